@@ -0,0 +1,85 @@
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"hatika-go/internal/application/services"
+	"hatika-go/internal/infrastructure/eventbus"
+	"hatika-go/internal/infrastructure/persistence"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+// adminServices are the services the administration commands work through
+type adminServices struct {
+	unitOfWork *persistence.UnitOfWork
+	seed       *services.SeedService
+	tenant     *services.TenantService
+	user       *services.UserService
+	permission *services.PermissionService
+}
+
+// openAdmin connects to a database whose schema is up to date and wires the administration services
+func openAdmin() (*adminServices, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	if err := checkMigrations(db, cfg.Database.Migrations); err != nil {
+		return nil, fmt.Errorf("database schema is not up to date: %w", err)
+	}
+
+	projectRepo := persistence.NewProjectRepository(db)
+	roleRepo := persistence.NewRoleRepository(db)
+	tenantRepo := persistence.NewTenantRepository(db)
+	userRepo := persistence.NewUserRepository(db)
+	unitOfWork := persistence.NewUnitOfWork(db)
+
+	permissionService := services.NewPermissionService(persistence.NewPermissionRepository(db), roleRepo, unitOfWork)
+	projectGroupService := services.NewProjectGroupService(persistence.NewProjectGroupRepository(db), userRepo)
+	projectService := services.NewProjectService(
+		projectRepo,
+		persistence.NewOcrProjectRepository(db),
+		persistence.NewOcrProjectTemplateRepository(db),
+		projectGroupService,
+		unitOfWork,
+		eventbus.New(persistence.NewOutboxRepository(db)),
+	)
+
+	return &adminServices{
+		unitOfWork: unitOfWork,
+		seed:       services.NewSeedService(roleRepo, tenantRepo, projectRepo, permissionService, projectService, projectGroupService),
+		tenant:     services.NewTenantService(tenantRepo),
+		user:       services.NewUserService(userRepo, roleRepo, tenantRepo),
+		permission: permissionService,
+	}, nil
+}
+
+// validateInput applies the binding rules of a DTO, as the HTTP handlers do for request bodies
+func validateInput(input interface{}) error {
+	if err := binding.Validator.ValidateStruct(input); err != nil {
+		return fmt.Errorf("invalid input: %w", err)
+	}
+	return nil
+}
+
+const passwordAlphabet = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// generatePassword returns a random password for commands run without --password
+func generatePassword() (string, error) {
+	password := make([]byte, 16)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(passwordAlphabet))))
+		if err != nil {
+			return "", fmt.Errorf("failed to generate password: %w", err)
+		}
+		password[i] = passwordAlphabet[n.Int64()]
+	}
+	return string(password), nil
+}
@@ -2,66 +2,52 @@ package main
 
 import (
 	"fmt"
-	"log"
+	"os"
 
-	"hatika-go/internal/application/services"
 	"hatika-go/internal/infrastructure/config"
-	"hatika-go/internal/infrastructure/persistence"
-	"hatika-go/internal/interfaces/http"
-	"hatika-go/internal/interfaces/http/handlers"
+
+	"github.com/spf13/cobra"
 
 	_ "hatika-go/docs"
 )
 
-func main() {
-	log.Println("Starting LLMOCR API Server...")
+// configPath is the directory holding config.yaml, set by the --config flag
+var configPath string
 
-	cfg, err := config.LoadConfig("./config")
-	if err != nil {
-		log.Fatalf("Failed to load configuration: %v", err)
+func main() {
+	root := &cobra.Command{
+		Use:   "api",
+		Short: "LLMOCR API server and administration commands",
+		Long: `LLMOCR API server and administration commands.
+
+Without a command the API server starts, as with "api serve".`,
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runServe()
+		},
 	}
-	log.Printf("Configuration loaded successfully")
-
-	dbConfig := &persistence.DatabaseConfig{
-		Host:     cfg.Database.Host,
-		Port:     cfg.Database.Port,
-		User:     cfg.Database.User,
-		Password: cfg.Database.Password,
-		DBName:   cfg.Database.DBName,
-		SSLMode:  cfg.Database.SSLMode,
+	root.PersistentFlags().StringVar(&configPath, "config", "./config", "directory containing config.yaml")
+
+	root.AddCommand(
+		newServeCommand(),
+		newMigrateCommand(),
+		newSeedCommand(),
+		newTenantCommand(),
+		newUserCommand(),
+		newPermissionsCommand(),
+	)
+
+	if err := root.Execute(); err != nil {
+		os.Exit(1)
 	}
+}
 
-	db, err := persistence.NewDatabase(dbConfig)
+// loadConfig reads the configuration from the --config directory
+func loadConfig() (*config.Config, error) {
+	cfg, err := config.LoadConfig(configPath)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
-	}
-	log.Println("Database connected successfully")
-
-	if err := persistence.AutoMigrate(db); err != nil {
-		log.Fatalf("Failed to run migrations: %v", err)
-	}
-
-	if err := persistence.SeedData(db); err != nil {
-		log.Printf("Warning: Failed to seed data: %v", err)
-	}
-
-	projectRepo := persistence.NewProjectRepository(db)
-
-	// Initialize services
-	projectService := services.NewProjectService(projectRepo)
-
-	// Initialize handlers
-	projectHandler := handlers.NewProjectHandler(projectService)
-
-	// Setup router
-	router := http.SetupRouter(projectHandler)
-
-	// Start server
-	address := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
-	log.Printf("Server starting on %s", address)
-	log.Printf("Swagger documentation: http://localhost:%d/swagger/index.html", cfg.Server.Port)
-
-	if err := router.Run(address); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
 	}
+	return cfg, nil
 }
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/migrations"
+	"hatika-go/internal/infrastructure/persistence"
+
+	"github.com/spf13/cobra"
+	"gorm.io/gorm"
+)
+
+func newMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply, roll back and inspect schema migrations",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "up",
+			Short: "Apply all pending migrations",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				migrator, err := openMigrator()
+				if err != nil {
+					return err
+				}
+				applied, err := migrator.Up(cmd.Context())
+				if err != nil {
+					return err
+				}
+				log.Printf("%d migrations applied", applied)
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "down [steps]",
+			Short: "Roll back the latest applied migrations (default 1)",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				steps := 1
+				if len(args) > 0 {
+					var err error
+					if steps, err = strconv.Atoi(args[0]); err != nil || steps < 1 {
+						return fmt.Errorf("migrate down expects a positive number of steps, got %q", args[0])
+					}
+				}
+				migrator, err := openMigrator()
+				if err != nil {
+					return err
+				}
+				rolledBack, err := migrator.Down(cmd.Context(), steps)
+				if err != nil {
+					return err
+				}
+				log.Printf("%d migrations rolled back", rolledBack)
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "status",
+			Short: "List migrations and whether they are applied",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				migrator, err := openMigrator()
+				if err != nil {
+					return err
+				}
+				statuses, err := migrator.Status(cmd.Context())
+				if err != nil {
+					return err
+				}
+				printMigrationStatus(statuses)
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "create <name>",
+			Short: "Write an empty up/down script pair to database.migrations.dir",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				cfg, err := loadConfig()
+				if err != nil {
+					return err
+				}
+				upPath, downPath, err := migrations.Create(cfg.Database.Migrations.Dir, args[0])
+				if err != nil {
+					return err
+				}
+				log.Printf("Created %s", upPath)
+				log.Printf("Created %s", downPath)
+				return nil
+			},
+		},
+	)
+	return cmd
+}
+
+func openMigrator() (*migrations.Migrator, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return migrations.New(db)
+}
+
+func printMigrationStatus(statuses []migrations.Status) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED AT")
+	for _, status := range statuses {
+		appliedAt := "-"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Local().Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%06d\t%s\t%s\t%s\n", status.Version, status.Name, status.State, appliedAt)
+	}
+	w.Flush()
+}
+
+// checkMigrations applies database.migrations.on_pending before the server starts
+func checkMigrations(db *gorm.DB, migrationsConfig config.MigrationsConfig) error {
+	migrator, err := migrations.New(db)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch migrationsConfig.OnPending {
+	case "apply":
+		if _, err := migrator.Up(ctx); err != nil {
+			return err
+		}
+		return nil
+	case "fail", "warn":
+	default:
+		return fmt.Errorf("invalid database.migrations.on_pending %q, expected fail, apply or warn", migrationsConfig.OnPending)
+	}
+
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	latest := pending[len(pending)-1]
+	if migrationsConfig.OnPending == "warn" {
+		log.Printf("Warning: %d migrations are pending, up to %06d_%s; run `migrate up`", len(pending), latest.Version, latest.Name)
+		return nil
+	}
+	return fmt.Errorf("%d migrations are pending, up to %06d_%s; run `migrate up` or set database.migrations.on_pending", len(pending), latest.Version, latest.Name)
+}
+
+func openDatabase(cfg *config.Config) (*gorm.DB, error) {
+	return persistence.NewDatabase(&persistence.DatabaseConfig{
+		Host:     cfg.Database.Host,
+		Port:     cfg.Database.Port,
+		User:     cfg.Database.User,
+		Password: cfg.Database.Password,
+		DBName:   cfg.Database.DBName,
+		SSLMode:  cfg.Database.SSLMode,
+	})
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+func newPermissionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions",
+		Short: "Manage permissions",
+	}
+
+	var prune bool
+	sync := &cobra.Command{
+		Use:   "sync",
+		Short: "Bring the permissions table in line with the permissions the code defines",
+		Long: `Create missing permissions, refresh display names and descriptions, and grant the
+Admin role every permission. Permissions no longer defined are listed; --prune deletes
+them together with their grants.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin, err := openAdmin()
+			if err != nil {
+				return err
+			}
+			result, err := admin.permission.Sync(cmd.Context(), prune)
+			if err != nil {
+				return err
+			}
+
+			logNames("Created", result.Created)
+			logNames("Updated", result.Updated)
+			logNames("Granted to Admin", result.Granted)
+			switch {
+			case len(result.Stale) == 0:
+			case result.Pruned:
+				logNames("Pruned", result.Stale)
+			default:
+				logNames("Stale, rerun with --prune to delete", result.Stale)
+			}
+			return nil
+		},
+	}
+	sync.Flags().BoolVar(&prune, "prune", false, "delete permissions that are no longer defined")
+
+	cmd.AddCommand(sync)
+	return cmd
+}
+
+func logNames(label string, names []string) {
+	if len(names) == 0 {
+		log.Printf("%s: none", label)
+		return
+	}
+	log.Printf("%s: %s", label, strings.Join(names, ", "))
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"hatika-go/internal/application/services"
+
+	"github.com/spf13/cobra"
+)
+
+func newSeedCommand() *cobra.Command {
+	var sets []string
+	var tenancyName string
+
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Insert the data the application expects; safe to run again",
+		Long: fmt.Sprintf(`Insert the data the application expects. Every set only adds what is missing, so
+seeding again is safe.
+
+Sets run in the order %s:
+  roles        the static Admin and User roles
+  permissions  every defined permission, granted to Admin
+  demo         a Demo project group with sample projects`, strings.Join(services.SeedSets, ", ")),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin, err := openAdmin()
+			if err != nil {
+				return err
+			}
+			return admin.seed.Seed(cmd.Context(), sets, tenancyName)
+		},
+	}
+	cmd.Flags().StringSliceVar(&sets, "set", services.DefaultSeedSets, "seed sets to run, comma separated")
+	cmd.Flags().StringVar(&tenancyName, "tenant", "", "tenancy name receiving the demo data; the host when empty")
+	return cmd
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"hatika-go/internal/application/services"
+	"hatika-go/internal/domain/events"
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/documents"
+	"hatika-go/internal/infrastructure/eventbus"
+	"hatika-go/internal/infrastructure/ocr"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/infrastructure/reports"
+	"hatika-go/internal/infrastructure/scheduler"
+	"hatika-go/internal/infrastructure/storage"
+	"hatika-go/internal/infrastructure/webhooks"
+	"hatika-go/internal/interfaces/http"
+	"hatika-go/internal/interfaces/http/handlers"
+	"hatika-go/internal/interfaces/http/middleware"
+
+	"github.com/spf13/cobra"
+	"gorm.io/gorm"
+)
+
+func newServeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "serve",
+		Short: "Start the API server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runServe()
+		},
+	}
+}
+
+// runServe starts the API server and its scheduled jobs, and blocks until the server stops
+func runServe() error {
+	log.Println("Starting LLMOCR API Server...")
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	log.Printf("Configuration loaded successfully")
+
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	log.Println("Database connected successfully")
+
+	if err := checkMigrations(db, cfg.Database.Migrations); err != nil {
+		return fmt.Errorf("database schema is not up to date: %w", err)
+	}
+
+	projectRepo := persistence.NewProjectRepository(db)
+	ocrProjectRepo := persistence.NewOcrProjectRepository(db)
+	ocrProjectTemplateRepo := persistence.NewOcrProjectTemplateRepository(db)
+	projectDocumentRepo := persistence.NewProjectDocumentRepository(db)
+	ocrRunRepo := persistence.NewOcrRunRepository(db)
+	projectGroupRepo := persistence.NewProjectGroupRepository(db)
+	userRepo := persistence.NewUserRepository(db)
+	projectImportRepo := persistence.NewProjectImportRepository(db)
+	projectBulkRepo := persistence.NewProjectBulkOperationRepository(db)
+	auditLogRepo := persistence.NewAuditLogRepository(db)
+	permitExpiryReminderRepo := persistence.NewPermitExpiryReminderRepository(db)
+	notificationRepo := persistence.NewNotificationRepository(db)
+	outboxRepo := persistence.NewOutboxRepository(db)
+	webhookSubscriptionRepo := persistence.NewWebhookSubscriptionRepository(db)
+	webhookDeliveryRepo := persistence.NewWebhookDeliveryRepository(db)
+
+	unitOfWork := persistence.NewUnitOfWork(db)
+	eventBus := eventbus.New(outboxRepo)
+
+	fileStorage, err := storage.NewLocalFileStorage(cfg.Storage.RootPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize file storage: %w", err)
+	}
+
+	ocrEngines := ocr.NewRegistry(cfg.Ocr.DefaultEngine)
+	for _, engine := range cfg.Ocr.Engines {
+		ocrEngines.Register(ocr.NewHTTPEngine(
+			engine.Name,
+			engine.Version,
+			engine.URL,
+			time.Duration(engine.TimeoutSeconds)*time.Second,
+		))
+	}
+
+	var documentRenderer documents.Renderer = documents.NewPdfTextRenderer()
+	if cfg.Ocr.TextRecognition.URL != "" {
+		documentRenderer = documents.NewRecognizingRenderer(documentRenderer, documents.NewHTTPTextRecognizer(
+			cfg.Ocr.TextRecognition.URL,
+			time.Duration(cfg.Ocr.TextRecognition.TimeoutSeconds)*time.Second,
+		))
+	}
+
+	// Initialize services
+	projectGroupService := services.NewProjectGroupService(projectGroupRepo, userRepo)
+	projectService := services.NewProjectService(
+		projectRepo,
+		ocrProjectRepo,
+		ocrProjectTemplateRepo,
+		projectGroupService,
+		unitOfWork,
+		eventBus,
+	)
+	projectImportService := services.NewProjectImportService(
+		projectImportRepo,
+		projectRepo,
+		projectService,
+		projectGroupService,
+		cfg.Import,
+		cfg.Storage.MaxUploadSizeMB<<20,
+	)
+	projectBulkService := services.NewProjectBulkService(
+		projectBulkRepo,
+		projectRepo,
+		projectService,
+		projectGroupService,
+		unitOfWork,
+		cfg.Bulk,
+	)
+	projectExportService := services.NewProjectExportService(
+		projectRepo,
+		projectService,
+		projectGroupService,
+		reports.NewPdfRenderer(cfg.Export.PdfFontPath),
+	)
+	schedulerLocation, err := scheduler.LoadLocation(cfg.Scheduler.Timezone)
+	if err != nil {
+		return fmt.Errorf("failed to load scheduler time zone: %w", err)
+	}
+	permitExpiryService := services.NewPermitExpiryService(
+		projectRepo,
+		permitExpiryReminderRepo,
+		projectGroupRepo,
+		projectService,
+		cfg.Scheduler.PermitExpiry,
+		schedulerLocation,
+	)
+	projectPurgeService := services.NewProjectPurgeService(projectRepo, auditLogRepo, unitOfWork, fileStorage, cfg.Scheduler.ProjectPurge)
+	notificationService := services.NewNotificationService(notificationRepo)
+	ocrProjectService := services.NewOcrProjectService(ocrProjectRepo, projectRepo, projectService, userRepo, cfg.Ocr.Review, unitOfWork, eventBus)
+	webhookService := services.NewWebhookService(webhookSubscriptionRepo, webhookDeliveryRepo, cfg.Events.Webhooks)
+	reconciliationService := services.NewReconciliationService(projectRepo, projectService, unitOfWork, eventBus)
+	ocrProjectTemplateService := services.NewOcrProjectTemplateService(ocrProjectTemplateRepo)
+	ocrRunService := services.NewOcrRunService(ocrRunRepo, ocrProjectRepo, projectDocumentRepo, projectService, ocrProjectService, ocrEngines)
+	documentService := services.NewDocumentService(
+		projectDocumentRepo,
+		projectRepo,
+		projectService,
+		fileStorage,
+		documentRenderer,
+		documents.NewRulesClassifier(),
+		cfg.Storage.MaxUploadSizeMB<<20,
+	)
+
+	// Subscribe in-process event handlers
+	eventbus.Subscribe[events.OcrProjectProcessed](eventBus, notificationService.OnOcrProjectProcessed)
+
+	// Fail the bulk operations a stopped server left unfinished
+	if err := projectBulkService.FailInterrupted(context.Background()); err != nil {
+		log.Printf("Warning: failed to check for interrupted bulk operations: %v", err)
+	}
+
+	// Start relaying outbox messages to the brokers
+	if cfg.Events.Relay.Enabled {
+		publishers, err := eventPublishers(db, cfg.Events, webhookSubscriptionRepo, webhookDeliveryRepo)
+		if err != nil {
+			return fmt.Errorf("failed to initialize event publishers: %w", err)
+		}
+		if len(publishers) == 0 {
+			log.Println("Warning: outbox relay is enabled but no broker is; events stay in the outbox")
+		} else {
+			relay := eventbus.NewRelay(outboxRepo, publishers, cfg.Events.Relay)
+			relay.Start()
+			defer relay.Stop()
+		}
+	}
+
+	// Start sending webhook deliveries
+	if cfg.Events.Webhooks.Enabled {
+		webhookWorker := webhooks.NewWorker(webhookDeliveryRepo, cfg.Events.Webhooks)
+		webhookWorker.Start()
+		defer webhookWorker.Stop()
+	}
+
+	// Start scheduled jobs
+	if cfg.Scheduler.Enabled {
+		jobs := scheduler.New(db, schedulerLocation)
+		if err := jobs.Register(services.PermitExpiryJobName, cfg.Scheduler.PermitExpiry.Schedule, permitExpiryService.SendReminders); err != nil {
+			return fmt.Errorf("failed to schedule permit expiry reminders: %w", err)
+		}
+		if err := jobs.Register(services.ProjectPurgeJobName, cfg.Scheduler.ProjectPurge.Schedule, projectPurgeService.PurgeExpired); err != nil {
+			return fmt.Errorf("failed to schedule project purge: %w", err)
+		}
+		if err := jobs.Register(services.ProjectBulkSweepJobName, cfg.Scheduler.BulkSweep.Schedule, projectBulkService.FailInterrupted); err != nil {
+			return fmt.Errorf("failed to schedule bulk operation sweep: %w", err)
+		}
+		jobs.Start()
+		defer jobs.Stop()
+	}
+
+	// Initialize handlers
+	projectHandler := handlers.NewProjectHandler(projectService)
+	ocrProjectHandler := handlers.NewOcrProjectHandler(ocrProjectService)
+	reconciliationHandler := handlers.NewReconciliationHandler(reconciliationService)
+	ocrProjectTemplateHandler := handlers.NewOcrProjectTemplateHandler(ocrProjectTemplateService)
+	documentHandler := handlers.NewDocumentHandler(documentService)
+	ocrRunHandler := handlers.NewOcrRunHandler(ocrRunService)
+	projectGroupHandler := handlers.NewProjectGroupHandler(projectGroupService)
+	projectImportHandler := handlers.NewProjectImportHandler(projectImportService)
+	projectBulkHandler := handlers.NewProjectBulkHandler(projectBulkService)
+	projectExportHandler := handlers.NewProjectExportHandler(projectExportService)
+	permitExpiryHandler := handlers.NewPermitExpiryHandler(permitExpiryService)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+
+	// Setup router
+	var requestUnitOfWork middleware.TransactionRunner
+	if cfg.Server.UnitOfWork {
+		requestUnitOfWork = unitOfWork
+	}
+	router := http.SetupRouter(
+		requestUnitOfWork,
+		projectHandler,
+		ocrProjectHandler,
+		reconciliationHandler,
+		ocrProjectTemplateHandler,
+		documentHandler,
+		ocrRunHandler,
+		projectGroupHandler,
+		projectImportHandler,
+		projectBulkHandler,
+		projectExportHandler,
+		permitExpiryHandler,
+		notificationHandler,
+		webhookHandler,
+	)
+
+	// Start server
+	address := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
+	log.Printf("Server starting on %s", address)
+	log.Printf("Swagger documentation: http://localhost:%d/swagger/index.html", cfg.Server.Port)
+
+	if err := router.Run(address); err != nil {
+		return fmt.Errorf("failed to start server: %w", err)
+	}
+	return nil
+}
+
+// eventPublishers connects to the brokers enabled in the configuration
+func eventPublishers(
+	db *gorm.DB,
+	eventsConfig config.EventsConfig,
+	webhookSubscriptionRepo *persistence.WebhookSubscriptionRepository,
+	webhookDeliveryRepo *persistence.WebhookDeliveryRepository,
+) ([]eventbus.Publisher, error) {
+	var publishers []eventbus.Publisher
+	if eventsConfig.Nats.Enabled {
+		natsPublisher, err := eventbus.NewNatsPublisher(eventsConfig.Nats)
+		if err != nil {
+			return nil, err
+		}
+		publishers = append(publishers, natsPublisher)
+	}
+	if eventsConfig.Postgres.Enabled {
+		publishers = append(publishers, eventbus.NewPostgresPublisher(db, eventsConfig.Postgres))
+	}
+	if eventsConfig.Webhooks.Enabled {
+		publishers = append(publishers, webhooks.NewPublisher(webhookSubscriptionRepo, webhookDeliveryRepo))
+	}
+	return publishers, nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/infrastructure/persistence"
+
+	"github.com/spf13/cobra"
+)
+
+func newTenantCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenant",
+		Short: "Manage tenants",
+	}
+	cmd.AddCommand(newCreateTenantCommand())
+	return cmd
+}
+
+func newCreateTenantCommand() *cobra.Command {
+	input := &dtos.CreateTenantDto{}
+	adminInput := &dtos.CreateAdminUserDto{}
+
+	cmd := &cobra.Command{
+		Use:   "create <tenancy-name>",
+		Short: "Create a tenant, optionally with its first administrator",
+		Long: `Create a tenant. With --admin-username and --admin-email the tenant's first
+administrator is created in the same transaction; without --admin-password a random
+password is generated and printed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input.TenancyName = args[0]
+			if input.Name == "" {
+				input.Name = input.TenancyName
+			}
+			if err := validateInput(input); err != nil {
+				return err
+			}
+			withAdmin := adminInput.Username != "" || adminInput.Email != ""
+			generated := false
+			if withAdmin {
+				if adminInput.Username == "" || adminInput.Email == "" {
+					return fmt.Errorf("--admin-username and --admin-email must be given together")
+				}
+				adminInput.TenancyName = input.TenancyName
+				var err error
+				if generated, err = prepareAdmin(adminInput); err != nil {
+					return err
+				}
+			}
+
+			admin, err := openAdmin()
+			if err != nil {
+				return err
+			}
+			// The tenant and its administrator are created together or not at all
+			return admin.unitOfWork.Do(cmd.Context(), func(ctx context.Context) error {
+				tenant, err := admin.tenant.Create(ctx, input)
+				if err != nil {
+					return err
+				}
+				persistence.AfterCommit(ctx, func() {
+					log.Printf("Created tenant %s with ID %d", tenant.TenancyName, tenant.ID)
+				})
+
+				if !withAdmin {
+					return nil
+				}
+				return createAdmin(ctx, admin, adminInput, generated)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&input.Name, "name", "", "display name of the tenant; the tenancy name when empty")
+	addAdminFlags(cmd, adminInput, "admin-")
+	return cmd
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/infrastructure/persistence"
+
+	"github.com/spf13/cobra"
+)
+
+func newUserCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user",
+		Short: "Manage user accounts",
+	}
+	cmd.AddCommand(newCreateAdminCommand(), newResetPasswordCommand())
+	return cmd
+}
+
+func newCreateAdminCommand() *cobra.Command {
+	input := &dtos.CreateAdminUserDto{}
+
+	cmd := &cobra.Command{
+		Use:   "create-admin",
+		Short: "Create a user holding the Admin role",
+		Long: `Create an active user holding the Admin role, in the tenant given by --tenant or on
+the host. Without --password a random password is generated and printed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			generated, err := prepareAdmin(input)
+			if err != nil {
+				return err
+			}
+			admin, err := openAdmin()
+			if err != nil {
+				return err
+			}
+			return createAdmin(cmd.Context(), admin, input, generated)
+		},
+	}
+	addAdminFlags(cmd, input, "")
+	cmd.Flags().StringVar(&input.TenancyName, "tenant", "", "tenancy name of the tenant the user belongs to; the host when empty")
+	cmd.MarkFlagRequired("username")
+	cmd.MarkFlagRequired("email")
+	return cmd
+}
+
+// addAdminFlags registers the flags describing an administrator, each name prefixed with prefix
+func addAdminFlags(cmd *cobra.Command, input *dtos.CreateAdminUserDto, prefix string) {
+	cmd.Flags().StringVar(&input.Username, prefix+"username", "", "username of the administrator")
+	cmd.Flags().StringVar(&input.Email, prefix+"email", "", "email of the administrator")
+	cmd.Flags().StringVar(&input.Password, prefix+"password", "", "password of the administrator; generated when empty")
+	cmd.Flags().StringVar(&input.Name, prefix+"name", "Admin", "first name of the administrator")
+	cmd.Flags().StringVar(&input.Surname, prefix+"surname", "", "last name of the administrator")
+}
+
+// prepareAdmin generates a password when none is given and validates the input, reporting whether
+// the password was generated
+func prepareAdmin(input *dtos.CreateAdminUserDto) (bool, error) {
+	generated := input.Password == ""
+	if generated {
+		password, err := generatePassword()
+		if err != nil {
+			return false, err
+		}
+		input.Password = password
+	}
+	return generated, validateInput(input)
+}
+
+// createAdmin creates the user and, once that is committed, reports it along with a generated password
+func createAdmin(ctx context.Context, admin *adminServices, input *dtos.CreateAdminUserDto, generated bool) error {
+	user, err := admin.user.CreateAdmin(ctx, input)
+	if err != nil {
+		return err
+	}
+	persistence.AfterCommit(ctx, func() {
+		log.Printf("Created administrator %s with ID %d", user.Username, user.ID)
+		if generated {
+			fmt.Printf("Generated password for %s: %s\n", user.Username, input.Password)
+		}
+	})
+	return nil
+}
+
+func newResetPasswordCommand() *cobra.Command {
+	var password string
+
+	cmd := &cobra.Command{
+		Use:   "reset-password <username>",
+		Short: "Set a new password for a user and unlock the account",
+		Long: `Set a new password for a user and unlock the account. Without --password a random
+password is generated and printed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin, err := openAdmin()
+			if err != nil {
+				return err
+			}
+			user, err := admin.user.GetByUsername(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			generated := password == ""
+			if generated {
+				if password, err = generatePassword(); err != nil {
+					return err
+				}
+			}
+			input := &dtos.ResetPasswordDto{UserID: user.ID, NewPassword: password}
+			if err := validateInput(input); err != nil {
+				return err
+			}
+			if err := admin.user.ResetPassword(cmd.Context(), input); err != nil {
+				return err
+			}
+
+			log.Printf("Password of %s reset", user.Username)
+			if generated {
+				fmt.Printf("Generated password for %s: %s\n", user.Username, password)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&password, "password", "", "new password; generated when empty")
+	return cmd
+}
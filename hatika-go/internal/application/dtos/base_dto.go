@@ -7,6 +7,7 @@ type PagedResultRequestDto struct {
 	PageNumber int    `form:"pageNumber" json:"pageNumber" binding:"required,min=1"`
 	PageSize   int    `form:"pageSize" json:"pageSize" binding:"required,min=1,max=100"`
 	Sorting    string `form:"sorting" json:"sorting,omitempty"`
+	Filter     string `form:"filter" json:"filter,omitempty"`
 }
 
 // PagedResultDto represents a paged result
@@ -15,6 +16,23 @@ type PagedResultDto[T any] struct {
 	Items      []T `json:"items"`
 }
 
+// CursorPagedResultRequestDto is the base class for keyset-paginated request DTOs
+type CursorPagedResultRequestDto struct {
+	Cursor       string `form:"cursor" json:"cursor,omitempty"`
+	Limit        int    `form:"limit" json:"limit" binding:"required,min=1,max=100"`
+	Sorting      string `form:"sorting" json:"sorting,omitempty"`
+	Filter       string `form:"filter" json:"filter,omitempty"`
+	IncludeTotal bool   `form:"includeTotal" json:"includeTotal,omitempty"`
+}
+
+// CursorPagedResultDto represents a keyset-paginated result; the total count is only set when requested
+type CursorPagedResultDto[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
+	TotalCount *int   `json:"totalCount,omitempty"`
+}
+
 // EntityDto represents a basic entity DTO
 type EntityDto struct {
 	ID int `json:"id"`
@@ -35,4 +53,6 @@ type FullAuditedEntityDto struct {
 	DeleterUserID *int       `json:"deleterUserId,omitempty"`
 	DeletionTime  *time.Time `json:"deletionTime,omitempty"`
 	IsDeleted     bool       `json:"isDeleted"`
+	// Version grows with every change; it is the ETag of the resource and the If-Match of updates
+	Version int `json:"version"`
 }
@@ -0,0 +1,21 @@
+package dtos
+
+import "time"
+
+// NotificationDto represents an in-app notification of the current user
+type NotificationDto struct {
+	ID        int        `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Message   string     `json:"message"`
+	ProjectID *int       `json:"projectId,omitempty"`
+	ReadAt    *time.Time `json:"readAt,omitempty"`
+}
+
+// NotificationListRequestDto pages through the current user's notifications
+type NotificationListRequestDto struct {
+	PageNumber int  `form:"pageNumber" binding:"required,min=1"`
+	PageSize   int  `form:"pageSize" binding:"required,min=1,max=100"`
+	UnreadOnly bool `form:"unreadOnly"`
+}
@@ -1,52 +1,66 @@
 package dtos
 
+import (
+	"time"
+
+	"hatika-go/internal/domain/valueobjects"
+)
+
 // OcrProjectDto represents an OCR project data transfer object
 type OcrProjectDto struct {
 	FullAuditedEntityDto
-	
-	ProjectName             string   `json:"projectName,omitempty"`
-	ProjectCode             string   `json:"projectCode,omitempty"`
-	ProjectComment          string   `json:"projectComment,omitempty"`
-	ProjectMuellef          string   `json:"projectMuellef,omitempty"`
-	Ada                     *int     `json:"ada,omitempty"`
-	Parsel                  *int     `json:"parsel,omitempty"`
-	TalepGucu               *int     `json:"talepGucu,omitempty"`
-	KuruluGuc               *int     `json:"kuruluGuc,omitempty"`
-	BagimsizBS              *int     `json:"bagimsizBS,omitempty"`
-	BlokS                   *int     `json:"blokS,omitempty"`
-	YapiYuksekligi          *float64 `json:"yapiYuksekligi,omitempty"`
-	RuhsatGecerlilikDate    string   `json:"ruhsatGecerlilikDate,omitempty"`
-	YapiSahibi              string   `json:"yapiSahibi,omitempty"`
-	Adress                  string   `json:"adress,omitempty"`
-	Type                    int      `json:"type"`
-	TypeName                string   `json:"typeName"`
-	ProjectID               int      `json:"projectId"`
-	PdfPath                 string   `json:"pdfPath,omitempty"`
+
+	ProjectName          string                   `json:"projectName,omitempty"`
+	ProjectCode          string                   `json:"projectCode,omitempty"`
+	ProjectComment       string                   `json:"projectComment,omitempty"`
+	ProjectMuellef       string                   `json:"projectMuellef,omitempty"`
+	Ada                  *valueobjects.Ada        `json:"ada,omitempty" swaggertype:"integer" minimum:"0" maximum:"99999"`
+	Parsel               *valueobjects.Parsel     `json:"parsel,omitempty" swaggertype:"integer" minimum:"1" maximum:"99999"`
+	TalepGucu            *valueobjects.Power      `json:"talepGucu,omitempty" swaggertype:"integer" minimum:"0"`
+	KuruluGuc            *valueobjects.Power      `json:"kuruluGuc,omitempty" swaggertype:"integer" minimum:"0"`
+	BagimsizBS           *int                     `json:"bagimsizBS,omitempty"`
+	BlokS                *int                     `json:"blokS,omitempty"`
+	YapiYuksekligi       *float64                 `json:"yapiYuksekligi,omitempty"`
+	RuhsatGecerlilikDate *valueobjects.PermitDate `json:"ruhsatGecerlilikDate,omitempty" swaggertype:"string" format:"date" example:"2026-12-31"`
+	YapiSahibi           string                   `json:"yapiSahibi,omitempty"`
+	Adress               string                   `json:"adress,omitempty"`
+	Type                 int                      `json:"type"`
+	TypeName             string                   `json:"typeName"`
+	ProjectID            int                      `json:"projectId"`
+	PdfPath              string                   `json:"pdfPath,omitempty"`
+	Status               int                      `json:"status"`
+	StatusName           string                   `json:"statusName"`
+	ReviewerUserID       *int                     `json:"reviewerUserId,omitempty"`
+	ApprovedByUserID     *int                     `json:"approvedByUserId,omitempty"`
+	ApprovedAt           *time.Time               `json:"approvedAt,omitempty"`
+	RejectionReason      string                   `json:"rejectionReason,omitempty"`
+	IsOptional           bool                     `json:"isOptional"`
+	FieldResults         []OcrFieldResultDto      `json:"fieldResults,omitempty"`
 }
 
 // UpdateOcrProjectDto represents the input for updating an OCR project
 type UpdateOcrProjectDto struct {
-	ProjectName             string   `json:"projectName,omitempty"`
-	ProjectCode             string   `json:"projectCode,omitempty"`
-	ProjectComment          string   `json:"projectComment,omitempty"`
-	ProjectMuellef          string   `json:"projectMuellef,omitempty"`
-	Ada                     *int     `json:"ada,omitempty"`
-	Parsel                  *int     `json:"parsel,omitempty"`
-	TalepGucu               *int     `json:"talepGucu,omitempty"`
-	KuruluGuc               *int     `json:"kuruluGuc,omitempty"`
-	BagimsizBS              *int     `json:"bagimsizBS,omitempty"`
-	BlokS                   *int     `json:"blokS,omitempty"`
-	YapiYuksekligi          *float64 `json:"yapiYuksekligi,omitempty"`
-	RuhsatGecerlilikDate    string   `json:"ruhsatGecerlilikDate,omitempty"`
-	YapiSahibi              string   `json:"yapiSahibi,omitempty"`
-	Adress                  string   `json:"adress,omitempty"`
-	PdfPath                 string   `json:"pdfPath,omitempty"`
+	ProjectName          string                   `json:"projectName,omitempty"`
+	ProjectCode          string                   `json:"projectCode,omitempty"`
+	ProjectComment       string                   `json:"projectComment,omitempty"`
+	ProjectMuellef       string                   `json:"projectMuellef,omitempty"`
+	Ada                  *valueobjects.Ada        `json:"ada,omitempty" swaggertype:"integer" minimum:"0" maximum:"99999"`
+	Parsel               *valueobjects.Parsel     `json:"parsel,omitempty" swaggertype:"integer" minimum:"1" maximum:"99999"`
+	TalepGucu            *valueobjects.Power      `json:"talepGucu,omitempty" swaggertype:"integer" minimum:"0"`
+	KuruluGuc            *valueobjects.Power      `json:"kuruluGuc,omitempty" swaggertype:"integer" minimum:"0"`
+	BagimsizBS           *int                     `json:"bagimsizBS,omitempty"`
+	BlokS                *int                     `json:"blokS,omitempty"`
+	YapiYuksekligi       *float64                 `json:"yapiYuksekligi,omitempty"`
+	RuhsatGecerlilikDate *valueobjects.PermitDate `json:"ruhsatGecerlilikDate,omitempty" swaggertype:"string" format:"date" example:"2026-12-31"`
+	YapiSahibi           string                   `json:"yapiSahibi,omitempty"`
+	Adress               string                   `json:"adress,omitempty"`
+	PdfPath              string                   `json:"pdfPath,omitempty"`
 }
 
 // PagedOcrProjectResultRequestDto represents paged request for OCR projects
 type PagedOcrProjectResultRequestDto struct {
 	PagedResultRequestDto
-	
+
 	ProjectID   int    `form:"projectId" json:"projectId,omitempty"`
 	Type        int    `form:"type" json:"type,omitempty"`
 	ProjectCode string `form:"projectCode" json:"projectCode,omitempty"`
@@ -54,7 +68,54 @@ type PagedOcrProjectResultRequestDto struct {
 
 // ProcessedDataModel represents processed OCR data
 type ProcessedDataModel struct {
-	FieldName  string      `json:"fieldName"`
+	FieldName  string      `json:"fieldName" binding:"required"`
 	Value      interface{} `json:"value"`
-	Confidence float64     `json:"confidence"`
+	Confidence float64     `json:"confidence" binding:"min=0,max=1"`
+}
+
+// OcrFieldResultDto represents a stored OCR field extraction and its review state
+type OcrFieldResultDto struct {
+	ID               int        `json:"id"`
+	FieldName        string     `json:"fieldName"`
+	Value            string     `json:"value"`
+	Confidence       float64    `json:"confidence"`
+	Threshold        float64    `json:"threshold"`
+	NeedsReview      bool       `json:"needsReview"`
+	IsApproved       bool       `json:"isApproved"`
+	ApprovedValue    *string    `json:"approvedValue,omitempty"`
+	ApprovedByUserID *int       `json:"approvedByUserId,omitempty"`
+	ApprovedAt       *time.Time `json:"approvedAt,omitempty"`
+}
+
+// SubmitOcrResultsDto carries the fields extracted by an OCR run
+type SubmitOcrResultsDto struct {
+	Fields []ProcessedDataModel `json:"fields" binding:"required,min=1,dive"`
+}
+
+// AssignReviewerDto assigns a reviewer to an OCR project
+type AssignReviewerDto struct {
+	ReviewerUserID int `json:"reviewerUserId" binding:"required,min=1"`
+}
+
+// ApprovedFieldValueDto is a reviewer's decision for one field; a nil value accepts the extracted value
+type ApprovedFieldValueDto struct {
+	FieldName string  `json:"fieldName" binding:"required"`
+	Value     *string `json:"value"`
+}
+
+// ApproveOcrProjectDto approves an OCR project; every field that needs review must be listed
+type ApproveOcrProjectDto struct {
+	Fields []ApprovedFieldValueDto `json:"fields" binding:"dive"`
+}
+
+// RejectOcrProjectDto rejects an OCR project so it can be processed again
+type RejectOcrProjectDto struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// PagedReviewQueueRequestDto represents paged request for the OCR review queue
+type PagedReviewQueueRequestDto struct {
+	PagedResultRequestDto
+
+	ReviewerUserID int `form:"reviewerUserId" json:"reviewerUserId,omitempty"`
 }
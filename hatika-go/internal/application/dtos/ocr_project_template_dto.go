@@ -0,0 +1,49 @@
+package dtos
+
+// OcrProjectTemplateItemDto represents one document slot of a template
+type OcrProjectTemplateItemDto struct {
+	ID         int    `json:"id"`
+	Type       int    `json:"type"`
+	TypeName   string `json:"typeName"`
+	IsOptional bool   `json:"isOptional"`
+	SortOrder  int    `json:"sortOrder"`
+}
+
+// OcrProjectTemplateDto represents an OCR project template data transfer object
+type OcrProjectTemplateDto struct {
+	FullAuditedEntityDto
+
+	TenantID    *int                        `json:"tenantId,omitempty"`
+	Name        string                      `json:"name"`
+	GroupID     *int                        `json:"groupId,omitempty"`
+	CodePattern string                      `json:"codePattern"`
+	IsActive    bool                        `json:"isActive"`
+	Items       []OcrProjectTemplateItemDto `json:"items"`
+}
+
+// CreateOcrProjectTemplateItemDto represents the input for one document slot
+type CreateOcrProjectTemplateItemDto struct {
+	Type       int  `json:"type" binding:"min=0"`
+	IsOptional bool `json:"isOptional"`
+}
+
+// CreateOcrProjectTemplateDto represents the input for creating an OCR project template.
+// CodePattern may use {projectCode}, {index} and {type}; it defaults to "{projectCode}-OCR-{index}".
+type CreateOcrProjectTemplateDto struct {
+	Name        string                            `json:"name" binding:"required,max=128"`
+	GroupID     *int                              `json:"groupId,omitempty"`
+	CodePattern string                            `json:"codePattern,omitempty" binding:"max=128"`
+	IsActive    *bool                             `json:"isActive,omitempty"`
+	Items       []CreateOcrProjectTemplateItemDto `json:"items" binding:"required,min=1,dive"`
+}
+
+// UpdateOcrProjectTemplateDto represents the input for updating an OCR project template
+type UpdateOcrProjectTemplateDto struct {
+	CreateOcrProjectTemplateDto
+}
+
+// AddOcrProjectSlotDto adds a document slot to an existing project
+type AddOcrProjectSlotDto struct {
+	Type       int  `json:"type" binding:"min=0"`
+	IsOptional bool `json:"isOptional"`
+}
@@ -0,0 +1,118 @@
+package dtos
+
+import "time"
+
+// OcrEngineDto describes a configured extraction engine
+type OcrEngineDto struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	IsDefault bool   `json:"isDefault"`
+}
+
+// OcrRunFieldDto is a value produced by an extraction run
+type OcrRunFieldDto struct {
+	FieldName  string  `json:"fieldName"`
+	Value      string  `json:"value"`
+	Confidence float64 `json:"confidence"`
+}
+
+// OcrRunDto represents one extraction run of an OCR project
+type OcrRunDto struct {
+	ID                int              `json:"id"`
+	CreatedAt         time.Time        `json:"createdAt"`
+	OcrProjectID      int              `json:"ocrProjectId"`
+	EngineName        string           `json:"engineName"`
+	EngineVersion     string           `json:"engineVersion"`
+	BatchID           string           `json:"batchId,omitempty"`
+	Status            int              `json:"status"`
+	StatusName        string           `json:"statusName"`
+	Error             string           `json:"error,omitempty"`
+	Apply             bool             `json:"apply"`
+	IsApplied         bool             `json:"isApplied"`
+	TriggeredByUserID *int             `json:"triggeredByUserId,omitempty"`
+	StartedAt         *time.Time       `json:"startedAt,omitempty"`
+	CompletedAt       *time.Time       `json:"completedAt,omitempty"`
+	Fields            []OcrRunFieldDto `json:"fields,omitempty"`
+}
+
+// ReprocessOcrProjectDto re-runs extraction; apply submits the results to the review workflow
+type ReprocessOcrProjectDto struct {
+	EngineName string `json:"engineName"`
+	Apply      bool   `json:"apply"`
+}
+
+// BulkReprocessDto re-runs extraction for every OCR project matching the filter
+type BulkReprocessDto struct {
+	EngineName string `json:"engineName"`
+	Apply      bool   `json:"apply"`
+	ProjectID  *int   `json:"projectId" binding:"omitempty,min=1"`
+	Type       *int   `json:"type" binding:"omitempty,min=0"`
+	Status     *int   `json:"status" binding:"omitempty,min=0,max=4"`
+	Limit      int    `json:"limit" binding:"omitempty,min=1,max=1000"`
+}
+
+// BulkReprocessResultDto identifies the runs queued by a bulk request
+type BulkReprocessResultDto struct {
+	BatchID       string `json:"batchId"`
+	EngineName    string `json:"engineName"`
+	EngineVersion string `json:"engineVersion"`
+	QueuedCount   int    `json:"queuedCount"`
+}
+
+// CompareOcrRunsRequestDto selects two runs to compare
+type CompareOcrRunsRequestDto struct {
+	BaseRunID      int `form:"baseRunId" binding:"required,min=1"`
+	CandidateRunID int `form:"candidateRunId" binding:"required,min=1"`
+}
+
+// OcrRunFieldDiffDto compares one field between two runs and, when reviewed, the approved value
+type OcrRunFieldDiffDto struct {
+	FieldName           string   `json:"fieldName"`
+	BaseValue           *string  `json:"baseValue,omitempty"`
+	BaseConfidence      *float64 `json:"baseConfidence,omitempty"`
+	CandidateValue      *string  `json:"candidateValue,omitempty"`
+	CandidateConfidence *float64 `json:"candidateConfidence,omitempty"`
+	Changed             bool     `json:"changed"`
+	ApprovedValue       *string  `json:"approvedValue,omitempty"`
+	BaseCorrect         *bool    `json:"baseCorrect,omitempty"`
+	CandidateCorrect    *bool    `json:"candidateCorrect,omitempty"`
+}
+
+// OcrRunComparisonDto is the field-by-field difference between two runs of an OCR project
+type OcrRunComparisonDto struct {
+	BaseRun           OcrRunDto            `json:"baseRun"`
+	CandidateRun      OcrRunDto            `json:"candidateRun"`
+	HasApprovedValues bool                 `json:"hasApprovedValues"`
+	BaseAccuracy      *float64             `json:"baseAccuracy,omitempty"`
+	CandidateAccuracy *float64             `json:"candidateAccuracy,omitempty"`
+	Fields            []OcrRunFieldDiffDto `json:"fields"`
+}
+
+// CompareOcrEnginesRequestDto selects two engine versions to compare on reviewed OCR projects
+type CompareOcrEnginesRequestDto struct {
+	BaseEngine       string   `form:"baseEngine" binding:"required"`
+	BaseVersion      string   `form:"baseVersion" binding:"required"`
+	CandidateEngine  string   `form:"candidateEngine" binding:"required"`
+	CandidateVersion string   `form:"candidateVersion" binding:"required"`
+	Fields           []string `form:"fields"`
+}
+
+// OcrFieldAccuracyDto is the accuracy of two engine versions on one field
+type OcrFieldAccuracyDto struct {
+	FieldName         string  `json:"fieldName"`
+	SampleCount       int     `json:"sampleCount"`
+	BaseCorrect       int     `json:"baseCorrect"`
+	CandidateCorrect  int     `json:"candidateCorrect"`
+	BaseAccuracy      float64 `json:"baseAccuracy"`
+	CandidateAccuracy float64 `json:"candidateAccuracy"`
+}
+
+// OcrEngineComparisonDto measures two engine versions against human-approved values
+type OcrEngineComparisonDto struct {
+	BaseEngine       string                `json:"baseEngine"`
+	BaseVersion      string                `json:"baseVersion"`
+	CandidateEngine  string                `json:"candidateEngine"`
+	CandidateVersion string                `json:"candidateVersion"`
+	OcrProjectCount  int                   `json:"ocrProjectCount"`
+	Fields           []OcrFieldAccuracyDto `json:"fields"`
+}
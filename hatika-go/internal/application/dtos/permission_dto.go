@@ -17,3 +17,12 @@ type FlatPermissionDto struct {
 	Description string `json:"description,omitempty"`
 	IsGranted   bool   `json:"isGranted"`
 }
+
+// PermissionSyncResultDto reports what a permission sync changed
+type PermissionSyncResultDto struct {
+	Created []string `json:"created"`
+	Updated []string `json:"updated"`
+	Granted []string `json:"granted"`
+	Stale   []string `json:"stale"`
+	Pruned  bool     `json:"pruned"`
+}
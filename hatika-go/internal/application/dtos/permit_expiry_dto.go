@@ -0,0 +1,16 @@
+package dtos
+
+// ExpiringProjectsRequestDto lists projects whose permit expires within Days; the project list
+// filters apply as well. Days defaults to the largest reminder window.
+type ExpiringProjectsRequestDto struct {
+	PagedResultRequestDto
+	ProjectFilterDto
+	Days           int  `form:"days" binding:"omitempty,min=1,max=3650"`
+	IncludeExpired bool `form:"includeExpired"`
+}
+
+// ExpiringProjectDto is a project with the days left until its permit expires; negative once expired
+type ExpiringProjectDto struct {
+	ProjectDto
+	DaysLeft int `json:"daysLeft"`
+}
@@ -0,0 +1,58 @@
+package dtos
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ProjectBulkRequestDto applies one operation to the projects selected by idList or by the list
+// filters and filter expression. Fields is a JSON Merge Patch applied to every project by
+// UpdateFields; GroupID is the target group of ChangeGroup. Background forces background processing
+// of a selection small enough to run in the request.
+type ProjectBulkRequestDto struct {
+	ProjectFilterDto
+
+	Filter     string          `json:"filter,omitempty"`
+	Operation  string          `json:"operation" binding:"required,oneof=UpdateFields ChangeGroup Delete Restore"`
+	Fields     json.RawMessage `json:"fields,omitempty" swaggertype:"object"`
+	GroupID    *int            `json:"groupId,omitempty" binding:"omitempty,min=1"`
+	Background bool            `json:"background,omitempty"`
+}
+
+// ProjectBulkOperationDto represents a bulk operation and its progress. Items are only set in the
+// response to an operation that ran in the request; a background operation reports them through
+// its items endpoint.
+type ProjectBulkOperationDto struct {
+	ID                int                           `json:"id"`
+	CreatedAt         time.Time                     `json:"createdAt"`
+	Operation         string                        `json:"operation"`
+	Background        bool                          `json:"background"`
+	Status            int                           `json:"status"`
+	StatusName        string                        `json:"statusName"`
+	Error             string                        `json:"error,omitempty"`
+	TotalCount        int                           `json:"totalCount"`
+	ProcessedCount    int                           `json:"processedCount"`
+	SucceededCount    int                           `json:"succeededCount"`
+	SkippedCount      int                           `json:"skippedCount"`
+	FailedCount       int                           `json:"failedCount"`
+	TriggeredByUserID *int                          `json:"triggeredByUserId,omitempty"`
+	StartedAt         *time.Time                    `json:"startedAt,omitempty"`
+	CompletedAt       *time.Time                    `json:"completedAt,omitempty"`
+	Items             []ProjectBulkOperationItemDto `json:"items,omitempty"`
+}
+
+// ProjectBulkOperationItemDto is the result of a bulk operation for one project
+type ProjectBulkOperationItemDto struct {
+	ProjectID   int    `json:"projectId"`
+	Outcome     int    `json:"outcome"`
+	OutcomeName string `json:"outcomeName"`
+	Error       string `json:"error,omitempty"`
+}
+
+// ProjectBulkItemsRequestDto pages through the per-project results of a bulk operation, optionally
+// by outcome
+type ProjectBulkItemsRequestDto struct {
+	PageNumber int  `form:"pageNumber" json:"pageNumber" binding:"required,min=1"`
+	PageSize   int  `form:"pageSize" json:"pageSize" binding:"required,min=1,max=1000"`
+	Outcome    *int `form:"outcome" json:"outcome,omitempty" binding:"omitempty,min=0,max=4"`
+}
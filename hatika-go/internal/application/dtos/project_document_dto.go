@@ -0,0 +1,33 @@
+package dtos
+
+// DocumentPageRangeDto is a run of consecutive pages that belong to the same OCR project
+type DocumentPageRangeDto struct {
+	StartPage        int     `json:"startPage"`
+	EndPage          int     `json:"endPage"`
+	OcrProjectID     *int    `json:"ocrProjectId,omitempty"`
+	DetectedType     *int    `json:"detectedType,omitempty"`
+	DetectedTypeName string  `json:"detectedTypeName,omitempty"`
+	Confidence       float64 `json:"confidence"`
+	IsManual         bool    `json:"isManual"`
+}
+
+// ProjectDocumentDto represents an uploaded document and how its pages were split
+type ProjectDocumentDto struct {
+	FullAuditedEntityDto
+
+	ProjectID   int                    `json:"projectId"`
+	FileName    string                 `json:"fileName"`
+	ContentType string                 `json:"contentType"`
+	SizeBytes   int64                  `json:"sizeBytes"`
+	PageCount   int                    `json:"pageCount"`
+	Status      int                    `json:"status"`
+	StatusName  string                 `json:"statusName"`
+	PageRanges  []DocumentPageRangeDto `json:"pageRanges"`
+}
+
+// ReassignDocumentPagesDto moves a page range to another OCR project; a nil ocrProjectId unassigns it
+type ReassignDocumentPagesDto struct {
+	StartPage    int  `json:"startPage" binding:"required,min=1"`
+	EndPage      int  `json:"endPage" binding:"required,min=1,gtefield=StartPage"`
+	OcrProjectID *int `json:"ocrProjectId"`
+}
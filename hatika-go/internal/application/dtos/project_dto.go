@@ -1,46 +1,48 @@
 package dtos
 
+import "hatika-go/internal/domain/valueobjects"
+
 // ProjectDto represents a project data transfer object
 type ProjectDto struct {
 	FullAuditedEntityDto
-	
-	ProjectName             string        `json:"projectName" binding:"required"`
-	ProjectCode             string        `json:"projectCode" binding:"required"`
-	ProjectComment          string        `json:"projectComment,omitempty"`
-	ProjectMuellef          string        `json:"projectMuellef,omitempty"`
-	Ada                     *int          `json:"ada,omitempty"`
-	Parsel                  *int          `json:"parsel,omitempty"`
-	TalepGucu               *int          `json:"talepGucu,omitempty"`
-	KuruluGuc               *int          `json:"kuruluGuc,omitempty"`
-	BagimsizBS              *int          `json:"bagimsizBS,omitempty"`
-	BlokS                   *int          `json:"blokS,omitempty"`
-	YapiYuksekligi          *float64      `json:"yapiYuksekligi,omitempty"`
-	RuhsatGecerlilikDate    string        `json:"ruhsatGecerlilikDate,omitempty"`
-	YapiSahibi              string        `json:"yapiSahibi,omitempty"`
-	Adress                  string        `json:"adress,omitempty"`
-	GroupID                 *int          `json:"groupId,omitempty"`
-	BildirimNo              string        `json:"bildirimNo,omitempty"`
-	OcrProjects             []OcrProjectDto `json:"ocrProjects,omitempty"`
+
+	ProjectName          string                   `json:"projectName" binding:"required"`
+	ProjectCode          string                   `json:"projectCode" binding:"required"`
+	ProjectComment       string                   `json:"projectComment,omitempty"`
+	ProjectMuellef       string                   `json:"projectMuellef,omitempty"`
+	Ada                  *valueobjects.Ada        `json:"ada,omitempty" swaggertype:"integer" minimum:"0" maximum:"99999"`
+	Parsel               *valueobjects.Parsel     `json:"parsel,omitempty" swaggertype:"integer" minimum:"1" maximum:"99999"`
+	TalepGucu            *valueobjects.Power      `json:"talepGucu,omitempty" swaggertype:"integer" minimum:"0"`
+	KuruluGuc            *valueobjects.Power      `json:"kuruluGuc,omitempty" swaggertype:"integer" minimum:"0"`
+	BagimsizBS           *int                     `json:"bagimsizBS,omitempty"`
+	BlokS                *int                     `json:"blokS,omitempty"`
+	YapiYuksekligi       *float64                 `json:"yapiYuksekligi,omitempty"`
+	RuhsatGecerlilikDate *valueobjects.PermitDate `json:"ruhsatGecerlilikDate,omitempty" swaggertype:"string" format:"date" example:"2026-12-31"`
+	YapiSahibi           string                   `json:"yapiSahibi,omitempty"`
+	Adress               string                   `json:"adress,omitempty"`
+	GroupID              *int                     `json:"groupId,omitempty"`
+	BildirimNo           string                   `json:"bildirimNo,omitempty"`
+	OcrProjects          []OcrProjectDto          `json:"ocrProjects,omitempty"`
 }
 
 // CreateProjectDto represents the input for creating a project
 type CreateProjectDto struct {
-	ProjectName             string   `json:"projectName" binding:"required"`
-	ProjectCode             string   `json:"projectCode" binding:"required"`
-	ProjectComment          string   `json:"projectComment,omitempty"`
-	ProjectMuellef          string   `json:"projectMuellef,omitempty"`
-	Ada                     *int     `json:"ada,omitempty"`
-	Parsel                  *int     `json:"parsel,omitempty"`
-	TalepGucu               *int     `json:"talepGucu,omitempty"`
-	KuruluGuc               *int     `json:"kuruluGuc,omitempty"`
-	BagimsizBS              *int     `json:"bagimsizBS,omitempty"`
-	BlokS                   *int     `json:"blokS,omitempty"`
-	YapiYuksekligi          *float64 `json:"yapiYuksekligi,omitempty"`
-	RuhsatGecerlilikDate    string   `json:"ruhsatGecerlilikDate,omitempty"`
-	YapiSahibi              string   `json:"yapiSahibi,omitempty"`
-	Adress                  string   `json:"adress,omitempty"`
-	GroupID                 *int     `json:"groupId,omitempty"`
-	BildirimNo              string   `json:"bildirimNo,omitempty"`
+	ProjectName          string                   `json:"projectName" binding:"required"`
+	ProjectCode          string                   `json:"projectCode" binding:"required"`
+	ProjectComment       string                   `json:"projectComment,omitempty"`
+	ProjectMuellef       string                   `json:"projectMuellef,omitempty"`
+	Ada                  *valueobjects.Ada        `json:"ada,omitempty" swaggertype:"integer" minimum:"0" maximum:"99999"`
+	Parsel               *valueobjects.Parsel     `json:"parsel,omitempty" swaggertype:"integer" minimum:"1" maximum:"99999"`
+	TalepGucu            *valueobjects.Power      `json:"talepGucu,omitempty" swaggertype:"integer" minimum:"0"`
+	KuruluGuc            *valueobjects.Power      `json:"kuruluGuc,omitempty" swaggertype:"integer" minimum:"0"`
+	BagimsizBS           *int                     `json:"bagimsizBS,omitempty"`
+	BlokS                *int                     `json:"blokS,omitempty"`
+	YapiYuksekligi       *float64                 `json:"yapiYuksekligi,omitempty"`
+	RuhsatGecerlilikDate *valueobjects.PermitDate `json:"ruhsatGecerlilikDate,omitempty" swaggertype:"string" format:"date" example:"2026-12-31"`
+	YapiSahibi           string                   `json:"yapiSahibi,omitempty"`
+	Adress               string                   `json:"adress,omitempty"`
+	GroupID              *int                     `json:"groupId,omitempty"`
+	BildirimNo           string                   `json:"bildirimNo,omitempty"`
 }
 
 // UpdateProjectDto represents the input for updating a project
@@ -48,14 +50,62 @@ type UpdateProjectDto struct {
 	CreateProjectDto
 }
 
+// ProjectFilterDto holds the filters of the project list
+type ProjectFilterDto struct {
+	GroupID        int    `form:"groupId" json:"groupId,omitempty"`
+	BildirimNo     string `form:"bildirimNo" json:"bildirimNo,omitempty"`
+	ProjectCode    string `form:"projectCode" json:"projectCode,omitempty"`
+	ProjectName    string `form:"projectName" json:"projectName,omitempty"`
+	ProjectMuellef string `form:"projectMuellef" json:"projectMuellef,omitempty"`
+	IdList         []int  `form:"idList" json:"idList,omitempty"`
+}
+
 // PagedProjectResultRequestDto represents paged request for projects with filters
 type PagedProjectResultRequestDto struct {
 	PagedResultRequestDto
-	
-	GroupID        int      `form:"groupId" json:"groupId,omitempty"`
-	BildirimNo     string   `form:"bildirimNo" json:"bildirimNo,omitempty"`
-	ProjectCode    string   `form:"projectCode" json:"projectCode,omitempty"`
-	ProjectName    string   `form:"projectName" json:"projectName,omitempty"`
-	ProjectMuellef string   `form:"projectMuellef" json:"projectMuellef,omitempty"`
-	IdList         []int    `form:"idList" json:"idList,omitempty"`
+	ProjectFilterDto
+}
+
+// CursorProjectResultRequestDto represents keyset-paginated request for projects with filters
+type CursorProjectResultRequestDto struct {
+	CursorPagedResultRequestDto
+	ProjectFilterDto
+}
+
+// DocumentFieldValueDto is a field value read from one OCR document
+type DocumentFieldValueDto struct {
+	OcrProjectID int    `json:"ocrProjectId"`
+	Type         int    `json:"type"`
+	TypeName     string `json:"typeName"`
+	StatusName   string `json:"statusName"`
+	Value        string `json:"value"`
+}
+
+// FieldReconciliationDto compares one shared field across the project and its OCR documents
+type FieldReconciliationDto struct {
+	FieldName      string                  `json:"fieldName"`
+	ProjectValue   string                  `json:"projectValue"`
+	DocumentValues []DocumentFieldValueDto `json:"documentValues"`
+	AgreedValue    *string                 `json:"agreedValue,omitempty"`
+	Consistent     bool                    `json:"consistent"`
+	Discrepancies  []string                `json:"discrepancies,omitempty"`
+}
+
+// ReconciliationReportDto is the cross-document discrepancy report of a project
+type ReconciliationReportDto struct {
+	ProjectID        int                      `json:"projectId"`
+	HasDiscrepancies bool                     `json:"hasDiscrepancies"`
+	Fields           []FieldReconciliationDto `json:"fields"`
+}
+
+// ApplyReconciliationDto selects the agreed values to copy onto the project; empty applies all of them
+type ApplyReconciliationDto struct {
+	FieldNames []string `json:"fieldNames,omitempty"`
+}
+
+// ProjectExportRequestDto selects the projects to export with the filters of the project list
+type ProjectExportRequestDto struct {
+	ProjectFilterDto
+	Sorting string `form:"sorting" json:"sorting,omitempty"`
+	Filter  string `form:"filter" json:"filter,omitempty"`
 }
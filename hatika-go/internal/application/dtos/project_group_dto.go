@@ -0,0 +1,41 @@
+package dtos
+
+// ProjectGroupDto represents a project group data transfer object
+type ProjectGroupDto struct {
+	FullAuditedEntityDto
+
+	TenantID    *int   `json:"tenantId,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	ParentID    *int   `json:"parentId,omitempty"`
+}
+
+// CreateProjectGroupDto represents the input for creating a project group
+type CreateProjectGroupDto struct {
+	Name        string `json:"name" binding:"required,max=128"`
+	Description string `json:"description,omitempty"`
+	ParentID    *int   `json:"parentId,omitempty"`
+}
+
+// UpdateProjectGroupDto represents the input for updating a project group
+type UpdateProjectGroupDto struct {
+	CreateProjectGroupDto
+}
+
+// ProjectGroupStatsDto aggregates the projects of a group and the subgroups visible to the caller
+type ProjectGroupStatsDto struct {
+	GroupID                 int     `json:"groupId"`
+	Name                    string  `json:"name"`
+	ParentID                *int    `json:"parentId,omitempty"`
+	DirectProjectCount      int     `json:"directProjectCount"`
+	ProjectCount            int     `json:"projectCount"`
+	OcrProjectCount         int     `json:"ocrProjectCount"`
+	ApprovedOcrProjectCount int     `json:"approvedOcrProjectCount"`
+	OcrCompletionPercent    float64 `json:"ocrCompletionPercent"`
+}
+
+// ProjectGroupPermissionsDto lists the users granted access to a group. An empty list makes the
+// group visible to everyone again, unless a parent group is restricted.
+type ProjectGroupPermissionsDto struct {
+	UserIDs []int `json:"userIds" binding:"dive,min=1"`
+}
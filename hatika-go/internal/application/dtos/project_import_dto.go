@@ -0,0 +1,50 @@
+package dtos
+
+import "time"
+
+// ProjectImportRequestDto holds the form fields sent with an import file. Mapping is an optional JSON
+// object from column header to CreateProjectDto field name, e.g. {"Dosya No": "projectCode"}.
+type ProjectImportRequestDto struct {
+	DryRun  bool   `form:"dryRun"`
+	Mapping string `form:"mapping"`
+}
+
+// ProjectImportDto represents a project import job and its progress. Columns (header to field) and
+// IgnoredColumns are only set in the response to the upload.
+type ProjectImportDto struct {
+	ID                int               `json:"id"`
+	CreatedAt         time.Time         `json:"createdAt"`
+	FileName          string            `json:"fileName"`
+	Format            string            `json:"format"`
+	DryRun            bool              `json:"dryRun"`
+	Status            int               `json:"status"`
+	StatusName        string            `json:"statusName"`
+	Error             string            `json:"error,omitempty"`
+	Columns           map[string]string `json:"columns,omitempty"`
+	IgnoredColumns    []string          `json:"ignoredColumns,omitempty"`
+	TotalRows         int               `json:"totalRows"`
+	ProcessedRows     int               `json:"processedRows"`
+	CreatedCount      int               `json:"createdCount"`
+	UpdatedCount      int               `json:"updatedCount"`
+	FailedCount       int               `json:"failedCount"`
+	TriggeredByUserID *int              `json:"triggeredByUserId,omitempty"`
+	StartedAt         *time.Time        `json:"startedAt,omitempty"`
+	CompletedAt       *time.Time        `json:"completedAt,omitempty"`
+}
+
+// ProjectImportRowDto is the result of one row of an import
+type ProjectImportRowDto struct {
+	RowNumber   int      `json:"rowNumber"`
+	ProjectCode string   `json:"projectCode,omitempty"`
+	Action      int      `json:"action"`
+	ActionName  string   `json:"actionName"`
+	ProjectID   *int     `json:"projectId,omitempty"`
+	Errors      []string `json:"errors,omitempty"`
+}
+
+// ProjectImportRowsRequestDto pages through the row results of an import, optionally by action
+type ProjectImportRowsRequestDto struct {
+	PageNumber int  `form:"pageNumber" json:"pageNumber" binding:"required,min=1"`
+	PageSize   int  `form:"pageSize" json:"pageSize" binding:"required,min=1,max=1000"`
+	Action     *int `form:"action" json:"action,omitempty" binding:"omitempty,min=0,max=2"`
+}
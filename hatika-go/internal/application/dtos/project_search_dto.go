@@ -0,0 +1,27 @@
+package dtos
+
+// ProjectSearchRequestDto is a full-text search over projects and their OCR text
+type ProjectSearchRequestDto struct {
+	Query      string `form:"q" json:"q" binding:"required,min=2,max=200"`
+	PageNumber int    `form:"pageNumber" json:"pageNumber" binding:"required,min=1"`
+	PageSize   int    `form:"pageSize" json:"pageSize" binding:"required,min=1,max=100"`
+}
+
+// OcrTextMatchDto is the best matching page of a project's uploaded documents
+type OcrTextMatchDto struct {
+	DocumentID int    `json:"documentId"`
+	PageNumber int    `json:"pageNumber"`
+	Snippet    string `json:"snippet"`
+}
+
+// ProjectSearchResultDto is one ranked search hit. Highlights and the snippet are HTML-escaped text with
+// matches marked by <mark> tags.
+type ProjectSearchResultDto struct {
+	ID          int               `json:"id"`
+	ProjectCode string            `json:"projectCode"`
+	ProjectName string            `json:"projectName"`
+	GroupID     *int              `json:"groupId,omitempty"`
+	Rank        float64           `json:"rank"`
+	Highlights  map[string]string `json:"highlights"`
+	OcrMatch    *OcrTextMatchDto  `json:"ocrMatch,omitempty"`
+}
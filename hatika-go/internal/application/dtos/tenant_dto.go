@@ -0,0 +1,16 @@
+package dtos
+
+// TenantDto represents a tenant data transfer object
+type TenantDto struct {
+	FullAuditedEntityDto
+
+	TenancyName string `json:"tenancyName"`
+	Name        string `json:"name"`
+	IsActive    bool   `json:"isActive"`
+}
+
+// CreateTenantDto represents the input for creating a tenant
+type CreateTenantDto struct {
+	TenancyName string `json:"tenancyName" binding:"required,max=128,alphanum"`
+	Name        string `json:"name" binding:"required,max=256"`
+}
@@ -43,3 +43,14 @@ type PagedUserResultRequestDto struct {
 	Email    string `form:"email" json:"email,omitempty"`
 	IsActive *bool  `form:"isActive" json:"isActive,omitempty"`
 }
+
+// CreateAdminUserDto represents the input for creating an administrator, of a tenant or of the host
+// when TenancyName is empty
+type CreateAdminUserDto struct {
+	Username    string `json:"username" binding:"required,min=3,max=32"`
+	Email       string `json:"email" binding:"required,email"`
+	Password    string `json:"password" binding:"required,min=6"`
+	Name        string `json:"name" binding:"required"`
+	Surname     string `json:"surname,omitempty"`
+	TenancyName string `json:"tenancyName,omitempty"`
+}
@@ -0,0 +1,68 @@
+package dtos
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WebhookSubscriptionDto represents a webhook subscription; the secret is never returned after creation
+type WebhookSubscriptionDto struct {
+	FullAuditedEntityDto
+
+	TenantID    *int     `json:"tenantId,omitempty"`
+	URL         string   `json:"url"`
+	EventTypes  []string `json:"eventTypes"`
+	Description string   `json:"description,omitempty"`
+	IsActive    bool     `json:"isActive"`
+}
+
+// WebhookSubscriptionCreatedDto is a new subscription with its signing secret, shown this once
+type WebhookSubscriptionCreatedDto struct {
+	WebhookSubscriptionDto
+
+	Secret string `json:"secret"`
+}
+
+// CreateWebhookSubscriptionDto represents the input for creating a webhook subscription. Event types
+// are event names, or "*" for every event. Without a secret one is generated.
+type CreateWebhookSubscriptionDto struct {
+	URL         string   `json:"url" binding:"required,url,max=2048"`
+	EventTypes  []string `json:"eventTypes" binding:"required,min=1,dive,required"`
+	Description string   `json:"description,omitempty" binding:"max=500"`
+	Secret      string   `json:"secret,omitempty" binding:"omitempty,min=16,max=128"`
+	IsActive    *bool    `json:"isActive,omitempty"`
+}
+
+// UpdateWebhookSubscriptionDto represents the input for updating a webhook subscription; an empty
+// secret keeps the current one
+type UpdateWebhookSubscriptionDto struct {
+	CreateWebhookSubscriptionDto
+}
+
+// WebhookDeliveryDto is one entry of a subscription's delivery log
+type WebhookDeliveryDto struct {
+	ID                  int             `json:"id"`
+	CreatedAt           time.Time       `json:"createdAt"`
+	SubscriptionID      int             `json:"subscriptionId"`
+	EventID             string          `json:"eventId"`
+	EventName           string          `json:"eventName"`
+	Status              string          `json:"status"`
+	Attempts            int             `json:"attempts"`
+	NextAttemptAt       *time.Time      `json:"nextAttemptAt,omitempty"`
+	LastAttemptAt       *time.Time      `json:"lastAttemptAt,omitempty"`
+	ResponseStatusCode  *int            `json:"responseStatusCode,omitempty"`
+	ResponseBody        string          `json:"responseBody,omitempty"`
+	DurationMs          *int64          `json:"durationMs,omitempty"`
+	LastError           string          `json:"lastError,omitempty"`
+	DeliveredAt         *time.Time      `json:"deliveredAt,omitempty"`
+	RedeliveredByUserID *int            `json:"redeliveredByUserId,omitempty"`
+	RedeliveredAt       *time.Time      `json:"redeliveredAt,omitempty"`
+	Body                json.RawMessage `json:"body" swaggertype:"object"`
+}
+
+// WebhookDeliveryListRequestDto pages through the delivery log of a subscription
+type WebhookDeliveryListRequestDto struct {
+	PageNumber int    `form:"pageNumber" binding:"required,min=1"`
+	PageSize   int    `form:"pageSize" binding:"required,min=1,max=100"`
+	Status     string `form:"status" binding:"omitempty,oneof=Pending Succeeded DeadLettered"`
+}
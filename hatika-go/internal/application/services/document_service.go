@@ -0,0 +1,316 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/documents"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/infrastructure/storage"
+	apperrors "hatika-go/pkg/errors"
+)
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// UploadedFile is a file received from a client
+type UploadedFile interface {
+	io.Reader
+	io.ReaderAt
+	io.Seeker
+}
+
+// DocumentService splits uploaded multi-document PDFs across a project's OCR projects
+type DocumentService struct {
+	documentRepo   *persistence.ProjectDocumentRepository
+	projectRepo    *persistence.ProjectRepository
+	projectService *ProjectService
+	fileStorage    storage.FileStorage
+	renderer       documents.Renderer
+	classifier     documents.Classifier
+	maxSize        int64
+}
+
+// NewDocumentService creates a new document service
+func NewDocumentService(
+	documentRepo *persistence.ProjectDocumentRepository,
+	projectRepo *persistence.ProjectRepository,
+	projectService *ProjectService,
+	fileStorage storage.FileStorage,
+	renderer documents.Renderer,
+	classifier documents.Classifier,
+	maxSize int64,
+) *DocumentService {
+	return &DocumentService{
+		documentRepo:   documentRepo,
+		projectRepo:    projectRepo,
+		projectService: projectService,
+		fileStorage:    fileStorage,
+		renderer:       renderer,
+		classifier:     classifier,
+		maxSize:        maxSize,
+	}
+}
+
+// Upload stores a PDF, classifies each page and attaches the pages to the matching OCR project slots
+func (s *DocumentService) Upload(ctx context.Context, projectID int, fileName string, file UploadedFile, size int64, userID int) (*dtos.ProjectDocumentDto, error) {
+	project, err := s.getVisibleProject(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if size > s.maxSize {
+		return nil, apperrors.Validation("file exceeds the %d MB upload limit", s.maxSize>>20)
+	}
+
+	header := make([]byte, 5)
+	if _, err := file.ReadAt(header, 0); err != nil || !bytes.Equal(header, []byte("%PDF-")) {
+		return nil, apperrors.Validation("uploaded file is not a PDF")
+	}
+
+	pages, err := s.renderer.Render(ctx, file, size)
+	if errors.Is(err, documents.ErrTextRecognition) {
+		return nil, fmt.Errorf("failed to read scanned pages: %w", err)
+	}
+	if err != nil {
+		return nil, apperrors.Validation("could not read the PDF: %v", err)
+	}
+	if len(pages) == 0 {
+		return nil, apperrors.Validation("the PDF has no pages")
+	}
+	// Pages still without text would be split off unclassified and give the OCR engines nothing to read
+	if missing := documents.PagesWithoutText(pages); len(missing) > 0 {
+		return nil, apperrors.Validation("no text could be read from pages %v of the PDF", missing).
+			WithDetails(map[string][]int{"pages": missing})
+	}
+
+	assignments, err := documents.ClassifyPages(ctx, s.classifier, pages)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make(map[entities.OcrProjectType]int, len(project.OcrProjects))
+	for _, ocrProject := range project.OcrProjects {
+		slots[ocrProject.Type] = ocrProject.ID
+	}
+
+	document := &entities.ProjectDocument{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: project.TenantID},
+		ProjectID:         project.ID,
+		FileName:          fileName,
+		StorageKey:        documentStorageKey(project.ID, fileName),
+		ContentType:       "application/pdf",
+		PageCount:         len(pages),
+		Status:            entities.ProjectDocumentStatusSplit,
+		Pages:             make([]entities.DocumentPage, len(assignments)),
+	}
+	for i, assignment := range assignments {
+		page := entities.DocumentPage{
+			PageNumber:   assignment.Page.Number,
+			DetectedType: assignment.Type,
+			Confidence:   assignment.Confidence,
+			Text:         assignment.Page.Text,
+		}
+		if assignment.Type != nil {
+			if ocrProjectID, ok := slots[*assignment.Type]; ok {
+				page.OcrProjectID = &ocrProjectID
+			}
+		}
+		document.Pages[i] = page
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if document.SizeBytes, err = s.fileStorage.Save(ctx, document.StorageKey, file); err != nil {
+		return nil, fmt.Errorf("failed to store document: %w", err)
+	}
+
+	if err := s.documentRepo.Insert(ctx, document); err != nil {
+		s.fileStorage.Delete(ctx, document.StorageKey)
+		return nil, fmt.Errorf("failed to save document: %w", err)
+	}
+
+	dto := mapProjectDocumentToDto(document)
+	return &dto, nil
+}
+
+// GetAll lists the documents uploaded to a project
+func (s *DocumentService) GetAll(ctx context.Context, projectID int, userID int) ([]dtos.ProjectDocumentDto, error) {
+	if _, err := s.getVisibleProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	projectDocuments, err := s.documentRepo.GetByProjectIDIncludingPages(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+
+	result := make([]dtos.ProjectDocumentDto, len(projectDocuments))
+	for i := range projectDocuments {
+		result[i] = mapProjectDocumentToDto(&projectDocuments[i])
+	}
+	return result, nil
+}
+
+// GetByID returns a document of a project the user may see
+func (s *DocumentService) GetByID(ctx context.Context, projectID, id int, userID int) (*dtos.ProjectDocumentDto, error) {
+	if _, err := s.getVisibleProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	document, err := s.documentRepo.GetByIDIncludingPages(ctx, projectID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	dto := mapProjectDocumentToDto(document)
+	return &dto, nil
+}
+
+// ReassignPages corrects a misclassification by moving a page range to another OCR project of the same project
+func (s *DocumentService) ReassignPages(ctx context.Context, projectID, id int, input *dtos.ReassignDocumentPagesDto, userID int) (*dtos.ProjectDocumentDto, error) {
+	project, err := s.getVisibleProject(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := s.documentRepo.GetByIDIncludingPages(ctx, projectID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	if input.EndPage > document.PageCount {
+		return nil, apperrors.Validation("document has only %d pages", document.PageCount)
+	}
+
+	if input.OcrProjectID != nil {
+		found := false
+		for _, ocrProject := range project.OcrProjects {
+			found = found || ocrProject.ID == *input.OcrProjectID
+		}
+		if !found {
+			return nil, apperrors.Validation("OCR project %d does not belong to project %d", *input.OcrProjectID, projectID)
+		}
+	}
+
+	if err := s.documentRepo.AssignPages(ctx, document.ID, input.StartPage, input.EndPage, input.OcrProjectID); err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, projectID, id, userID)
+}
+
+// getVisibleProject loads a live project of the current tenant with its OCR projects, hiding projects
+// of groups the user may not see
+func (s *DocumentService) getVisibleProject(ctx context.Context, projectID int, userID int) (*entities.Project, error) {
+	project, err := s.projectRepo.GetByIDIncludingOcrProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if project.IsDeleted {
+		return nil, apperrors.NotFound("project with ID %d not found", projectID)
+	}
+	if err := s.projectService.ensureProjectVisible(ctx, project, userID); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// documentStorageKey builds a unique storage key that keeps a readable part of the file name
+func documentStorageKey(projectID int, fileName string) string {
+	safeName := unsafeFileNameChars.ReplaceAllString(filepath.Base(fileName), "_")
+	return fmt.Sprintf("projects/%d/%d-%s", projectID, time.Now().UnixNano(), safeName)
+}
+
+func mapProjectDocumentToDto(document *entities.ProjectDocument) dtos.ProjectDocumentDto {
+	return dtos.ProjectDocumentDto{
+		FullAuditedEntityDto: dtos.FullAuditedEntityDto{
+			AuditedEntityDto: dtos.AuditedEntityDto{
+				EntityDto: dtos.EntityDto{
+					ID: document.ID,
+				},
+				CreatedAt:      document.CreatedAt,
+				UpdatedAt:      document.UpdatedAt,
+				CreatorUserID:  document.CreatorUserID,
+				LastModifierID: document.LastModifierID,
+			},
+			DeleterUserID: document.DeleterUserID,
+			DeletionTime:  document.DeletionTime,
+			IsDeleted:     document.IsDeleted,
+			Version:       document.Version,
+		},
+		ProjectID:   document.ProjectID,
+		FileName:    document.FileName,
+		ContentType: document.ContentType,
+		SizeBytes:   document.SizeBytes,
+		PageCount:   document.PageCount,
+		Status:      int(document.Status),
+		StatusName:  document.Status.String(),
+		PageRanges:  pageRanges(document.Pages),
+	}
+}
+
+// pageRanges groups consecutive pages with the same assignment
+func pageRanges(pages []entities.DocumentPage) []dtos.DocumentPageRangeDto {
+	ranges := []dtos.DocumentPageRangeDto{}
+	for _, page := range pages {
+		if n := len(ranges); n > 0 && sameRange(&ranges[n-1], &page) {
+			last := &ranges[n-1]
+			last.EndPage = page.PageNumber
+			if page.Confidence < last.Confidence {
+				last.Confidence = page.Confidence
+			}
+			continue
+		}
+
+		pageRange := dtos.DocumentPageRangeDto{
+			StartPage:    page.PageNumber,
+			EndPage:      page.PageNumber,
+			OcrProjectID: page.OcrProjectID,
+			Confidence:   page.Confidence,
+			IsManual:     page.IsManual,
+		}
+		if page.DetectedType != nil {
+			detectedType := int(*page.DetectedType)
+			pageRange.DetectedType = &detectedType
+			pageRange.DetectedTypeName = page.DetectedType.String()
+		}
+		ranges = append(ranges, pageRange)
+	}
+	return ranges
+}
+
+func sameRange(pageRange *dtos.DocumentPageRangeDto, page *entities.DocumentPage) bool {
+	if pageRange.EndPage+1 != page.PageNumber || pageRange.IsManual != page.IsManual {
+		return false
+	}
+	if !equalIntPtr(pageRange.OcrProjectID, page.OcrProjectID) {
+		return false
+	}
+	if pageRange.OcrProjectID != nil {
+		return true
+	}
+
+	// Unassigned pages are grouped by the type they were detected as
+	var detectedType *int
+	if page.DetectedType != nil {
+		t := int(*page.DetectedType)
+		detectedType = &t
+	}
+	return equalIntPtr(pageRange.DetectedType, detectedType)
+}
+
+func equalIntPtr(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/events"
+	"hatika-go/internal/infrastructure/persistence"
+)
+
+// NotificationService handles the in-app notifications of users
+type NotificationService struct {
+	notificationRepo *persistence.NotificationRepository
+}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService(notificationRepo *persistence.NotificationRepository) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// GetAll pages through the user's notifications, newest first
+func (s *NotificationService) GetAll(ctx context.Context, request *dtos.NotificationListRequestDto, userID int) (*dtos.PagedResultDto[dtos.NotificationDto], error) {
+	notifications, totalCount, err := s.notificationRepo.GetPageForUser(ctx, userID, request.UnreadOnly, request.PageNumber, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dtos.NotificationDto, len(notifications))
+	for i := range notifications {
+		items[i] = mapNotificationToDto(&notifications[i])
+	}
+
+	return &dtos.PagedResultDto[dtos.NotificationDto]{
+		TotalCount: int(totalCount),
+		Items:      items,
+	}, nil
+}
+
+// MarkRead marks a notification of the user as read
+func (s *NotificationService) MarkRead(ctx context.Context, id int, userID int) (*dtos.NotificationDto, error) {
+	notification, err := s.notificationRepo.GetByIDForUser(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	notification.MarkRead()
+	if err := s.notificationRepo.Update(ctx, notification); err != nil {
+		return nil, fmt.Errorf("failed to update notification: %w", err)
+	}
+
+	dto := mapNotificationToDto(notification)
+	return &dto, nil
+}
+
+// OnOcrProjectProcessed tells the assigned reviewer that extracted fields wait for their review
+func (s *NotificationService) OnOcrProjectProcessed(ctx context.Context, event events.OcrProjectProcessed) error {
+	if !event.NeedsReview || event.ReviewerUserID == nil {
+		return nil
+	}
+
+	projectID := event.ProjectID
+	notification := entities.Notification{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: event.TenantID},
+		UserID:            *event.ReviewerUserID,
+		Type:              entities.NotificationTypeOcrReview,
+		Title:             "OCR incelemesi bekleniyor: " + event.ProjectCode,
+		Message:           fmt.Sprintf("%s projesinin %s OCR sonuçları incelemenizi bekliyor.", event.ProjectCode, event.Type),
+		ProjectID:         &projectID,
+	}
+	if err := s.notificationRepo.Insert(ctx, &notification); err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+func mapNotificationToDto(notification *entities.Notification) dtos.NotificationDto {
+	return dtos.NotificationDto{
+		ID:        notification.ID,
+		CreatedAt: notification.CreatedAt,
+		Type:      string(notification.Type),
+		Title:     notification.Title,
+		Message:   notification.Message,
+		ProjectID: notification.ProjectID,
+		ReadAt:    notification.ReadAt,
+	}
+}
@@ -0,0 +1,580 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/specifications"
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/eventbus"
+	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+)
+
+// OcrProjectService handles the OCR document review workflow
+type OcrProjectService struct {
+	ocrProjectRepo *persistence.OcrProjectRepository
+	projectRepo    *persistence.ProjectRepository
+	projectService *ProjectService
+	userRepo       *persistence.UserRepository
+	reviewConfig   config.OcrReviewConfig
+	unitOfWork     *persistence.UnitOfWork
+	eventBus       *eventbus.Bus
+}
+
+// NewOcrProjectService creates a new OCR project service
+func NewOcrProjectService(
+	ocrProjectRepo *persistence.OcrProjectRepository,
+	projectRepo *persistence.ProjectRepository,
+	projectService *ProjectService,
+	userRepo *persistence.UserRepository,
+	reviewConfig config.OcrReviewConfig,
+	unitOfWork *persistence.UnitOfWork,
+	eventBus *eventbus.Bus,
+) *OcrProjectService {
+	return &OcrProjectService{
+		ocrProjectRepo: ocrProjectRepo,
+		projectRepo:    projectRepo,
+		projectService: projectService,
+		userRepo:       userRepo,
+		reviewConfig:   reviewConfig,
+		unitOfWork:     unitOfWork,
+		eventBus:       eventBus,
+	}
+}
+
+func (s *OcrProjectService) GetByID(ctx context.Context, id int, userID int) (*dtos.OcrProjectDto, error) {
+	ocrProject, err := s.getVisible(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := s.mapToDto(ocrProject)
+	return &dto, nil
+}
+
+// GetReviewQueue lists OCR projects waiting for a reviewer whose projects the user may see
+func (s *OcrProjectService) GetReviewQueue(ctx context.Context, request *dtos.PagedReviewQueueRequestDto, userID int) (*dtos.PagedResultDto[dtos.OcrProjectDto], error) {
+	spec, err := s.ocrProjectRepo.ParseFilter(request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if request.ReviewerUserID != 0 {
+		spec = specifications.And(specifications.Equals("reviewerUserId", request.ReviewerUserID), spec)
+	}
+	groupIDs, err := s.projectService.visibleGroupIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ocrProjects, totalCount, err := s.ocrProjectRepo.GetReviewQueue(
+		ctx,
+		groupIDs,
+		request.PageNumber,
+		request.PageSize,
+		request.Sorting,
+		spec,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review queue: %w", err)
+	}
+
+	items := make([]dtos.OcrProjectDto, len(ocrProjects))
+	for i := range ocrProjects {
+		items[i] = s.mapToDto(&ocrProjects[i])
+	}
+
+	return &dtos.PagedResultDto[dtos.OcrProjectDto]{
+		TotalCount: int(totalCount),
+		Items:      items,
+	}, nil
+}
+
+// StartProcessing marks a pending or rejected OCR project as being processed
+func (s *OcrProjectService) StartProcessing(ctx context.Context, id int, userID int) (*dtos.OcrProjectDto, error) {
+	ocrProject, err := s.getVisible(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ocrProject.TransitionTo(entities.OcrProjectStatusProcessing); err != nil {
+		return nil, apperrors.Conflict("%v", err)
+	}
+	ocrProject.RejectionReason = ""
+	ocrProject.LastModifierID = &userID
+
+	if err := s.ocrProjectRepo.Update(ctx, ocrProject); err != nil {
+		return nil, fmt.Errorf("failed to update OCR project: %w", err)
+	}
+
+	dto := s.mapToDto(ocrProject)
+	return &dto, nil
+}
+
+// SubmitResults stores extracted fields. Fields under their confidence threshold send the
+// OCR project to the review queue; otherwise it is approved and its values flow into the project.
+func (s *OcrProjectService) SubmitResults(ctx context.Context, id int, input *dtos.SubmitOcrResultsDto, userID int) (*dtos.OcrProjectDto, error) {
+	ocrProject, err := s.getVisible(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ocrProject.Status == entities.OcrProjectStatusPending {
+		if err := ocrProject.TransitionTo(entities.OcrProjectStatusProcessing); err != nil {
+			return nil, apperrors.Conflict("%v", err)
+		}
+	}
+	if ocrProject.Status != entities.OcrProjectStatusProcessing {
+		return nil, apperrors.Conflict("cannot submit results for an OCR project in status %s", ocrProject.Status)
+	}
+
+	results := make([]entities.OcrFieldResult, 0, len(input.Fields))
+	seen := make(map[string]bool, len(input.Fields))
+	needsReview := false
+	for _, field := range input.Fields {
+		if !entities.IsOcrField(field.FieldName) {
+			return nil, apperrors.Validation("unknown OCR field %q", field.FieldName)
+		}
+		if seen[field.FieldName] {
+			return nil, apperrors.Validation("field %q submitted more than once", field.FieldName)
+		}
+		seen[field.FieldName] = true
+
+		result := entities.OcrFieldResult{
+			FieldName:  field.FieldName,
+			Value:      formatOcrValue(field.Value),
+			Confidence: field.Confidence,
+		}
+
+		// Values that do not parse are kept for the reviewer instead of being dropped
+		parseErr := ocrProject.SetOcrField(result.FieldName, result.Value)
+		result.NeedsReview = parseErr != nil || result.Confidence < s.threshold(result.FieldName)
+		needsReview = needsReview || result.NeedsReview
+
+		results = append(results, result)
+	}
+
+	var project *entities.Project
+	ocrProject.FieldResults = results
+	ocrProject.LastModifierID = &userID
+	if needsReview {
+		if err := ocrProject.TransitionTo(entities.OcrProjectStatusNeedsReview); err != nil {
+			return nil, apperrors.Conflict("%v", err)
+		}
+	} else {
+		for i := range ocrProject.FieldResults {
+			ocrProject.FieldResults[i].Approve(ocrProject.FieldResults[i].Value, nil)
+		}
+		if err := ocrProject.Approve(nil); err != nil {
+			return nil, apperrors.Conflict("%v", err)
+		}
+
+		if project, err = s.applyApprovedValues(ctx, ocrProject); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.ocrProjectRepo.ReplaceFieldResults(ctx, ocrProject, project); err != nil {
+			return fmt.Errorf("failed to store OCR results: %w", err)
+		}
+		return s.eventBus.Raise(ctx, ocrProject.PullEvents()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dto := s.mapToDto(ocrProject)
+	return &dto, nil
+}
+
+// Patch partially updates the values of an OCR project, e.g. to correct what OCR read. apply receives
+// the current values and changes the ones the client sent; only the columns whose values differ are
+// written.
+func (s *OcrProjectService) Patch(ctx context.Context, id int, apply func(input *dtos.UpdateOcrProjectDto) error, userID int, expectedVersion *int) (*dtos.OcrProjectDto, error) {
+	ocrProject, err := s.getVisible(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureVersion("OCR project", ocrProject.ID, ocrProject.Version, expectedVersion); err != nil {
+		return nil, err
+	}
+	if ocrProject.Status == entities.OcrProjectStatusProcessing {
+		return nil, apperrors.Conflict("cannot change an OCR project while it is being processed")
+	}
+
+	input := dtos.UpdateOcrProjectDto{
+		ProjectName:          ocrProject.ProjectName,
+		ProjectCode:          ocrProject.ProjectCode,
+		ProjectComment:       ocrProject.ProjectComment,
+		ProjectMuellef:       ocrProject.ProjectMuellef,
+		Ada:                  ocrProject.Ada,
+		Parsel:               ocrProject.Parsel,
+		TalepGucu:            ocrProject.TalepGucu,
+		KuruluGuc:            ocrProject.KuruluGuc,
+		BagimsizBS:           ocrProject.BagimsizBS,
+		BlokS:                ocrProject.BlokS,
+		YapiYuksekligi:       ocrProject.YapiYuksekligi,
+		RuhsatGecerlilikDate: ocrProject.RuhsatGecerlilikDate,
+		YapiSahibi:           ocrProject.YapiSahibi,
+		Adress:               ocrProject.Adress,
+		PdfPath:              ocrProject.PdfPath,
+	}
+	if err := apply(&input); err != nil {
+		return nil, err
+	}
+
+	var columns []string
+	patchField(&columns, "project_name", &ocrProject.ProjectName, input.ProjectName)
+	patchField(&columns, "project_code", &ocrProject.ProjectCode, input.ProjectCode)
+	patchField(&columns, "project_comment", &ocrProject.ProjectComment, input.ProjectComment)
+	patchField(&columns, "project_muellef", &ocrProject.ProjectMuellef, input.ProjectMuellef)
+	patchField(&columns, "ada", &ocrProject.Ada, input.Ada)
+	patchField(&columns, "parsel", &ocrProject.Parsel, input.Parsel)
+	patchField(&columns, "talep_gucu", &ocrProject.TalepGucu, input.TalepGucu)
+	patchField(&columns, "kurulu_guc", &ocrProject.KuruluGuc, input.KuruluGuc)
+	patchField(&columns, "bagimsiz_bs", &ocrProject.BagimsizBS, input.BagimsizBS)
+	patchField(&columns, "blok_s", &ocrProject.BlokS, input.BlokS)
+	patchField(&columns, "yapi_yuksekligi", &ocrProject.YapiYuksekligi, input.YapiYuksekligi)
+	patchField(&columns, "ruhsat_gecerlilik_date", &ocrProject.RuhsatGecerlilikDate, input.RuhsatGecerlilikDate)
+	patchField(&columns, "yapi_sahibi", &ocrProject.YapiSahibi, input.YapiSahibi)
+	patchField(&columns, "adress", &ocrProject.Adress, input.Adress)
+	patchField(&columns, "pdf_path", &ocrProject.PdfPath, input.PdfPath)
+
+	if len(columns) > 0 {
+		ocrProject.LastModifierID = &userID
+		columns = append(columns, "last_modifier_id")
+		if err := s.ocrProjectRepo.UpdateColumns(ctx, ocrProject, columns...); err != nil {
+			return nil, fmt.Errorf("failed to update OCR project: %w", err)
+		}
+	}
+
+	dto := s.mapToDto(ocrProject)
+	return &dto, nil
+}
+
+// AssignReviewer assigns the user responsible for reviewing an OCR project
+func (s *OcrProjectService) AssignReviewer(ctx context.Context, id int, input *dtos.AssignReviewerDto, userID int, expectedVersion *int) (*dtos.OcrProjectDto, error) {
+	if err := s.ensureCanReview(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	ocrProject, err := s.getVisible(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureVersion("OCR project", ocrProject.ID, ocrProject.Version, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	if ocrProject.Status != entities.OcrProjectStatusNeedsReview {
+		return nil, apperrors.Conflict("cannot assign a reviewer to an OCR project in status %s", ocrProject.Status)
+	}
+	if err := s.ensureReviewerCandidate(ctx, input.ReviewerUserID); err != nil {
+		return nil, err
+	}
+
+	reviewerUserID := input.ReviewerUserID
+	ocrProject.ReviewerUserID = &reviewerUserID
+	ocrProject.LastModifierID = &userID
+
+	if err := s.ocrProjectRepo.Update(ctx, ocrProject); err != nil {
+		return nil, fmt.Errorf("failed to assign reviewer: %w", err)
+	}
+
+	dto := s.mapToDto(ocrProject)
+	return &dto, nil
+}
+
+// Approve records the reviewer's decisions and applies every approved value to the parent project
+func (s *OcrProjectService) Approve(ctx context.Context, id int, userID int, input *dtos.ApproveOcrProjectDto, expectedVersion *int) (*dtos.OcrProjectDto, error) {
+	if err := s.ensureCanReview(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	ocrProject, err := s.getVisible(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureVersion("OCR project", ocrProject.ID, ocrProject.Version, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkReviewer(ocrProject, userID); err != nil {
+		return nil, err
+	}
+	if ocrProject.Status != entities.OcrProjectStatusNeedsReview {
+		return nil, apperrors.Conflict("cannot approve an OCR project in status %s", ocrProject.Status)
+	}
+
+	extracted := make(map[string]bool, len(ocrProject.FieldResults))
+	for _, result := range ocrProject.FieldResults {
+		extracted[result.FieldName] = true
+	}
+
+	decisions := make(map[string]*string, len(input.Fields))
+	for _, field := range input.Fields {
+		if !extracted[field.FieldName] {
+			return nil, apperrors.Validation("field %q was not extracted for this OCR project", field.FieldName)
+		}
+		if _, exists := decisions[field.FieldName]; exists {
+			return nil, apperrors.Validation("field %q approved more than once", field.FieldName)
+		}
+		decisions[field.FieldName] = field.Value
+	}
+
+	for i := range ocrProject.FieldResults {
+		result := &ocrProject.FieldResults[i]
+		value, decided := decisions[result.FieldName]
+
+		if !decided {
+			if result.NeedsReview && !result.IsApproved {
+				return nil, apperrors.Validation("field %q needs a review decision", result.FieldName)
+			}
+			if !result.IsApproved {
+				result.Approve(result.Value, nil)
+			}
+			continue
+		}
+
+		approvedValue := result.Value
+		if value != nil {
+			approvedValue = *value
+		}
+		if err := ocrProject.SetOcrField(result.FieldName, approvedValue); err != nil {
+			return nil, apperrors.Validation("%v", err)
+		}
+		reviewer := userID
+		result.Approve(approvedValue, &reviewer)
+	}
+
+	if err := ocrProject.Approve(&userID); err != nil {
+		return nil, apperrors.Conflict("%v", err)
+	}
+
+	project, err := s.applyApprovedValues(ctx, ocrProject)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.ocrProjectRepo.SaveReview(ctx, ocrProject, project); err != nil {
+			return fmt.Errorf("failed to approve OCR project: %w", err)
+		}
+		return s.eventBus.Raise(ctx, ocrProject.PullEvents()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dto := s.mapToDto(ocrProject)
+	return &dto, nil
+}
+
+// Reject sends an OCR project back so it can be processed again
+func (s *OcrProjectService) Reject(ctx context.Context, id int, userID int, input *dtos.RejectOcrProjectDto, expectedVersion *int) (*dtos.OcrProjectDto, error) {
+	if err := s.ensureCanReview(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	ocrProject, err := s.getVisible(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureVersion("OCR project", ocrProject.ID, ocrProject.Version, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkReviewer(ocrProject, userID); err != nil {
+		return nil, err
+	}
+	if err := ocrProject.TransitionTo(entities.OcrProjectStatusRejected); err != nil {
+		return nil, apperrors.Conflict("%v", err)
+	}
+	ocrProject.RejectionReason = input.Reason
+	ocrProject.LastModifierID = &userID
+
+	if err := s.ocrProjectRepo.SaveReview(ctx, ocrProject, nil); err != nil {
+		return nil, fmt.Errorf("failed to reject OCR project: %w", err)
+	}
+
+	dto := s.mapToDto(ocrProject)
+	return &dto, nil
+}
+
+// getVisible loads an OCR project with its field results, hiding it when the user may not see the
+// group of its project
+func (s *OcrProjectService) getVisible(ctx context.Context, id int, userID int) (*entities.OcrProject, error) {
+	ocrProject, err := s.ocrProjectRepo.GetByIDIncludingFieldResults(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCR project: %w", err)
+	}
+	project, err := s.projectRepo.GetByID(ctx, ocrProject.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent project: %w", err)
+	}
+	if err := s.projectService.ensureProjectVisible(ctx, project, userID); err != nil {
+		if apperrors.IsKind(err, apperrors.KindNotFound) {
+			return nil, apperrors.NotFound("OCR project with ID %d not found", id)
+		}
+		return nil, err
+	}
+	return ocrProject, nil
+}
+
+// ensureCanReview allows only users whose roles grant the review permission to decide on extractions
+func (s *OcrProjectService) ensureCanReview(ctx context.Context, userID int) error {
+	allowed, err := s.userRepo.HasPermission(ctx, userID, entities.OcrProjectsReview)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return apperrors.Forbidden("reviewing OCR projects requires the %s permission", entities.OcrProjectsReview)
+	}
+	return nil
+}
+
+// ensureReviewerCandidate allows assigning only live users of the current tenant who may review
+func (s *OcrProjectService) ensureReviewerCandidate(ctx context.Context, reviewerUserID int) error {
+	existing, err := s.userRepo.GetExistingIDs(ctx, []int{reviewerUserID})
+	if err != nil {
+		return err
+	}
+	if len(existing) == 0 {
+		return apperrors.Validation("user with ID %d not found", reviewerUserID)
+	}
+
+	allowed, err := s.userRepo.HasPermission(ctx, reviewerUserID, entities.OcrProjectsReview)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return apperrors.Validation("user %d does not have the %s permission", reviewerUserID, entities.OcrProjectsReview)
+	}
+	return nil
+}
+
+// checkReviewer allows only the assigned reviewer to decide, when one is assigned
+func (s *OcrProjectService) checkReviewer(ocrProject *entities.OcrProject, userID int) error {
+	if ocrProject.ReviewerUserID != nil && *ocrProject.ReviewerUserID != userID {
+		return apperrors.Forbidden("OCR project %d is assigned to another reviewer", ocrProject.ID)
+	}
+	return nil
+}
+
+// applyApprovedValues copies approved field values onto the parent project
+func (s *OcrProjectService) applyApprovedValues(ctx context.Context, ocrProject *entities.OcrProject) (*entities.Project, error) {
+	project, err := s.projectRepo.GetByID(ctx, ocrProject.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent project: %w", err)
+	}
+
+	for _, result := range ocrProject.FieldResults {
+		if !result.IsApproved || result.ApprovedValue == nil {
+			continue
+		}
+		if err := project.SetOcrField(result.FieldName, *result.ApprovedValue); err != nil {
+			return nil, apperrors.Validation("%v", err)
+		}
+	}
+
+	return project, nil
+}
+
+// threshold returns the confidence a field needs to skip review; viper lowercases map keys
+func (s *OcrProjectService) threshold(fieldName string) float64 {
+	for name, threshold := range s.reviewConfig.FieldThresholds {
+		if strings.EqualFold(name, fieldName) {
+			return threshold
+		}
+	}
+	return s.reviewConfig.DefaultThreshold
+}
+
+// formatOcrValue converts a decoded JSON value to the string stored for a field
+func formatOcrValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (s *OcrProjectService) mapToDto(ocrProject *entities.OcrProject) dtos.OcrProjectDto {
+	dto := mapOcrProjectToDto(ocrProject)
+
+	if ocrProject.FieldResults != nil {
+		dto.FieldResults = make([]dtos.OcrFieldResultDto, len(ocrProject.FieldResults))
+		for i, result := range ocrProject.FieldResults {
+			dto.FieldResults[i] = dtos.OcrFieldResultDto{
+				ID:               result.ID,
+				FieldName:        result.FieldName,
+				Value:            result.Value,
+				Confidence:       result.Confidence,
+				Threshold:        s.threshold(result.FieldName),
+				NeedsReview:      result.NeedsReview,
+				IsApproved:       result.IsApproved,
+				ApprovedValue:    result.ApprovedValue,
+				ApprovedByUserID: result.ApprovedByUserID,
+				ApprovedAt:       result.ApprovedAt,
+			}
+		}
+	}
+
+	return dto
+}
+
+// mapOcrProjectToDto converts an OCR project entity to DTO
+func mapOcrProjectToDto(ocrProj *entities.OcrProject) dtos.OcrProjectDto {
+	return dtos.OcrProjectDto{
+		FullAuditedEntityDto: dtos.FullAuditedEntityDto{
+			AuditedEntityDto: dtos.AuditedEntityDto{
+				EntityDto: dtos.EntityDto{
+					ID: ocrProj.ID,
+				},
+				CreatedAt:      ocrProj.CreatedAt,
+				UpdatedAt:      ocrProj.UpdatedAt,
+				CreatorUserID:  ocrProj.CreatorUserID,
+				LastModifierID: ocrProj.LastModifierID,
+			},
+			DeleterUserID: ocrProj.DeleterUserID,
+			DeletionTime:  ocrProj.DeletionTime,
+			IsDeleted:     ocrProj.IsDeleted,
+			Version:       ocrProj.Version,
+		},
+		ProjectName:          ocrProj.ProjectName,
+		ProjectCode:          ocrProj.ProjectCode,
+		ProjectComment:       ocrProj.ProjectComment,
+		ProjectMuellef:       ocrProj.ProjectMuellef,
+		Ada:                  ocrProj.Ada,
+		Parsel:               ocrProj.Parsel,
+		TalepGucu:            ocrProj.TalepGucu,
+		KuruluGuc:            ocrProj.KuruluGuc,
+		BagimsizBS:           ocrProj.BagimsizBS,
+		BlokS:                ocrProj.BlokS,
+		YapiYuksekligi:       ocrProj.YapiYuksekligi,
+		RuhsatGecerlilikDate: ocrProj.RuhsatGecerlilikDate,
+		YapiSahibi:           ocrProj.YapiSahibi,
+		Adress:               ocrProj.Adress,
+		Type:                 int(ocrProj.Type),
+		TypeName:             ocrProj.Type.String(),
+		ProjectID:            ocrProj.ProjectID,
+		PdfPath:              ocrProj.PdfPath,
+		Status:               int(ocrProj.Status),
+		StatusName:           ocrProj.Status.String(),
+		ReviewerUserID:       ocrProj.ReviewerUserID,
+		ApprovedByUserID:     ocrProj.ApprovedByUserID,
+		ApprovedAt:           ocrProj.ApprovedAt,
+		RejectionReason:      ocrProj.RejectionReason,
+		IsOptional:           ocrProj.IsOptional,
+	}
+}
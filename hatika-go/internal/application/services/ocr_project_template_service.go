@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
+)
+
+// OcrProjectTemplateService manages which OCR documents new projects get
+type OcrProjectTemplateService struct {
+	templateRepo *persistence.OcrProjectTemplateRepository
+}
+
+// NewOcrProjectTemplateService creates a new OCR project template service
+func NewOcrProjectTemplateService(templateRepo *persistence.OcrProjectTemplateRepository) *OcrProjectTemplateService {
+	return &OcrProjectTemplateService{
+		templateRepo: templateRepo,
+	}
+}
+
+// GetAll lists the templates of the current tenant
+func (s *OcrProjectTemplateService) GetAll(ctx context.Context) ([]dtos.OcrProjectTemplateDto, error) {
+	templates, err := s.templateRepo.GetAllIncludingItems(ctx, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCR project templates: %w", err)
+	}
+
+	result := make([]dtos.OcrProjectTemplateDto, len(templates))
+	for i := range templates {
+		result[i] = mapOcrProjectTemplateToDto(&templates[i])
+	}
+	return result, nil
+}
+
+func (s *OcrProjectTemplateService) GetByID(ctx context.Context, id int) (*dtos.OcrProjectTemplateDto, error) {
+	template, err := s.templateRepo.GetByIDIncludingItems(ctx, id, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCR project template: %w", err)
+	}
+
+	dto := mapOcrProjectTemplateToDto(template)
+	return &dto, nil
+}
+
+// Create adds a template for the current tenant; only one template may cover a tenant and group
+func (s *OcrProjectTemplateService) Create(ctx context.Context, input *dtos.CreateOcrProjectTemplateDto) (*dtos.OcrProjectTemplateDto, error) {
+	template := &entities.OcrProjectTemplate{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: multitenancy.TenantIDFromContext(ctx)},
+	}
+	if err := s.applyInput(ctx, template, input); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.Insert(ctx, template); err != nil {
+		return nil, fmt.Errorf("failed to create OCR project template: %w", err)
+	}
+
+	dto := mapOcrProjectTemplateToDto(template)
+	return &dto, nil
+}
+
+func (s *OcrProjectTemplateService) Update(ctx context.Context, id int, input *dtos.UpdateOcrProjectTemplateDto) (*dtos.OcrProjectTemplateDto, error) {
+	template, err := s.templateRepo.GetByIDIncludingItems(ctx, id, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCR project template: %w", err)
+	}
+
+	if err := s.applyInput(ctx, template, &input.CreateOcrProjectTemplateDto); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.UpdateWithItems(ctx, template); err != nil {
+		return nil, fmt.Errorf("failed to update OCR project template: %w", err)
+	}
+
+	dto := mapOcrProjectTemplateToDto(template)
+	return &dto, nil
+}
+
+// Delete removes a template; existing projects keep their OCR projects
+func (s *OcrProjectTemplateService) Delete(ctx context.Context, id int, userID int) error {
+	if _, err := s.templateRepo.GetByIDIncludingItems(ctx, id, multitenancy.TenantIDFromContext(ctx)); err != nil {
+		return fmt.Errorf("failed to get OCR project template: %w", err)
+	}
+
+	if err := s.templateRepo.SoftDelete(ctx, id, userID); err != nil {
+		return fmt.Errorf("failed to delete OCR project template: %w", err)
+	}
+	return nil
+}
+
+func (s *OcrProjectTemplateService) applyInput(ctx context.Context, template *entities.OcrProjectTemplate, input *dtos.CreateOcrProjectTemplateDto) error {
+	exists, err := s.templateRepo.ExistsForScope(ctx, template.TenantID, input.GroupID, template.ID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return apperrors.Conflict("a template for this tenant and group already exists")
+	}
+
+	codePattern := strings.TrimSpace(input.CodePattern)
+	if codePattern == "" {
+		codePattern = entities.DefaultOcrCodePattern
+	}
+	if !strings.Contains(codePattern, "{index}") && !strings.Contains(codePattern, "{type}") {
+		return apperrors.Validation("code pattern must contain {index} or {type} so OCR project codes stay unique")
+	}
+
+	items := make([]entities.OcrProjectTemplateItem, len(input.Items))
+	seen := make(map[entities.OcrProjectType]bool, len(input.Items))
+	for i, item := range input.Items {
+		ocrProjectType := entities.OcrProjectType(item.Type)
+		if !ocrProjectType.IsValid() {
+			return apperrors.Validation("unknown OCR project type %d", item.Type)
+		}
+		if seen[ocrProjectType] {
+			return apperrors.Validation("OCR project type %s is listed more than once", ocrProjectType)
+		}
+		seen[ocrProjectType] = true
+
+		items[i] = entities.OcrProjectTemplateItem{
+			Type:       ocrProjectType,
+			IsOptional: item.IsOptional,
+			SortOrder:  i,
+		}
+	}
+
+	template.Name = input.Name
+	template.GroupID = input.GroupID
+	template.CodePattern = codePattern
+	template.Items = items
+	template.IsActive = true
+	if input.IsActive != nil {
+		template.IsActive = *input.IsActive
+	}
+	return nil
+}
+
+// resolveOcrProjectTemplate returns the template that applies to a new project, falling back to the built-in one
+func resolveOcrProjectTemplate(
+	ctx context.Context,
+	templateRepo *persistence.OcrProjectTemplateRepository,
+	tenantID *int,
+	groupID *int,
+) (*entities.OcrProjectTemplate, error) {
+	template, err := templateRepo.FindForProject(ctx, tenantID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return entities.DefaultOcrProjectTemplate(), nil
+	}
+	return template, nil
+}
+
+func mapOcrProjectTemplateToDto(template *entities.OcrProjectTemplate) dtos.OcrProjectTemplateDto {
+	dto := dtos.OcrProjectTemplateDto{
+		FullAuditedEntityDto: dtos.FullAuditedEntityDto{
+			AuditedEntityDto: dtos.AuditedEntityDto{
+				EntityDto: dtos.EntityDto{
+					ID: template.ID,
+				},
+				CreatedAt:      template.CreatedAt,
+				UpdatedAt:      template.UpdatedAt,
+				CreatorUserID:  template.CreatorUserID,
+				LastModifierID: template.LastModifierID,
+			},
+			DeleterUserID: template.DeleterUserID,
+			DeletionTime:  template.DeletionTime,
+			IsDeleted:     template.IsDeleted,
+			Version:       template.Version,
+		},
+		TenantID:    template.TenantID,
+		Name:        template.Name,
+		GroupID:     template.GroupID,
+		CodePattern: template.CodePattern,
+		IsActive:    template.IsActive,
+		Items:       make([]dtos.OcrProjectTemplateItemDto, len(template.Items)),
+	}
+
+	for i, item := range template.Items {
+		dto.Items[i] = dtos.OcrProjectTemplateItemDto{
+			ID:         item.ID,
+			Type:       int(item.Type),
+			TypeName:   item.Type.String(),
+			IsOptional: item.IsOptional,
+			SortOrder:  item.SortOrder,
+		}
+	}
+
+	return dto
+}
@@ -0,0 +1,649 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/specifications"
+	"hatika-go/internal/infrastructure/ocr"
+	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
+)
+
+const defaultBulkReprocessLimit = 100
+
+// OcrRunService re-runs OCR extraction with a chosen engine and compares the results of runs
+type OcrRunService struct {
+	runRepo           *persistence.OcrRunRepository
+	ocrProjectRepo    *persistence.OcrProjectRepository
+	documentRepo      *persistence.ProjectDocumentRepository
+	projectService    *ProjectService
+	ocrProjectService *OcrProjectService
+	engines           *ocr.Registry
+}
+
+// NewOcrRunService creates a new OCR run service
+func NewOcrRunService(
+	runRepo *persistence.OcrRunRepository,
+	ocrProjectRepo *persistence.OcrProjectRepository,
+	documentRepo *persistence.ProjectDocumentRepository,
+	projectService *ProjectService,
+	ocrProjectService *OcrProjectService,
+	engines *ocr.Registry,
+) *OcrRunService {
+	return &OcrRunService{
+		runRepo:           runRepo,
+		ocrProjectRepo:    ocrProjectRepo,
+		documentRepo:      documentRepo,
+		projectService:    projectService,
+		ocrProjectService: ocrProjectService,
+		engines:           engines,
+	}
+}
+
+// GetEngines lists the configured extraction engines
+func (s *OcrRunService) GetEngines() []dtos.OcrEngineDto {
+	defaultEngine, _ := s.engines.Get("")
+
+	engines := s.engines.Engines()
+	result := make([]dtos.OcrEngineDto, len(engines))
+	for i, engine := range engines {
+		result[i] = dtos.OcrEngineDto{
+			Name:      engine.Name(),
+			Version:   engine.Version(),
+			IsDefault: defaultEngine != nil && engine.Name() == defaultEngine.Name(),
+		}
+	}
+	return result
+}
+
+// GetByID returns a run of an OCR project the user may see
+func (s *OcrRunService) GetByID(ctx context.Context, id int, userID int) (*dtos.OcrRunDto, error) {
+	run, err := s.getVisibleRun(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := mapOcrRunToDto(run)
+	return &dto, nil
+}
+
+// GetByOcrProjectID lists the runs of an OCR project, newest first
+func (s *OcrRunService) GetByOcrProjectID(ctx context.Context, ocrProjectID int, userID int) ([]dtos.OcrRunDto, error) {
+	if _, err := s.getVisibleOcrProject(ctx, ocrProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	runs, err := s.runRepo.GetByOcrProjectID(ctx, ocrProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCR runs: %w", err)
+	}
+
+	return mapOcrRunsToDto(runs), nil
+}
+
+// GetBatch lists the runs queued by a bulk re-processing request for OCR projects the user may see
+func (s *OcrRunService) GetBatch(ctx context.Context, batchID string, userID int) ([]dtos.OcrRunDto, error) {
+	groupIDs, err := s.projectService.visibleGroupIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := s.runRepo.GetByBatchID(ctx, batchID, groupIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCR runs: %w", err)
+	}
+	if len(runs) == 0 {
+		return nil, apperrors.NotFound("OCR run batch %s not found", batchID)
+	}
+
+	return mapOcrRunsToDto(runs), nil
+}
+
+// Reprocess queues a run for one OCR project and processes it in the background, so no request
+// transaction is held while the engine works; the run is followed through GetByID
+func (s *OcrRunService) Reprocess(ctx context.Context, ocrProjectID int, userID int, input *dtos.ReprocessOcrProjectDto) (*dtos.OcrRunDto, error) {
+	ocrProject, err := s.getVisibleOcrProject(ctx, ocrProjectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := s.engines.Get(input.EngineName)
+	if err != nil {
+		return nil, apperrors.Validation("%v", err)
+	}
+
+	if input.Apply && !canApplyRun(ocrProject) {
+		return nil, apperrors.Conflict("cannot apply a run to an OCR project in status %s", ocrProject.Status)
+	}
+
+	run := newOcrRun(ocrProject, engine, userID, input.Apply, "")
+	if err := s.runRepo.Insert(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to create OCR run: %w", err)
+	}
+
+	// The queued run must be committed before the background work updates it
+	background := persistence.WithoutUnitOfWork(context.WithoutCancel(ctx))
+	runs := []entities.OcrRun{*run}
+	persistence.AfterCommit(ctx, func() {
+		go s.processBatch(background, engine, runs)
+	})
+
+	dto := mapOcrRunToDto(run)
+	return &dto, nil
+}
+
+// BulkReprocess queues a run for every OCR project matching the filter whose project the user may see
+// and processes them in the background
+func (s *OcrRunService) BulkReprocess(ctx context.Context, userID int, input *dtos.BulkReprocessDto) (*dtos.BulkReprocessResultDto, error) {
+	engine, err := s.engines.Get(input.EngineName)
+	if err != nil {
+		return nil, apperrors.Validation("%v", err)
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultBulkReprocessLimit
+	}
+
+	var specs []specifications.Specification
+	if input.ProjectID != nil {
+		specs = append(specs, specifications.Equals("projectId", *input.ProjectID))
+	}
+	if input.Type != nil {
+		if !entities.OcrProjectType(*input.Type).IsValid() {
+			return nil, apperrors.Validation("unknown OCR project type %d", *input.Type)
+		}
+		specs = append(specs, specifications.Equals("type", *input.Type))
+	}
+	if input.Status != nil {
+		specs = append(specs, specifications.Equals("status", *input.Status))
+	}
+
+	groupIDs, err := s.projectService.visibleGroupIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ocrProjects, err := s.ocrProjectRepo.FindForReprocess(
+		ctx,
+		multitenancy.TenantIDFromContext(ctx),
+		groupIDs,
+		specifications.And(specs...),
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find OCR projects: %w", err)
+	}
+
+	batchID, err := newBatchID()
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]entities.OcrRun, 0, len(ocrProjects))
+	for i := range ocrProjects {
+		// Applying only makes sense where the workflow still accepts results
+		if input.Apply && !canApplyRun(&ocrProjects[i]) {
+			continue
+		}
+		runs = append(runs, *newOcrRun(&ocrProjects[i], engine, userID, input.Apply, batchID))
+	}
+
+	if len(runs) > 0 {
+		if err := s.runRepo.InsertMany(ctx, runs); err != nil {
+			return nil, fmt.Errorf("failed to queue OCR runs: %w", err)
+		}
+		// The queued runs must be committed before the background work updates them
+		background := persistence.WithoutUnitOfWork(context.WithoutCancel(ctx))
+		persistence.AfterCommit(ctx, func() {
+			go s.processBatch(background, engine, runs)
+		})
+	}
+
+	return &dtos.BulkReprocessResultDto{
+		BatchID:       batchID,
+		EngineName:    engine.Name(),
+		EngineVersion: engine.Version(),
+		QueuedCount:   len(runs),
+	}, nil
+}
+
+func (s *OcrRunService) processBatch(ctx context.Context, engine ocr.Engine, runs []entities.OcrRun) {
+	for i := range runs {
+		run := &runs[i]
+
+		ocrProject, err := s.getOcrProject(ctx, run.OcrProjectID)
+		if err != nil {
+			run.Fail(err)
+			if err := s.runRepo.SaveState(ctx, run); err != nil {
+				log.Printf("OCR run %d: %v", run.ID, err)
+			}
+			continue
+		}
+
+		if err := s.execute(ctx, engine, run, ocrProject); err != nil {
+			log.Printf("OCR run %d: %v", run.ID, err)
+		}
+	}
+}
+
+// execute calls the engine and stores the outcome on the run. Engine failures are recorded on
+// the run rather than returned; only storage errors are returned.
+func (s *OcrRunService) execute(ctx context.Context, engine ocr.Engine, run *entities.OcrRun, ocrProject *entities.OcrProject) error {
+	run.Start()
+	if err := s.runRepo.SaveState(ctx, run); err != nil {
+		return err
+	}
+
+	fields, err := s.extract(ctx, engine, ocrProject)
+	if err != nil {
+		run.Fail(err)
+		return s.runRepo.SaveState(ctx, run)
+	}
+
+	run.Complete(fields)
+	if err := s.runRepo.SaveResult(ctx, run); err != nil {
+		return err
+	}
+
+	if run.Apply {
+		if err := s.apply(ctx, run); err != nil {
+			run.Error = fmt.Sprintf("results were not applied: %v", err)
+		} else {
+			run.IsApplied = true
+		}
+		return s.runRepo.SaveState(ctx, run)
+	}
+
+	return nil
+}
+
+func (s *OcrRunService) extract(ctx context.Context, engine ocr.Engine, ocrProject *entities.OcrProject) ([]entities.OcrRunField, error) {
+	pages, err := s.documentRepo.GetPagesByOcrProjectID(ctx, ocrProject.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &ocr.ExtractionRequest{
+		OcrProjectID: ocrProject.ID,
+		Type:         ocrProject.Type,
+		TypeName:     ocrProject.Type.String(),
+		PdfPath:      ocrProject.PdfPath,
+		Pages:        make([]string, len(pages)),
+	}
+	for i, page := range pages {
+		request.Pages[i] = page.Text
+	}
+
+	extracted, err := engine.Extract(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]entities.OcrRunField, 0, len(extracted))
+	seen := make(map[string]bool, len(extracted))
+	for _, field := range extracted {
+		if !entities.IsOcrField(field.FieldName) || seen[field.FieldName] {
+			continue
+		}
+		seen[field.FieldName] = true
+
+		fields = append(fields, entities.OcrRunField{
+			FieldName:  field.FieldName,
+			Value:      formatOcrValue(field.Value),
+			Confidence: field.Confidence,
+		})
+	}
+	return fields, nil
+}
+
+// apply submits the run's fields to the review workflow as if they came from the regular pipeline
+func (s *OcrRunService) apply(ctx context.Context, run *entities.OcrRun) error {
+	if len(run.Fields) == 0 {
+		return fmt.Errorf("the run produced no fields")
+	}
+
+	if run.TriggeredByUserID == nil {
+		return fmt.Errorf("the run has no triggering user to submit the results as")
+	}
+	userID := *run.TriggeredByUserID
+
+	ocrProject, err := s.getOcrProject(ctx, run.OcrProjectID)
+	if err != nil {
+		return err
+	}
+	if ocrProject.Status == entities.OcrProjectStatusRejected {
+		if _, err := s.ocrProjectService.StartProcessing(ctx, ocrProject.ID, userID); err != nil {
+			return err
+		}
+	}
+
+	input := &dtos.SubmitOcrResultsDto{
+		Fields: make([]dtos.ProcessedDataModel, len(run.Fields)),
+	}
+	for i, field := range run.Fields {
+		input.Fields[i] = dtos.ProcessedDataModel{
+			FieldName:  field.FieldName,
+			Value:      field.Value,
+			Confidence: field.Confidence,
+		}
+	}
+
+	_, err = s.ocrProjectService.SubmitResults(ctx, ocrProject.ID, input, userID)
+	return err
+}
+
+// Compare diffs the fields of two runs of the same OCR project, scoring both against approved values when present
+func (s *OcrRunService) Compare(ctx context.Context, request *dtos.CompareOcrRunsRequestDto, userID int) (*dtos.OcrRunComparisonDto, error) {
+	baseRun, err := s.getVisibleRun(ctx, request.BaseRunID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base run: %w", err)
+	}
+	candidateRun, err := s.getVisibleRun(ctx, request.CandidateRunID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get candidate run: %w", err)
+	}
+
+	if baseRun.OcrProjectID != candidateRun.OcrProjectID {
+		return nil, apperrors.Validation("runs %d and %d belong to different OCR projects", baseRun.ID, candidateRun.ID)
+	}
+	for _, run := range []*entities.OcrRun{baseRun, candidateRun} {
+		if run.Status != entities.OcrRunStatusCompleted {
+			return nil, apperrors.Conflict("OCR run %d is %s", run.ID, run.Status)
+		}
+	}
+
+	approved, err := s.ocrProjectRepo.GetHumanApprovedByIDs(ctx, []int{baseRun.OcrProjectID})
+	if err != nil {
+		return nil, err
+	}
+	var approvedValues map[string]string
+	if len(approved) > 0 {
+		approvedValues = approvedFieldValues(&approved[0])
+	}
+
+	baseFields := runFieldsByName(baseRun)
+	candidateFields := runFieldsByName(candidateRun)
+
+	result := &dtos.OcrRunComparisonDto{
+		BaseRun:           mapOcrRunToDto(baseRun),
+		CandidateRun:      mapOcrRunToDto(candidateRun),
+		HasApprovedValues: approvedValues != nil,
+		Fields:            []dtos.OcrRunFieldDiffDto{},
+	}
+
+	evaluated, baseCorrect, candidateCorrect := 0, 0, 0
+	for _, fieldName := range entities.OcrFieldNames {
+		base, inBase := baseFields[fieldName]
+		candidate, inCandidate := candidateFields[fieldName]
+		approvedValue, isApproved := approvedValues[fieldName]
+		if !inBase && !inCandidate && !isApproved {
+			continue
+		}
+
+		diff := dtos.OcrRunFieldDiffDto{
+			FieldName: fieldName,
+			Changed:   normalizeFieldValue(base.Value) != normalizeFieldValue(candidate.Value),
+		}
+		if inBase {
+			diff.BaseValue = &base.Value
+			diff.BaseConfidence = &base.Confidence
+		}
+		if inCandidate {
+			diff.CandidateValue = &candidate.Value
+			diff.CandidateConfidence = &candidate.Confidence
+		}
+		if isApproved {
+			isBaseCorrect := matchesApprovedValue(base.Value, approvedValue)
+			isCandidateCorrect := matchesApprovedValue(candidate.Value, approvedValue)
+			diff.ApprovedValue = &approvedValue
+			diff.BaseCorrect = &isBaseCorrect
+			diff.CandidateCorrect = &isCandidateCorrect
+
+			evaluated++
+			if isBaseCorrect {
+				baseCorrect++
+			}
+			if isCandidateCorrect {
+				candidateCorrect++
+			}
+		}
+
+		result.Fields = append(result.Fields, diff)
+	}
+
+	if evaluated > 0 {
+		baseAccuracy := float64(baseCorrect) / float64(evaluated)
+		candidateAccuracy := float64(candidateCorrect) / float64(evaluated)
+		result.BaseAccuracy = &baseAccuracy
+		result.CandidateAccuracy = &candidateAccuracy
+	}
+
+	return result, nil
+}
+
+// CompareEngines scores the latest run of two engine versions on every OCR project a reviewer approved.
+// Only OCR projects processed by both versions are counted so the samples are identical.
+func (s *OcrRunService) CompareEngines(ctx context.Context, request *dtos.CompareOcrEnginesRequestDto) (*dtos.OcrEngineComparisonDto, error) {
+	fieldNames := request.Fields
+	if len(fieldNames) == 0 {
+		fieldNames = entities.OcrFieldNames
+	}
+	for _, fieldName := range fieldNames {
+		if !entities.IsOcrField(fieldName) {
+			return nil, apperrors.Validation("unknown OCR field %q", fieldName)
+		}
+	}
+
+	tenantID := multitenancy.TenantIDFromContext(ctx)
+	baseRuns, err := s.runRepo.GetLatestCompletedByEngine(ctx, tenantID, request.BaseEngine, request.BaseVersion)
+	if err != nil {
+		return nil, err
+	}
+	candidateRuns, err := s.runRepo.GetLatestCompletedByEngine(ctx, tenantID, request.CandidateEngine, request.CandidateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	baseByOcrProject := make(map[int]*entities.OcrRun, len(baseRuns))
+	for i := range baseRuns {
+		baseByOcrProject[baseRuns[i].OcrProjectID] = &baseRuns[i]
+	}
+	candidateByOcrProject := make(map[int]*entities.OcrRun, len(candidateRuns))
+	ocrProjectIDs := make([]int, 0, len(candidateRuns))
+	for i := range candidateRuns {
+		ocrProjectID := candidateRuns[i].OcrProjectID
+		candidateByOcrProject[ocrProjectID] = &candidateRuns[i]
+		if _, ok := baseByOcrProject[ocrProjectID]; ok {
+			ocrProjectIDs = append(ocrProjectIDs, ocrProjectID)
+		}
+	}
+
+	approved, err := s.ocrProjectRepo.GetHumanApprovedByIDs(ctx, ocrProjectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	accuracy := make([]dtos.OcrFieldAccuracyDto, len(fieldNames))
+	for i, fieldName := range fieldNames {
+		accuracy[i].FieldName = fieldName
+	}
+
+	for i := range approved {
+		approvedValues := approvedFieldValues(&approved[i])
+		baseFields := runFieldsByName(baseByOcrProject[approved[i].ID])
+		candidateFields := runFieldsByName(candidateByOcrProject[approved[i].ID])
+
+		for j := range accuracy {
+			fieldAccuracy := &accuracy[j]
+			approvedValue, ok := approvedValues[fieldAccuracy.FieldName]
+			if !ok {
+				continue
+			}
+
+			fieldAccuracy.SampleCount++
+			if matchesApprovedValue(baseFields[fieldAccuracy.FieldName].Value, approvedValue) {
+				fieldAccuracy.BaseCorrect++
+			}
+			if matchesApprovedValue(candidateFields[fieldAccuracy.FieldName].Value, approvedValue) {
+				fieldAccuracy.CandidateCorrect++
+			}
+		}
+	}
+
+	for i := range accuracy {
+		if accuracy[i].SampleCount > 0 {
+			accuracy[i].BaseAccuracy = float64(accuracy[i].BaseCorrect) / float64(accuracy[i].SampleCount)
+			accuracy[i].CandidateAccuracy = float64(accuracy[i].CandidateCorrect) / float64(accuracy[i].SampleCount)
+		}
+	}
+
+	return &dtos.OcrEngineComparisonDto{
+		BaseEngine:       request.BaseEngine,
+		BaseVersion:      request.BaseVersion,
+		CandidateEngine:  request.CandidateEngine,
+		CandidateVersion: request.CandidateVersion,
+		OcrProjectCount:  len(approved),
+		Fields:           accuracy,
+	}, nil
+}
+
+func (s *OcrRunService) getOcrProject(ctx context.Context, id int) (*entities.OcrProject, error) {
+	ocrProject, err := s.ocrProjectRepo.GetByIDIncludingFieldResults(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCR project: %w", err)
+	}
+	if ocrProject.IsDeleted {
+		return nil, apperrors.NotFound("OCR project with ID %d not found", id)
+	}
+	return ocrProject, nil
+}
+
+// getVisibleOcrProject loads a live OCR project whose project the user may see
+func (s *OcrRunService) getVisibleOcrProject(ctx context.Context, id int, userID int) (*entities.OcrProject, error) {
+	ocrProject, err := s.ocrProjectService.getVisible(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ocrProject.IsDeleted {
+		return nil, apperrors.NotFound("OCR project with ID %d not found", id)
+	}
+	return ocrProject, nil
+}
+
+// getVisibleRun loads a run with its fields, hiding it when the user may not see its OCR project
+func (s *OcrRunService) getVisibleRun(ctx context.Context, id int, userID int) (*entities.OcrRun, error) {
+	run, err := s.runRepo.GetByIDIncludingFields(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCR run: %w", err)
+	}
+	if _, err := s.ocrProjectService.getVisible(ctx, run.OcrProjectID, userID); err != nil {
+		if apperrors.IsKind(err, apperrors.KindNotFound) {
+			return nil, apperrors.NotFound("OCR run with ID %d not found", id)
+		}
+		return nil, err
+	}
+	return run, nil
+}
+
+// canApplyRun reports whether the review workflow still accepts new results for the OCR project
+func canApplyRun(ocrProject *entities.OcrProject) bool {
+	switch ocrProject.Status {
+	case entities.OcrProjectStatusPending, entities.OcrProjectStatusProcessing, entities.OcrProjectStatusRejected:
+		return true
+	}
+	return false
+}
+
+func newOcrRun(ocrProject *entities.OcrProject, engine ocr.Engine, userID int, apply bool, batchID string) *entities.OcrRun {
+	return &entities.OcrRun{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: ocrProject.TenantID},
+		OcrProjectID:      ocrProject.ID,
+		EngineName:        engine.Name(),
+		EngineVersion:     engine.Version(),
+		BatchID:           batchID,
+		Status:            entities.OcrRunStatusQueued,
+		Apply:             apply,
+		TriggeredByUserID: &userID,
+	}
+}
+
+func newBatchID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate batch ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// approvedFieldValues returns the values a reviewer accepted, keyed by field name; values approved
+// automatically for their confidence are left out like in GetHumanApprovedByIDs
+func approvedFieldValues(ocrProject *entities.OcrProject) map[string]string {
+	values := make(map[string]string, len(ocrProject.FieldResults))
+	for _, result := range ocrProject.FieldResults {
+		if result.IsApproved && result.ApprovedByUserID != nil && result.ApprovedValue != nil {
+			values[result.FieldName] = *result.ApprovedValue
+		}
+	}
+	return values
+}
+
+func runFieldsByName(run *entities.OcrRun) map[string]entities.OcrRunField {
+	fields := make(map[string]entities.OcrRunField, len(run.Fields))
+	for _, field := range run.Fields {
+		fields[field.FieldName] = field
+	}
+	return fields
+}
+
+// matchesApprovedValue compares values the same way reconciliation does; a missing value counts as empty
+func matchesApprovedValue(value, approvedValue string) bool {
+	return normalizeFieldValue(value) == normalizeFieldValue(approvedValue)
+}
+
+func mapOcrRunsToDto(runs []entities.OcrRun) []dtos.OcrRunDto {
+	result := make([]dtos.OcrRunDto, len(runs))
+	for i := range runs {
+		result[i] = mapOcrRunToDto(&runs[i])
+	}
+	return result
+}
+
+func mapOcrRunToDto(run *entities.OcrRun) dtos.OcrRunDto {
+	dto := dtos.OcrRunDto{
+		ID:                run.ID,
+		CreatedAt:         run.CreatedAt,
+		OcrProjectID:      run.OcrProjectID,
+		EngineName:        run.EngineName,
+		EngineVersion:     run.EngineVersion,
+		BatchID:           run.BatchID,
+		Status:            int(run.Status),
+		StatusName:        run.Status.String(),
+		Error:             run.Error,
+		Apply:             run.Apply,
+		IsApplied:         run.IsApplied,
+		TriggeredByUserID: run.TriggeredByUserID,
+		StartedAt:         run.StartedAt,
+		CompletedAt:       run.CompletedAt,
+	}
+
+	if run.Fields != nil {
+		dto.Fields = make([]dtos.OcrRunFieldDto, len(run.Fields))
+		for i, field := range run.Fields {
+			dto.Fields[i] = dtos.OcrRunFieldDto{
+				FieldName:  field.FieldName,
+				Value:      field.Value,
+				Confidence: field.Confidence,
+			}
+		}
+	}
+
+	return dto
+}
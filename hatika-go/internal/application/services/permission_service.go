@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+)
+
+// PermissionService keeps the permissions table in line with the permissions the code defines
+type PermissionService struct {
+	permissionRepo *persistence.PermissionRepository
+	roleRepo       *persistence.RoleRepository
+	unitOfWork     *persistence.UnitOfWork
+}
+
+// NewPermissionService creates a new permission service
+func NewPermissionService(
+	permissionRepo *persistence.PermissionRepository,
+	roleRepo *persistence.RoleRepository,
+	unitOfWork *persistence.UnitOfWork,
+) *PermissionService {
+	return &PermissionService{
+		permissionRepo: permissionRepo,
+		roleRepo:       roleRepo,
+		unitOfWork:     unitOfWork,
+	}
+}
+
+// Sync creates the defined permissions that are missing, refreshes the display names and descriptions
+// of the others and grants the Admin role every permission it lacks. Permissions no longer defined are
+// reported as stale, and deleted together with their grants when prune is set. Everything is applied
+// in one transaction.
+func (s *PermissionService) Sync(ctx context.Context, prune bool) (*dtos.PermissionSyncResultDto, error) {
+	var result *dtos.PermissionSyncResultDto
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.sync(ctx, prune)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *PermissionService) sync(ctx context.Context, prune bool) (*dtos.PermissionSyncResultDto, error) {
+	existing, err := s.permissionRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch permissions: %w", err)
+	}
+	byName := make(map[string]*entities.Permission, len(existing))
+	for i := range existing {
+		byName[existing[i].Name] = &existing[i]
+	}
+
+	result := &dtos.PermissionSyncResultDto{
+		Created: []string{},
+		Updated: []string{},
+		Granted: []string{},
+		Stale:   []string{},
+	}
+	defined := make([]entities.Permission, 0, len(entities.PermissionDefinitions))
+	for _, definition := range entities.PermissionDefinitions {
+		permission, ok := byName[definition.Name]
+		delete(byName, definition.Name)
+
+		if !ok {
+			permission = &entities.Permission{
+				Name:        definition.Name,
+				DisplayName: definition.DisplayName,
+				Description: definition.Description,
+			}
+			if err := s.permissionRepo.Insert(ctx, permission); err != nil {
+				return nil, fmt.Errorf("failed to create permission %s: %w", definition.Name, err)
+			}
+			result.Created = append(result.Created, definition.Name)
+		} else if permission.DisplayName != definition.DisplayName || permission.Description != definition.Description {
+			permission.DisplayName = definition.DisplayName
+			permission.Description = definition.Description
+			if err := s.permissionRepo.Update(ctx, permission); err != nil {
+				return nil, fmt.Errorf("failed to update permission %s: %w", definition.Name, err)
+			}
+			result.Updated = append(result.Updated, definition.Name)
+		}
+		defined = append(defined, *permission)
+	}
+
+	granted, err := s.grantAdmin(ctx, defined)
+	if err != nil {
+		return nil, err
+	}
+	result.Granted = granted
+
+	staleIDs := make([]int, 0, len(byName))
+	for name, permission := range byName {
+		result.Stale = append(result.Stale, name)
+		staleIDs = append(staleIDs, permission.ID)
+	}
+	sort.Strings(result.Stale)
+	if prune && len(staleIDs) > 0 {
+		if err := s.permissionRepo.DeleteWithGrants(ctx, staleIDs); err != nil {
+			return nil, err
+		}
+		result.Pruned = true
+	}
+
+	return result, nil
+}
+
+// grantAdmin grants the Admin role the permissions it does not have yet; without an Admin role there
+// is nobody to grant them to, which the roles seed fixes
+func (s *PermissionService) grantAdmin(ctx context.Context, permissions []entities.Permission) ([]string, error) {
+	admin, err := s.roleRepo.GetByName(ctx, entities.AdminRoleName)
+	if err != nil {
+		if apperrors.IsKind(err, apperrors.KindNotFound) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	has := make(map[int]bool, len(admin.Permissions))
+	for _, permission := range admin.Permissions {
+		has[permission.ID] = true
+	}
+	missing := []entities.Permission{}
+	names := []string{}
+	for _, permission := range permissions {
+		if !has[permission.ID] {
+			missing = append(missing, permission)
+			names = append(names, permission.Name)
+		}
+	}
+
+	if err := s.roleRepo.GrantPermissions(ctx, admin, missing); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/specifications"
+	"hatika-go/internal/domain/valueobjects"
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/persistence"
+)
+
+// PermitExpiryJobName is the scheduler name of the reminder job; it also names its advisory lock
+const PermitExpiryJobName = "permit-expiry-reminders"
+
+// defaultExpiringDays is the range of the expiring list when no reminder window is configured
+const defaultExpiringDays = 90
+
+// PermitExpiryService tracks building permits that are about to expire and reminds the people
+// responsible for the project
+type PermitExpiryService struct {
+	projectRepo    *persistence.ProjectRepository
+	reminderRepo   *persistence.PermitExpiryReminderRepository
+	groupRepo      *persistence.ProjectGroupRepository
+	projectService *ProjectService
+	windows        []int
+	location       *time.Location
+}
+
+// NewPermitExpiryService creates a new permit expiry service. Windows are days before expiry;
+// non-positive and repeated windows are ignored.
+func NewPermitExpiryService(
+	projectRepo *persistence.ProjectRepository,
+	reminderRepo *persistence.PermitExpiryReminderRepository,
+	groupRepo *persistence.ProjectGroupRepository,
+	projectService *ProjectService,
+	expiryConfig config.PermitExpiryConfig,
+	location *time.Location,
+) *PermitExpiryService {
+	seen := make(map[int]bool)
+	var windows []int
+	for _, days := range expiryConfig.WindowsDays {
+		if days > 0 && !seen[days] {
+			seen[days] = true
+			windows = append(windows, days)
+		}
+	}
+	sort.Ints(windows)
+
+	return &PermitExpiryService{
+		projectRepo:    projectRepo,
+		reminderRepo:   reminderRepo,
+		groupRepo:      groupRepo,
+		projectService: projectService,
+		windows:        windows,
+		location:       location,
+	}
+}
+
+// GetExpiring lists the projects visible to the user whose permit expires within the requested days,
+// soonest first unless sorted otherwise
+func (s *PermitExpiryService) GetExpiring(ctx context.Context, request *dtos.ExpiringProjectsRequestDto, userID int) (*dtos.PagedResultDto[dtos.ExpiringProjectDto], error) {
+	days := request.Days
+	if days == 0 {
+		days = s.largestWindow()
+	}
+	today := s.today()
+
+	var from interface{} = today
+	if request.IncludeExpired {
+		from = nil
+	}
+	spec, err := s.projectService.listSpecification(ctx, &request.ProjectFilterDto, request.Filter, userID)
+	if err != nil {
+		return nil, err
+	}
+	spec = specifications.And(spec, specifications.Range("ruhsatGecerlilikDate", from, today.AddDays(days)))
+
+	projects, totalCount, err := s.projectRepo.GetPermitsExpiring(ctx, request.PageNumber, request.PageSize, request.Sorting, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dtos.ExpiringProjectDto, len(projects))
+	for i := range projects {
+		items[i] = dtos.ExpiringProjectDto{
+			ProjectDto: s.projectService.mapToDto(&projects[i]),
+			DaysLeft:   today.DaysUntil(*projects[i].RuhsatGecerlilikDate),
+		}
+	}
+
+	return &dtos.PagedResultDto[dtos.ExpiringProjectDto]{
+		TotalCount: int(totalCount),
+		Items:      items,
+	}, nil
+}
+
+// SendReminders notifies the creator and the group members of every project whose permit has come
+// within a reminder window. Each window is reminded once per permit date; when several windows are
+// reached at once, e.g. for a project created a week before expiry, only the nearest one is sent.
+func (s *PermitExpiryService) SendReminders(ctx context.Context) error {
+	if len(s.windows) == 0 {
+		return nil
+	}
+
+	today := s.today()
+	projects, err := s.projectRepo.FindPermitsExpiringBetween(ctx, today, today.AddDays(s.largestWindow()))
+	if err != nil {
+		return err
+	}
+
+	trees := make(map[int]*entities.ProjectGroupTree)
+	var failures []error
+	sent, notified := 0, 0
+	for i := range projects {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		project := &projects[i]
+		daysLeft := today.DaysUntil(*project.RuhsatGecerlilikDate)
+
+		recipients, err := s.recipients(ctx, project, trees)
+		if err != nil {
+			failures = append(failures, err)
+			continue
+		}
+		if len(recipients) == 0 {
+			log.Printf("Warning: permit of project %d expires in %d days but it has no creator or group members to notify", project.ID, daysLeft)
+		}
+
+		reminder := &entities.PermitExpiryReminder{
+			ProjectID:  project.ID,
+			PermitDate: *project.RuhsatGecerlilikDate,
+			WindowDays: s.windowFor(daysLeft),
+			Recipients: len(recipients),
+		}
+		notifications := make([]entities.Notification, len(recipients))
+		for j, userID := range recipients {
+			notifications[j] = permitExpiryNotification(project, userID, daysLeft)
+		}
+
+		recorded, err := s.reminderRepo.Record(ctx, reminder, notifications)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("project %d: %w", project.ID, err))
+			continue
+		}
+		if recorded {
+			sent++
+			notified += len(notifications)
+		}
+	}
+
+	log.Printf("Permit expiry reminders: %d projects within %d days, %d reminders sent to %d recipients",
+		len(projects), s.largestWindow(), sent, notified)
+	return errors.Join(failures...)
+}
+
+// recipients returns the creator of the project and the users granted its group or a group above it
+func (s *PermitExpiryService) recipients(ctx context.Context, project *entities.Project, trees map[int]*entities.ProjectGroupTree) ([]int, error) {
+	seen := make(map[int]bool)
+	var recipients []int
+	add := func(userID int) {
+		if !seen[userID] {
+			seen[userID] = true
+			recipients = append(recipients, userID)
+		}
+	}
+
+	if project.CreatorUserID != nil {
+		add(*project.CreatorUserID)
+	}
+	if project.GroupID != nil {
+		tenantKey := 0
+		if project.TenantID != nil {
+			tenantKey = *project.TenantID
+		}
+		tree, ok := trees[tenantKey]
+		if !ok {
+			var err error
+			if tree, err = s.groupRepo.GetTree(ctx, project.TenantID); err != nil {
+				return nil, err
+			}
+			trees[tenantKey] = tree
+		}
+		for _, userID := range tree.Members(*project.GroupID) {
+			add(userID)
+		}
+	}
+	return recipients, nil
+}
+
+// windowFor returns the nearest window the days left fall in
+func (s *PermitExpiryService) windowFor(daysLeft int) int {
+	for _, window := range s.windows {
+		if daysLeft <= window {
+			return window
+		}
+	}
+	return s.largestWindow()
+}
+
+func (s *PermitExpiryService) largestWindow() int {
+	if len(s.windows) == 0 {
+		return defaultExpiringDays
+	}
+	return s.windows[len(s.windows)-1]
+}
+
+// today is the current date in the scheduler's time zone
+func (s *PermitExpiryService) today() valueobjects.PermitDate {
+	today, _ := valueobjects.NewPermitDate(time.Now().In(s.location))
+	return today
+}
+
+func permitExpiryNotification(project *entities.Project, userID int, daysLeft int) entities.Notification {
+	projectID := project.ID
+	message := fmt.Sprintf("%s (%s) projesinin yapı ruhsatı bugün sona eriyor.", project.ProjectName, project.ProjectCode)
+	if daysLeft > 0 {
+		message = fmt.Sprintf("%s (%s) projesinin yapı ruhsatı %s tarihinde sona eriyor, %d gün kaldı.",
+			project.ProjectName, project.ProjectCode, project.RuhsatGecerlilikDate.Time().Format("02.01.2006"), daysLeft)
+	}
+
+	return entities.Notification{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: project.TenantID},
+		UserID:            userID,
+		Type:              entities.NotificationTypePermitExpiry,
+		Title:             "Ruhsat süresi doluyor: " + project.ProjectCode,
+		Message:           message,
+		ProjectID:         &projectID,
+	}
+}
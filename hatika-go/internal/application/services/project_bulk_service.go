@@ -0,0 +1,451 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
+)
+
+// ProjectBulkSweepJobName is the scheduler name of the job failing interrupted bulk operations; it also
+// names its advisory lock
+const ProjectBulkSweepJobName = "project-bulk-sweep"
+
+// defaultBulkStaleAfter is how long a background operation may go without saving progress when no
+// limit is configured
+const defaultBulkStaleAfter = 10 * time.Minute
+
+// projectBulkTarget is a selected project; decided holds the result of one the operation is not run
+// for, such as an unknown ID or a project already in the requested state
+type projectBulkTarget struct {
+	projectID int
+	decided   *entities.ProjectBulkOperationItem
+}
+
+// projectBulkAction runs the operation of a bulk request for one project
+type projectBulkAction func(ctx context.Context, projectID int) error
+
+// ProjectBulkService applies one operation to many projects at once and reports the result per project
+type ProjectBulkService struct {
+	bulkRepo       *persistence.ProjectBulkOperationRepository
+	projectRepo    *persistence.ProjectRepository
+	projectService *ProjectService
+	groupService   *ProjectGroupService
+	unitOfWork     *persistence.UnitOfWork
+	config         config.BulkConfig
+}
+
+// NewProjectBulkService creates a new project bulk service
+func NewProjectBulkService(
+	bulkRepo *persistence.ProjectBulkOperationRepository,
+	projectRepo *persistence.ProjectRepository,
+	projectService *ProjectService,
+	groupService *ProjectGroupService,
+	unitOfWork *persistence.UnitOfWork,
+	bulkConfig config.BulkConfig,
+) *ProjectBulkService {
+	return &ProjectBulkService{
+		bulkRepo:       bulkRepo,
+		projectRepo:    projectRepo,
+		projectService: projectService,
+		groupService:   groupService,
+		unitOfWork:     unitOfWork,
+		config:         bulkConfig,
+	}
+}
+
+// Run selects the projects and applies the operation to each of them with the user's permissions.
+// Selections up to the sync limit run in one transaction and the result lists every project; larger
+// ones, or any with Background set, are processed in chunks in the background. applyFields is the
+// change of UpdateFields.
+func (s *ProjectBulkService) Run(
+	ctx context.Context,
+	input *dtos.ProjectBulkRequestDto,
+	applyFields func(input *dtos.UpdateProjectDto) error,
+	userID int,
+) (*dtos.ProjectBulkOperationDto, error) {
+	operation := entities.ProjectBulkOperationType(input.Operation)
+	action, err := s.action(ctx, operation, input, applyFields, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := s.selectTargets(ctx, operation, input, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	bulk := &entities.ProjectBulkOperation{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: multitenancy.TenantIDFromContext(ctx)},
+		Operation:         operation,
+		Background:        input.Background || len(targets) > s.config.SyncLimit,
+		Status:            entities.ProjectBulkOperationStatusQueued,
+		TotalCount:        len(targets),
+		TriggeredByUserID: &userID,
+	}
+
+	if !bulk.Background {
+		var items []entities.ProjectBulkOperationItem
+		err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+			if err := s.bulkRepo.Insert(ctx, bulk); err != nil {
+				return fmt.Errorf("failed to create project bulk operation: %w", err)
+			}
+			bulk.Start()
+			items = s.process(ctx, bulk, targets, action)
+			bulk.Complete()
+			return s.bulkRepo.SaveProgress(ctx, bulk, items)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		dto := mapProjectBulkOperationToDto(bulk)
+		dto.Items = make([]dtos.ProjectBulkOperationItemDto, len(items))
+		for i := range items {
+			dto.Items[i] = mapProjectBulkOperationItemToDto(&items[i])
+		}
+		return &dto, nil
+	}
+
+	if err := s.bulkRepo.Insert(ctx, bulk); err != nil {
+		return nil, fmt.Errorf("failed to create project bulk operation: %w", err)
+	}
+
+	// The operation row must be committed before the background work updates it
+	background := persistence.WithoutUnitOfWork(context.WithoutCancel(ctx))
+	persistence.AfterCommit(ctx, func() {
+		go s.runInBackground(background, bulk, targets, action)
+	})
+
+	dto := mapProjectBulkOperationToDto(bulk)
+	return &dto, nil
+}
+
+// GetByID returns the progress and counters of a bulk operation
+func (s *ProjectBulkService) GetByID(ctx context.Context, id int) (*dtos.ProjectBulkOperationDto, error) {
+	bulk, err := s.bulkRepo.GetByIDForTenant(ctx, id, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	dto := mapProjectBulkOperationToDto(bulk)
+	return &dto, nil
+}
+
+// GetItems pages through the per-project results of a bulk operation
+func (s *ProjectBulkService) GetItems(ctx context.Context, id int, request *dtos.ProjectBulkItemsRequestDto) (*dtos.PagedResultDto[dtos.ProjectBulkOperationItemDto], error) {
+	if _, err := s.bulkRepo.GetByIDForTenant(ctx, id, multitenancy.TenantIDFromContext(ctx)); err != nil {
+		return nil, err
+	}
+
+	var outcome *entities.ProjectBulkItemOutcome
+	if request.Outcome != nil {
+		o := entities.ProjectBulkItemOutcome(*request.Outcome)
+		outcome = &o
+	}
+
+	items, totalCount, err := s.bulkRepo.GetItems(ctx, id, outcome, request.PageNumber, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtos.ProjectBulkOperationItemDto, len(items))
+	for i := range items {
+		result[i] = mapProjectBulkOperationItemToDto(&items[i])
+	}
+
+	return &dtos.PagedResultDto[dtos.ProjectBulkOperationItemDto]{
+		TotalCount: int(totalCount),
+		Items:      result,
+	}, nil
+}
+
+// action checks the arguments of the operation and returns what it does with one project. Every
+// project goes through the same service method a single request would use, with its checks.
+func (s *ProjectBulkService) action(
+	ctx context.Context,
+	operation entities.ProjectBulkOperationType,
+	input *dtos.ProjectBulkRequestDto,
+	applyFields func(input *dtos.UpdateProjectDto) error,
+	userID int,
+) (projectBulkAction, error) {
+	switch operation {
+	case entities.ProjectBulkUpdateFields:
+		if applyFields == nil {
+			return nil, apperrors.Validation("UpdateFields needs the fields to change")
+		}
+		return func(ctx context.Context, projectID int) error {
+			_, err := s.projectService.Patch(ctx, projectID, applyFields, userID, nil)
+			return err
+		}, nil
+
+	case entities.ProjectBulkChangeGroup:
+		if input.GroupID == nil {
+			return nil, apperrors.Validation("ChangeGroup needs the groupId to move the projects to")
+		}
+		if err := s.groupService.EnsureVisible(ctx, input.GroupID, userID); err != nil {
+			return nil, err
+		}
+		groupID := *input.GroupID
+		return func(ctx context.Context, projectID int) error {
+			_, err := s.projectService.Patch(ctx, projectID, func(input *dtos.UpdateProjectDto) error {
+				input.GroupID = &groupID
+				return nil
+			}, userID, nil)
+			return err
+		}, nil
+
+	case entities.ProjectBulkDelete:
+		return func(ctx context.Context, projectID int) error {
+			return s.projectService.Delete(ctx, projectID, userID, nil)
+		}, nil
+
+	case entities.ProjectBulkRestore:
+		return func(ctx context.Context, projectID int) error {
+			_, err := s.projectService.Restore(ctx, projectID, userID, nil)
+			return err
+		}, nil
+	}
+
+	return nil, apperrors.Validation("unknown bulk operation %q", operation)
+}
+
+// selectTargets resolves the selection to projects the user may see. With an idList every listed ID
+// gets a result, so unknown, hidden and already handled projects are reported; a filter only selects
+// the projects the operation applies to.
+func (s *ProjectBulkService) selectTargets(
+	ctx context.Context,
+	operation entities.ProjectBulkOperationType,
+	input *dtos.ProjectBulkRequestDto,
+	userID int,
+) ([]projectBulkTarget, error) {
+	filter := input.ProjectFilterDto
+	if len(filter.IdList) == 0 && !hasProjectFilter(&filter) && strings.TrimSpace(input.Filter) == "" {
+		return nil, apperrors.Validation("select the projects by idList or by a filter")
+	}
+
+	ids := uniqueIDs(filter.IdList)
+	if len(ids) > s.config.MaxItems {
+		return nil, apperrors.Validation("idList has %d projects; at most %d can be changed at once", len(ids), s.config.MaxItems)
+	}
+	filter.IdList = ids
+
+	spec, err := s.projectService.listSpecification(ctx, &filter, input.Filter, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		deleted := operation == entities.ProjectBulkRestore
+		candidates, err := s.projectRepo.FindBulkCandidates(ctx, spec, &deleted, s.config.MaxItems+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(candidates) > s.config.MaxItems {
+			return nil, apperrors.Validation("the filter matches more than %d projects; narrow it down", s.config.MaxItems)
+		}
+
+		targets := make([]projectBulkTarget, len(candidates))
+		for i, candidate := range candidates {
+			targets[i] = projectBulkTarget{projectID: candidate.ID}
+		}
+		return targets, nil
+	}
+
+	candidates, err := s.projectRepo.FindBulkCandidates(ctx, spec, nil, len(ids))
+	if err != nil {
+		return nil, err
+	}
+	deleted := make(map[int]bool, len(candidates))
+	for _, candidate := range candidates {
+		deleted[candidate.ID] = candidate.IsDeleted
+	}
+
+	targets := make([]projectBulkTarget, len(ids))
+	for i, id := range ids {
+		targets[i] = projectBulkTarget{projectID: id}
+		isDeleted, found := deleted[id]
+		switch {
+		case !found:
+			targets[i].decided = decidedBulkItem(id, entities.ProjectBulkItemNotFound, fmt.Sprintf("project with ID %d not found", id))
+		case operation == entities.ProjectBulkRestore && !isDeleted:
+			targets[i].decided = decidedBulkItem(id, entities.ProjectBulkItemSkipped, "project is not deleted")
+		case operation == entities.ProjectBulkDelete && isDeleted:
+			targets[i].decided = decidedBulkItem(id, entities.ProjectBulkItemSkipped, "project is already deleted")
+		case operation != entities.ProjectBulkRestore && isDeleted:
+			targets[i].decided = decidedBulkItem(id, entities.ProjectBulkItemNotFound, fmt.Sprintf("project with ID %d is deleted", id))
+		}
+	}
+	return targets, nil
+}
+
+// process runs the operation for every target, each in its own savepoint so a failing project does
+// not undo the others, and counts the outcomes
+func (s *ProjectBulkService) process(
+	ctx context.Context,
+	bulk *entities.ProjectBulkOperation,
+	targets []projectBulkTarget,
+	action projectBulkAction,
+) []entities.ProjectBulkOperationItem {
+	items := make([]entities.ProjectBulkOperationItem, len(targets))
+	for i, target := range targets {
+		if target.decided != nil {
+			items[i] = *target.decided
+		} else {
+			err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+				return action(ctx, target.projectID)
+			})
+			items[i] = bulkItemResult(target.projectID, err)
+		}
+		bulk.Record(&items[i])
+	}
+	return items
+}
+
+// runInBackground processes the targets in chunks, one transaction each, storing progress per chunk
+func (s *ProjectBulkService) runInBackground(
+	ctx context.Context,
+	bulk *entities.ProjectBulkOperation,
+	targets []projectBulkTarget,
+	action projectBulkAction,
+) {
+	bulk.Start()
+	if err := s.bulkRepo.SaveProgress(ctx, bulk, nil); err != nil {
+		log.Printf("Project bulk operation %d: %v", bulk.ID, err)
+		return
+	}
+
+	chunkSize := max(s.config.ChunkSize, 1)
+	for start := 0; start < len(targets); start += chunkSize {
+		chunk := targets[start:min(start+chunkSize, len(targets))]
+
+		// A chunk that fails to commit must not be counted
+		counters := *bulk
+		err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+			items := s.process(ctx, bulk, chunk, action)
+			return s.bulkRepo.SaveProgress(ctx, bulk, items)
+		})
+		if err != nil {
+			*bulk = counters
+			s.fail(ctx, bulk, err)
+			return
+		}
+	}
+
+	bulk.Complete()
+	if err := s.bulkRepo.SaveProgress(ctx, bulk, nil); err != nil {
+		s.fail(ctx, bulk, err)
+	}
+}
+
+func (s *ProjectBulkService) fail(ctx context.Context, bulk *entities.ProjectBulkOperation, err error) {
+	log.Printf("Project bulk operation %d: %v", bulk.ID, err)
+	bulk.Fail(err)
+	if err := s.bulkRepo.SaveProgress(ctx, bulk, nil); err != nil {
+		log.Printf("Project bulk operation %d: %v", bulk.ID, err)
+	}
+}
+
+// FailInterrupted fails the background operations that saved no progress for the configured time, since
+// the server running them has stopped. Their selection and change live only in that server's memory,
+// so they cannot be resumed; the items still list every project processed before, and the rest can be
+// selected again. It runs when the server starts and on the sweep schedule; running operations save
+// progress after every chunk, so operations of other replicas are left alone.
+func (s *ProjectBulkService) FailInterrupted(ctx context.Context) error {
+	staleAfter := time.Duration(s.config.StaleAfterMinutes) * time.Minute
+	if staleAfter <= 0 {
+		staleAfter = defaultBulkStaleAfter
+	}
+
+	failed, err := s.bulkRepo.FailStale(ctx, time.Now().Add(-staleAfter),
+		"the server running the operation stopped before it finished; the items list the projects processed until then")
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		log.Printf("Failed %d interrupted project bulk operations", failed)
+	}
+	return nil
+}
+
+// hasProjectFilter reports whether any list filter other than idList is set
+func hasProjectFilter(filter *dtos.ProjectFilterDto) bool {
+	return filter.GroupID != 0 ||
+		filter.BildirimNo != "" ||
+		filter.ProjectCode != "" ||
+		filter.ProjectName != "" ||
+		filter.ProjectMuellef != ""
+}
+
+// uniqueIDs drops repeated IDs, keeping the first occurrence
+func uniqueIDs(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func decidedBulkItem(projectID int, outcome entities.ProjectBulkItemOutcome, reason string) *entities.ProjectBulkOperationItem {
+	return &entities.ProjectBulkOperationItem{ProjectID: projectID, Outcome: outcome, Error: reason}
+}
+
+// bulkItemResult turns the error of the operation on one project into its outcome
+func bulkItemResult(projectID int, err error) entities.ProjectBulkOperationItem {
+	item := entities.ProjectBulkOperationItem{ProjectID: projectID, Outcome: entities.ProjectBulkItemSucceeded}
+	if err == nil {
+		return item
+	}
+
+	item.Error = err.Error()
+	switch {
+	case apperrors.IsKind(err, apperrors.KindNotFound):
+		item.Outcome = entities.ProjectBulkItemNotFound
+	case apperrors.IsKind(err, apperrors.KindForbidden):
+		item.Outcome = entities.ProjectBulkItemForbidden
+	default:
+		item.Outcome = entities.ProjectBulkItemFailed
+	}
+	return item
+}
+
+func mapProjectBulkOperationToDto(bulk *entities.ProjectBulkOperation) dtos.ProjectBulkOperationDto {
+	return dtos.ProjectBulkOperationDto{
+		ID:                bulk.ID,
+		CreatedAt:         bulk.CreatedAt,
+		Operation:         string(bulk.Operation),
+		Background:        bulk.Background,
+		Status:            int(bulk.Status),
+		StatusName:        bulk.Status.String(),
+		Error:             bulk.Error,
+		TotalCount:        bulk.TotalCount,
+		ProcessedCount:    bulk.ProcessedCount,
+		SucceededCount:    bulk.SucceededCount,
+		SkippedCount:      bulk.SkippedCount,
+		FailedCount:       bulk.FailedCount,
+		TriggeredByUserID: bulk.TriggeredByUserID,
+		StartedAt:         bulk.StartedAt,
+		CompletedAt:       bulk.CompletedAt,
+	}
+}
+
+func mapProjectBulkOperationItemToDto(item *entities.ProjectBulkOperationItem) dtos.ProjectBulkOperationItemDto {
+	return dtos.ProjectBulkOperationItemDto{
+		ProjectID:   item.ProjectID,
+		Outcome:     int(item.Outcome),
+		OutcomeName: item.Outcome.String(),
+		Error:       item.Error,
+	}
+}
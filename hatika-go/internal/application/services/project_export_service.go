@@ -0,0 +1,274 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/infrastructure/reports"
+	"hatika-go/internal/infrastructure/spreadsheet"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
+)
+
+// ProjectExportContentTypePDF is the MIME type of project summaries
+const ProjectExportContentTypePDF = "application/pdf"
+
+// projectExportHeaders are the column headers of list exports. They are import headers too, so an
+// edited export can be imported back.
+var projectExportHeaders = []interface{}{
+	"ID", "Proje Kodu", "Proje Adı", "Açıklama", "Müellif", "Ada", "Parsel",
+	"Talep Gücü (kW)", "Kurulu Güç (kW)", "Bağımsız Bölüm Sayısı", "Blok Sayısı", "Yapı Yüksekliği (m)",
+	"Ruhsat Geçerlilik Tarihi", "Yapı Sahibi", "Adres", "Grup No", "Bildirim No", "Oluşturulma Tarihi",
+}
+
+var ocrProjectTypeLabels = map[entities.OcrProjectType]string{
+	entities.ProjeAntenti:        "Proje Antenti",
+	entities.YapiRuhsati:         "Yapı Ruhsatı",
+	entities.YapiKullanimBelgesi: "Yapı Kullanım Belgesi",
+	entities.Tapu:                "Tapu",
+	entities.IskanBelgesi:        "İskan Belgesi",
+}
+
+var ocrProjectStatusLabels = map[entities.OcrProjectStatus]string{
+	entities.OcrProjectStatusPending:     "Bekliyor",
+	entities.OcrProjectStatusProcessing:  "İşleniyor",
+	entities.OcrProjectStatusNeedsReview: "İncelemede",
+	entities.OcrProjectStatusApproved:    "Onaylandı",
+	entities.OcrProjectStatusRejected:    "Reddedildi",
+}
+
+// ProjectExport is an export ready to be written. Validation happens before it is returned, so the
+// caller can still answer with an error status; errors of Write occur mid-stream.
+type ProjectExport struct {
+	FileName    string
+	ContentType string
+	write       func(w io.Writer) error
+}
+
+// Write streams the export to w
+func (e *ProjectExport) Write(w io.Writer) error {
+	return e.write(w)
+}
+
+// ProjectExportService exports project lists as spreadsheets and projects as PDF summaries
+type ProjectExportService struct {
+	projectRepo    *persistence.ProjectRepository
+	projectService *ProjectService
+	groupService   *ProjectGroupService
+	renderer       *reports.PdfRenderer
+}
+
+// NewProjectExportService creates a new project export service
+func NewProjectExportService(
+	projectRepo *persistence.ProjectRepository,
+	projectService *ProjectService,
+	groupService *ProjectGroupService,
+	renderer *reports.PdfRenderer,
+) *ProjectExportService {
+	return &ProjectExportService{
+		projectRepo:    projectRepo,
+		projectService: projectService,
+		groupService:   groupService,
+		renderer:       renderer,
+	}
+}
+
+// ExportList exports the projects of the current tenant matching the list filters, one row per project.
+// Rows are read from a cursor and written as they arrive.
+func (s *ProjectExportService) ExportList(ctx context.Context, request *dtos.ProjectExportRequestDto, format spreadsheet.Format, userID int) (*ProjectExport, error) {
+	contentType, ok := spreadsheet.ContentTypes[format]
+	if !ok {
+		return nil, apperrors.Validation("unsupported export format %q", format)
+	}
+	spec, err := s.projectService.listSpecification(ctx, &request.ProjectFilterDto, request.Filter, userID)
+	if err != nil {
+		return nil, err
+	}
+	// Check the sorting and filter now; once streaming starts errors can no longer change the response status
+	if _, err := s.projectRepo.Sorting(request.Sorting); err != nil {
+		return nil, err
+	}
+	if _, err := s.projectRepo.Filter(spec); err != nil {
+		return nil, err
+	}
+
+	return &ProjectExport{
+		FileName:    fmt.Sprintf("projeler-%s.%s", time.Now().Format("20060102-150405"), format),
+		ContentType: contentType,
+		write: func(w io.Writer) error {
+			writer, err := spreadsheet.NewWriter(w, format, "Projeler")
+			if err != nil {
+				return err
+			}
+			if err := writer.WriteRow(projectExportHeaders); err != nil {
+				return err
+			}
+			err = s.projectRepo.StreamAll(ctx, request.Sorting, spec, func(project *entities.Project) error {
+				return writer.WriteRow(projectExportRow(project))
+			})
+			if closeErr := writer.Close(); err == nil {
+				err = closeErr
+			}
+			return err
+		},
+	}, nil
+}
+
+// ExportSummary renders a PDF with the fields of the project and the state of its OCR documents
+func (s *ProjectExportService) ExportSummary(ctx context.Context, id int, userID int) (*ProjectExport, error) {
+	project, err := s.projectRepo.GetByIDIncludingOcrProjects(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if project.IsDeleted || !sameTenant(project.TenantID, multitenancy.TenantIDFromContext(ctx)) {
+		return nil, apperrors.NotFound("project with ID %d not found", id)
+	}
+	if err := s.projectService.ensureProjectVisible(ctx, project, userID); err != nil {
+		return nil, err
+	}
+
+	groupName := ""
+	if project.GroupID != nil {
+		group, err := s.groupService.GetByID(ctx, *project.GroupID, userID)
+		if err != nil {
+			return nil, err
+		}
+		groupName = group.Name
+	}
+
+	summary := projectSummary(project, groupName)
+	return &ProjectExport{
+		FileName:    fmt.Sprintf("proje-%d.pdf", project.ID),
+		ContentType: ProjectExportContentTypePDF,
+		write: func(w io.Writer) error {
+			return s.renderer.RenderProjectSummary(w, summary)
+		},
+	}, nil
+}
+
+func projectExportRow(project *entities.Project) []interface{} {
+	var ruhsatDate interface{}
+	if project.RuhsatGecerlilikDate != nil {
+		ruhsatDate = project.RuhsatGecerlilikDate.Time()
+	}
+
+	return []interface{}{
+		project.ID,
+		project.ProjectCode,
+		project.ProjectName,
+		project.ProjectComment,
+		project.ProjectMuellef,
+		optionalInt(project.Ada),
+		optionalInt(project.Parsel),
+		optionalInt(project.TalepGucu),
+		optionalInt(project.KuruluGuc),
+		optionalInt(project.BagimsizBS),
+		optionalInt(project.BlokS),
+		optionalFloat(project.YapiYuksekligi),
+		ruhsatDate,
+		project.YapiSahibi,
+		project.Adress,
+		optionalInt(project.GroupID),
+		project.BildirimNo,
+		project.CreatedAt,
+	}
+}
+
+// optionalInt and optionalFloat turn nil pointers into empty cells
+func optionalInt[T ~int](v *T) interface{} {
+	if v == nil {
+		return nil
+	}
+	return int(*v)
+}
+
+func optionalFloat(v *float64) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+func projectSummary(project *entities.Project, groupName string) *reports.ProjectSummary {
+	approved := 0
+	documents := make([]reports.SummaryDocument, 0, len(project.OcrProjects))
+	for _, ocrProject := range project.OcrProjects {
+		if ocrProject.Status == entities.OcrProjectStatusApproved {
+			approved++
+		}
+
+		detail := ""
+		switch {
+		case ocrProject.Status == entities.OcrProjectStatusApproved && ocrProject.ApprovedAt != nil:
+			detail = "Onay: " + ocrProject.ApprovedAt.Format("02.01.2006")
+		case ocrProject.Status == entities.OcrProjectStatusRejected && ocrProject.RejectionReason != "":
+			detail = ocrProject.RejectionReason
+		case ocrProject.IsOptional:
+			detail = "isteğe bağlı"
+		}
+
+		documents = append(documents, reports.SummaryDocument{
+			Code:   ocrProject.ProjectCode,
+			Type:   labelOr(ocrProjectTypeLabels[ocrProject.Type], ocrProject.Type.String()),
+			Status: labelOr(ocrProjectStatusLabels[ocrProject.Status], strconv.Itoa(int(ocrProject.Status))),
+			Detail: detail,
+		})
+	}
+
+	yapiYuksekligi := ""
+	if project.YapiYuksekligi != nil {
+		yapiYuksekligi = strconv.FormatFloat(*project.YapiYuksekligi, 'f', -1, 64)
+	}
+	ruhsatDate := ""
+	if project.RuhsatGecerlilikDate != nil {
+		ruhsatDate = project.RuhsatGecerlilikDate.Time().Format("02.01.2006")
+	}
+	adaParsel := ""
+	if project.Ada != nil || project.Parsel != nil {
+		adaParsel = intText(project.Ada) + " / " + intText(project.Parsel)
+	}
+
+	return &reports.ProjectSummary{
+		Title:    project.ProjectName,
+		Subtitle: "Proje Kodu: " + project.ProjectCode,
+		Fields: []reports.SummaryField{
+			{Label: "Bildirim No", Value: project.BildirimNo},
+			{Label: "Grup", Value: groupName},
+			{Label: "Müellif", Value: project.ProjectMuellef},
+			{Label: "Yapı Sahibi", Value: project.YapiSahibi},
+			{Label: "Adres", Value: project.Adress},
+			{Label: "Ada / Parsel", Value: adaParsel},
+			{Label: "Talep Gücü (kW)", Value: intText(project.TalepGucu)},
+			{Label: "Kurulu Güç (kW)", Value: intText(project.KuruluGuc)},
+			{Label: "Bağımsız Bölüm Sayısı", Value: intText(project.BagimsizBS)},
+			{Label: "Blok Sayısı", Value: intText(project.BlokS)},
+			{Label: "Yapı Yüksekliği (m)", Value: yapiYuksekligi},
+			{Label: "Ruhsat Geçerlilik Tarihi", Value: ruhsatDate},
+			{Label: "Açıklama", Value: project.ProjectComment},
+			{Label: "OCR Durumu", Value: fmt.Sprintf("%d / %d onaylandı", approved, len(project.OcrProjects))},
+		},
+		Documents: documents,
+		Footer:    "Oluşturulma: " + time.Now().Format("02.01.2006 15:04"),
+	}
+}
+
+// intText formats an optional number for the summary, leaving it empty when unset
+func intText[T ~int](v *T) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*v))
+}
+
+func labelOr(label, fallback string) string {
+	if label != "" {
+		return label
+	}
+	return fallback
+}
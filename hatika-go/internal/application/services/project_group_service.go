@@ -0,0 +1,384 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
+)
+
+// ProjectGroupService manages project groups and who may see them
+type ProjectGroupService struct {
+	groupRepo *persistence.ProjectGroupRepository
+	userRepo  *persistence.UserRepository
+}
+
+// NewProjectGroupService creates a new project group service
+func NewProjectGroupService(groupRepo *persistence.ProjectGroupRepository, userRepo *persistence.UserRepository) *ProjectGroupService {
+	return &ProjectGroupService{
+		groupRepo: groupRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// GetAll lists the groups of the current tenant the user may see, sorted by name
+func (s *ProjectGroupService) GetAll(ctx context.Context, userID int) ([]dtos.ProjectGroupDto, error) {
+	tree, err := s.groupRepo.GetTree(ctx, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := s.userRepo.HasRole(ctx, userID, entities.AdminRoleName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []dtos.ProjectGroupDto{}
+	for _, group := range tree.Groups() {
+		if isAdmin || tree.IsVisibleTo(group.ID, userID) {
+			result = append(result, mapProjectGroupToDto(group))
+		}
+	}
+	return result, nil
+}
+
+func (s *ProjectGroupService) GetByID(ctx context.Context, id int, userID int) (*dtos.ProjectGroupDto, error) {
+	tree, err := s.visibleTree(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := mapProjectGroupToDto(tree.Get(id))
+	return &dto, nil
+}
+
+// Create adds a group to the current tenant, optionally below a parent group
+func (s *ProjectGroupService) Create(ctx context.Context, input *dtos.CreateProjectGroupDto, userID int) (*dtos.ProjectGroupDto, error) {
+	group := &entities.ProjectGroup{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: multitenancy.TenantIDFromContext(ctx)},
+	}
+	if err := s.applyInput(ctx, group, input, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepo.Insert(ctx, group); err != nil {
+		return nil, fmt.Errorf("failed to create project group: %w", err)
+	}
+
+	dto := mapProjectGroupToDto(group)
+	return &dto, nil
+}
+
+// Update renames or moves a group; it cannot be moved below itself
+func (s *ProjectGroupService) Update(ctx context.Context, id int, input *dtos.UpdateProjectGroupDto, userID int) (*dtos.ProjectGroupDto, error) {
+	tree, err := s.visibleTree(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	group := tree.Get(id)
+
+	if input.ParentID != nil && tree.Get(*input.ParentID) != nil && tree.IsDescendant(*input.ParentID, id) {
+		return nil, apperrors.Validation("a project group cannot be moved below itself")
+	}
+	if err := s.applyInput(ctx, group, &input.CreateProjectGroupDto, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepo.Update(ctx, group); err != nil {
+		return nil, fmt.Errorf("failed to update project group: %w", err)
+	}
+
+	dto := mapProjectGroupToDto(group)
+	return &dto, nil
+}
+
+// Delete removes an empty group; groups with projects or subgroups are kept
+func (s *ProjectGroupService) Delete(ctx context.Context, id int, userID int) error {
+	if _, err := s.visibleTree(ctx, userID, id); err != nil {
+		return err
+	}
+
+	children, err := s.groupRepo.CountChildren(ctx, id)
+	if err != nil {
+		return err
+	}
+	if children > 0 {
+		return apperrors.Conflict("project group has %d subgroups; move or delete them first", children)
+	}
+
+	projects, err := s.groupRepo.CountProjects(ctx, id)
+	if err != nil {
+		return err
+	}
+	if projects > 0 {
+		return apperrors.Conflict("project group has %d projects; move them to another group first", projects)
+	}
+
+	if err := s.groupRepo.SoftDelete(ctx, id, userID); err != nil {
+		return fmt.Errorf("failed to delete project group: %w", err)
+	}
+	return nil
+}
+
+// GetStats aggregates one group and its visible subgroups
+func (s *ProjectGroupService) GetStats(ctx context.Context, id int, userID int) (*dtos.ProjectGroupStatsDto, error) {
+	tree, err := s.visibleTree(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.stats(ctx, tree, userID, []int{id})
+	if err != nil {
+		return nil, err
+	}
+	return &stats[0], nil
+}
+
+// GetAllStats aggregates every group the user may see
+func (s *ProjectGroupService) GetAllStats(ctx context.Context, userID int) ([]dtos.ProjectGroupStatsDto, error) {
+	tree, err := s.groupRepo.GetTree(ctx, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	visible, err := s.visibleIDs(ctx, tree, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int
+	for _, group := range tree.Groups() {
+		if visible[group.ID] {
+			ids = append(ids, group.ID)
+		}
+	}
+	return s.stats(ctx, tree, userID, ids)
+}
+
+// GetPermissions lists the users granted access to a group
+func (s *ProjectGroupService) GetPermissions(ctx context.Context, id int, userID int) (*dtos.ProjectGroupPermissionsDto, error) {
+	if _, err := s.visibleTree(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	userIDs, err := s.groupRepo.GetPermissionUserIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &dtos.ProjectGroupPermissionsDto{UserIDs: userIDs}, nil
+}
+
+// SetPermissions replaces the users granted access to a group. The caller keeps access to a group it
+// restricts only if it is listed, an administrator, or granted on a parent group.
+func (s *ProjectGroupService) SetPermissions(ctx context.Context, id int, input *dtos.ProjectGroupPermissionsDto, userID int) (*dtos.ProjectGroupPermissionsDto, error) {
+	if _, err := s.visibleTree(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool, len(input.UserIDs))
+	userIDs := []int{}
+	for _, grantedID := range input.UserIDs {
+		if !seen[grantedID] {
+			seen[grantedID] = true
+			userIDs = append(userIDs, grantedID)
+		}
+	}
+	sort.Ints(userIDs)
+
+	existing, err := s.userRepo.GetExistingIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) != len(userIDs) {
+		found := make(map[int]bool, len(existing))
+		for _, existingID := range existing {
+			found[existingID] = true
+		}
+		var missing []int
+		for _, grantedID := range userIDs {
+			if !found[grantedID] {
+				missing = append(missing, grantedID)
+			}
+		}
+		return nil, apperrors.Validation("unknown users %v", missing).
+			WithDetails(map[string]interface{}{"userIds": missing})
+	}
+
+	if err := s.groupRepo.ReplacePermissions(ctx, id, userIDs); err != nil {
+		return nil, err
+	}
+	return &dtos.ProjectGroupPermissionsDto{UserIDs: userIDs}, nil
+}
+
+// VisibleGroupIDs returns the groups of the current tenant a user may see; all is true for
+// administrators, who see every group
+func (s *ProjectGroupService) VisibleGroupIDs(ctx context.Context, userID int) (ids []int, all bool, err error) {
+	isAdmin, err := s.userRepo.HasRole(ctx, userID, entities.AdminRoleName)
+	if err != nil {
+		return nil, false, err
+	}
+	if isAdmin {
+		return nil, true, nil
+	}
+
+	tree, err := s.groupRepo.GetTree(ctx, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, false, err
+	}
+	return tree.VisibleTo(userID), false, nil
+}
+
+// EnsureVisible fails with a validation error unless groupID is a group the user may assign projects to
+func (s *ProjectGroupService) EnsureVisible(ctx context.Context, groupID *int, userID int) error {
+	if groupID == nil {
+		return nil
+	}
+	if _, err := s.visibleTree(ctx, userID, *groupID); err != nil {
+		if apperrors.IsKind(err, apperrors.KindNotFound) {
+			return apperrors.Validation("project group %d does not exist", *groupID)
+		}
+		return err
+	}
+	return nil
+}
+
+// visibleTree loads the tenant's groups and checks that the user may see group id
+func (s *ProjectGroupService) visibleTree(ctx context.Context, userID int, id int) (*entities.ProjectGroupTree, error) {
+	tree, err := s.groupRepo.GetTree(ctx, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if tree.Get(id) == nil {
+		return nil, apperrors.NotFound("project group with ID %d not found", id)
+	}
+	if tree.IsVisibleTo(id, userID) {
+		return tree, nil
+	}
+
+	isAdmin, err := s.userRepo.HasRole(ctx, userID, entities.AdminRoleName)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		// Hidden groups look the same as missing ones
+		return nil, apperrors.NotFound("project group with ID %d not found", id)
+	}
+	return tree, nil
+}
+
+func (s *ProjectGroupService) visibleIDs(ctx context.Context, tree *entities.ProjectGroupTree, userID int) (map[int]bool, error) {
+	isAdmin, err := s.userRepo.HasRole(ctx, userID, entities.AdminRoleName)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make(map[int]bool)
+	for _, group := range tree.Groups() {
+		if isAdmin || tree.IsVisibleTo(group.ID, userID) {
+			visible[group.ID] = true
+		}
+	}
+	return visible, nil
+}
+
+// stats rolls the direct counts of every visible group up into the requested groups
+func (s *ProjectGroupService) stats(ctx context.Context, tree *entities.ProjectGroupTree, userID int, ids []int) ([]dtos.ProjectGroupStatsDto, error) {
+	visible, err := s.visibleIDs(ctx, tree, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	visibleIDs := make([]int, 0, len(visible))
+	for id := range visible {
+		visibleIDs = append(visibleIDs, id)
+	}
+	counts, err := s.groupRepo.GetCounts(ctx, visibleIDs)
+	if err != nil {
+		return nil, err
+	}
+	countsByGroup := make(map[int]persistence.ProjectGroupCounts, len(counts))
+	for _, count := range counts {
+		countsByGroup[count.GroupID] = count
+	}
+
+	result := make([]dtos.ProjectGroupStatsDto, len(ids))
+	for i, id := range ids {
+		group := tree.Get(id)
+		stats := dtos.ProjectGroupStatsDto{
+			GroupID:            id,
+			Name:               group.Name,
+			ParentID:           group.ParentID,
+			DirectProjectCount: int(countsByGroup[id].ProjectCount),
+		}
+		for _, memberID := range tree.Subtree(id) {
+			if !visible[memberID] {
+				continue
+			}
+			count := countsByGroup[memberID]
+			stats.ProjectCount += int(count.ProjectCount)
+			stats.OcrProjectCount += int(count.OcrProjectCount)
+			stats.ApprovedOcrProjectCount += int(count.ApprovedOcrProjectCount)
+		}
+		if stats.OcrProjectCount > 0 {
+			percent := float64(stats.ApprovedOcrProjectCount) * 100 / float64(stats.OcrProjectCount)
+			stats.OcrCompletionPercent = math.Round(percent*10) / 10
+		}
+		result[i] = stats
+	}
+	return result, nil
+}
+
+func (s *ProjectGroupService) applyInput(ctx context.Context, group *entities.ProjectGroup, input *dtos.CreateProjectGroupDto, userID int) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return apperrors.Validation("project group name is required")
+	}
+
+	if input.ParentID != nil {
+		if err := s.EnsureVisible(ctx, input.ParentID, userID); err != nil {
+			return err
+		}
+	}
+
+	exists, err := s.groupRepo.ExistsWithName(ctx, group.TenantID, input.ParentID, name, group.ID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return apperrors.Conflict("a project group named %q already exists here", name)
+	}
+
+	group.Name = name
+	group.Description = input.Description
+	group.ParentID = input.ParentID
+	return nil
+}
+
+func mapProjectGroupToDto(group *entities.ProjectGroup) dtos.ProjectGroupDto {
+	return dtos.ProjectGroupDto{
+		FullAuditedEntityDto: dtos.FullAuditedEntityDto{
+			AuditedEntityDto: dtos.AuditedEntityDto{
+				EntityDto: dtos.EntityDto{
+					ID: group.ID,
+				},
+				CreatedAt:      group.CreatedAt,
+				UpdatedAt:      group.UpdatedAt,
+				CreatorUserID:  group.CreatorUserID,
+				LastModifierID: group.LastModifierID,
+			},
+			DeleterUserID: group.DeleterUserID,
+			DeletionTime:  group.DeletionTime,
+			IsDeleted:     group.IsDeleted,
+			Version:       group.Version,
+		},
+		TenantID:    group.TenantID,
+		Name:        group.Name,
+		Description: group.Description,
+		ParentID:    group.ParentID,
+	}
+}
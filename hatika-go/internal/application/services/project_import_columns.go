@@ -0,0 +1,276 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/valueobjects"
+	"hatika-go/internal/infrastructure/spreadsheet"
+	apperrors "hatika-go/pkg/errors"
+)
+
+// projectImportField maps a column onto a CreateProjectDto field
+type projectImportField struct {
+	Name    string
+	Headers []string
+	Set     func(dto *dtos.CreateProjectDto, value string) error
+}
+
+// projectImportFields lists the importable fields with the English and Turkish headers recognized by default.
+// The field name itself is always accepted as a header too.
+var projectImportFields = []projectImportField{
+	{"projectName", []string{"Project Name", "Proje Adı", "Proje İsmi"}, textSetter(255, func(d *dtos.CreateProjectDto) *string { return &d.ProjectName })},
+	{"projectCode", []string{"Project Code", "Proje Kodu", "Proje No"}, textSetter(100, func(d *dtos.CreateProjectDto) *string { return &d.ProjectCode })},
+	{"projectComment", []string{"Comment", "Açıklama", "Proje Açıklaması", "Not"}, textSetter(0, func(d *dtos.CreateProjectDto) *string { return &d.ProjectComment })},
+	{"projectMuellef", []string{"Müellif", "Proje Müellifi"}, textSetter(255, func(d *dtos.CreateProjectDto) *string { return &d.ProjectMuellef })},
+	{"ada", []string{"Ada", "Ada No"}, valueSetter(valueobjects.NewAda, func(d *dtos.CreateProjectDto) **valueobjects.Ada { return &d.Ada })},
+	{"parsel", []string{"Parsel", "Parsel No"}, valueSetter(valueobjects.NewParsel, func(d *dtos.CreateProjectDto) **valueobjects.Parsel { return &d.Parsel })},
+	{"talepGucu", []string{"Talep Gücü", "Talep Gücü (kW)"}, valueSetter(valueobjects.NewPower, func(d *dtos.CreateProjectDto) **valueobjects.Power { return &d.TalepGucu })},
+	{"kuruluGuc", []string{"Kurulu Güç", "Kurulu Güç (kW)"}, valueSetter(valueobjects.NewPower, func(d *dtos.CreateProjectDto) **valueobjects.Power { return &d.KuruluGuc })},
+	{"bagimsizBS", []string{"Bağımsız Bölüm Sayısı", "BBS"}, intSetter(func(d *dtos.CreateProjectDto) **int { return &d.BagimsizBS })},
+	{"blokS", []string{"Blok Sayısı"}, intSetter(func(d *dtos.CreateProjectDto) **int { return &d.BlokS })},
+	{"yapiYuksekligi", []string{"Yapı Yüksekliği", "Yapı Yüksekliği (m)"}, setYapiYuksekligi},
+	{"ruhsatGecerlilikDate", []string{"Ruhsat Geçerlilik Tarihi", "Ruhsat Geçerlilik", "Ruhsat Tarihi"}, setRuhsatGecerlilikDate},
+	{"yapiSahibi", []string{"Yapı Sahibi", "Mal Sahibi"}, textSetter(255, func(d *dtos.CreateProjectDto) *string { return &d.YapiSahibi })},
+	{"adress", []string{"Address", "Adres"}, textSetter(0, func(d *dtos.CreateProjectDto) *string { return &d.Adress })},
+	{"groupId", []string{"Group Id", "Grup", "Grup No"}, setGroupID},
+	{"bildirimNo", []string{"Bildirim No", "Bildirim Numarası"}, textSetter(100, func(d *dtos.CreateProjectDto) *string { return &d.BildirimNo })},
+}
+
+func textSetter(maxLength int, field func(*dtos.CreateProjectDto) *string) func(*dtos.CreateProjectDto, string) error {
+	return func(dto *dtos.CreateProjectDto, value string) error {
+		if maxLength > 0 && utf8.RuneCountInString(value) > maxLength {
+			return fmt.Errorf("must be at most %d characters", maxLength)
+		}
+		*field(dto) = value
+		return nil
+	}
+}
+
+func intSetter(field func(*dtos.CreateProjectDto) **int) func(*dtos.CreateProjectDto, string) error {
+	return func(dto *dtos.CreateProjectDto, value string) error {
+		n, err := spreadsheet.ParseInt(value)
+		if err != nil {
+			return err
+		}
+		if n < 0 {
+			return fmt.Errorf("must not be negative")
+		}
+		*field(dto) = &n
+		return nil
+	}
+}
+
+// valueSetter reads a whole number and validates it with the value object's constructor
+func valueSetter[T any](create func(int) (T, error), field func(*dtos.CreateProjectDto) **T) func(*dtos.CreateProjectDto, string) error {
+	return func(dto *dtos.CreateProjectDto, value string) error {
+		n, err := spreadsheet.ParseInt(value)
+		if err != nil {
+			return err
+		}
+		v, err := create(n)
+		if err != nil {
+			return err
+		}
+		*field(dto) = &v
+		return nil
+	}
+}
+
+func setYapiYuksekligi(dto *dtos.CreateProjectDto, value string) error {
+	f, err := spreadsheet.ParseFloat(value)
+	if err != nil {
+		return err
+	}
+	if f < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+	dto.YapiYuksekligi = &f
+	return nil
+}
+
+func setRuhsatGecerlilikDate(dto *dtos.CreateProjectDto, value string) error {
+	t, err := spreadsheet.ParseDate(value)
+	if err != nil {
+		return err
+	}
+	date, err := valueobjects.NewPermitDate(t)
+	if err != nil {
+		return err
+	}
+	dto.RuhsatGecerlilikDate = &date
+	return nil
+}
+
+func setGroupID(dto *dtos.CreateProjectDto, value string) error {
+	n, err := spreadsheet.ParseInt(value)
+	if err != nil {
+		return err
+	}
+	if n < 1 {
+		return fmt.Errorf("must be a project group ID")
+	}
+	dto.GroupID = &n
+	return nil
+}
+
+// projectImportColumns maps the columns of a file to import fields by position
+type projectImportColumns struct {
+	order   []int
+	fields  map[int]*projectImportField
+	headers map[string]string
+	ignored []string
+}
+
+// projectImportHeaders indexes the built-in headers and the configured aliases by normalized header.
+// Aliases for unknown fields are logged and skipped.
+func projectImportHeaders(aliases map[string][]string) map[string]*projectImportField {
+	fieldsByName := make(map[string]*projectImportField, len(projectImportFields))
+	headers := make(map[string]*projectImportField)
+	for i := range projectImportFields {
+		field := &projectImportFields[i]
+		fieldsByName[strings.ToLower(field.Name)] = field
+		headers[normalizeHeader(field.Name)] = field
+		for _, h := range field.Headers {
+			headers[normalizeHeader(h)] = field
+		}
+	}
+
+	// Viper lowercases map keys, so configured field names are matched without case
+	for name, aliasHeaders := range aliases {
+		field, ok := fieldsByName[strings.ToLower(name)]
+		if !ok {
+			log.Printf("Warning: import.header_aliases: unknown project field %q", name)
+			continue
+		}
+		for _, h := range aliasHeaders {
+			headers[normalizeHeader(h)] = field
+		}
+	}
+	return headers
+}
+
+// newProjectImportColumns matches the header row against the request mapping first, then against the
+// known headers. Headers are compared without case, accents or punctuation.
+func newProjectImportColumns(header []string, mapping string, known map[string]*projectImportField) (*projectImportColumns, error) {
+	requested := make(map[string]*projectImportField)
+	if strings.TrimSpace(mapping) != "" {
+		var raw map[string]string
+		if err := json.Unmarshal([]byte(mapping), &raw); err != nil {
+			return nil, apperrors.Validation("mapping must be a JSON object from column header to field name: %v", err)
+		}
+		for h, name := range raw {
+			field, ok := known[normalizeHeader(name)]
+			if !ok {
+				return nil, apperrors.Validation("mapping: unknown project field %q", name).
+					WithDetails(map[string]interface{}{"importableFields": projectImportFieldNames()})
+			}
+			requested[normalizeHeader(h)] = field
+		}
+	}
+
+	columns := &projectImportColumns{
+		fields:  make(map[int]*projectImportField),
+		headers: make(map[string]string),
+	}
+	mappedBy := make(map[string]string)
+	for i, h := range header {
+		h = strings.TrimSpace(h)
+		key := normalizeHeader(h)
+		if key == "" {
+			continue
+		}
+
+		field, ok := requested[key]
+		if !ok {
+			field, ok = known[key]
+		}
+		if !ok {
+			columns.ignored = append(columns.ignored, h)
+			continue
+		}
+		if previous, taken := mappedBy[field.Name]; taken {
+			return nil, apperrors.Validation("columns %q and %q both map to %s", previous, h, field.Name)
+		}
+
+		mappedBy[field.Name] = h
+		columns.order = append(columns.order, i)
+		columns.fields[i] = field
+		columns.headers[h] = field.Name
+	}
+
+	for _, required := range []string{"projectCode", "projectName"} {
+		if _, ok := mappedBy[required]; !ok {
+			return nil, apperrors.Validation("no column maps to %s", required).
+				WithDetails(map[string]interface{}{"columns": header, "ignoredColumns": columns.ignored})
+		}
+	}
+
+	return columns, nil
+}
+
+// Value returns the trimmed cell of the column mapped to the field, or "" when there is none
+func (c *projectImportColumns) Value(row []string, fieldName string) string {
+	for _, i := range c.order {
+		if c.fields[i].Name == fieldName && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+	}
+	return ""
+}
+
+// Apply copies the non-empty cells of a row onto the DTO and returns the problems per field
+func (c *projectImportColumns) Apply(dto *dtos.CreateProjectDto, row []string) []string {
+	var problems []string
+	for _, i := range c.order {
+		if i >= len(row) {
+			continue
+		}
+		value := strings.TrimSpace(row[i])
+		if value == "" {
+			continue
+		}
+		field := c.fields[i]
+		if err := field.Set(dto, value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", field.Name, err))
+		}
+	}
+	return problems
+}
+
+func projectImportFieldNames() []string {
+	names := make([]string, len(projectImportFields))
+	for i, field := range projectImportFields {
+		names[i] = field.Name
+	}
+	return names
+}
+
+// headerFolding maps Turkish letters to their unaccented lower case form
+var headerFolding = strings.NewReplacer(
+	"İ", "i", "I", "i", "ı", "i",
+	"Ğ", "g", "ğ", "g",
+	"Ü", "u", "ü", "u",
+	"Ş", "s", "ş", "s",
+	"Ö", "o", "ö", "o",
+	"Ç", "c", "ç", "c",
+	"Â", "a", "â", "a",
+	"Î", "i", "î", "i",
+	"Û", "u", "û", "u",
+)
+
+// normalizeHeader folds a header so "Proje Adı", "PROJE ADI" and "proje_adi" compare equal
+func normalizeHeader(header string) string {
+	var b strings.Builder
+	for _, r := range headerFolding.Replace(header) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,417 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/infrastructure/spreadsheet"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
+)
+
+const (
+	// projectImportBatchSize is the number of row results stored at once; progress is visible per batch
+	projectImportBatchSize = 100
+	// projectCodeLookupSize caps the number of codes per existing-project query
+	projectCodeLookupSize = 500
+)
+
+// projectImportLine is a non-empty data row with its line number in the file
+type projectImportLine struct {
+	number int
+	cells  []string
+}
+
+// ProjectImportService creates and updates projects in bulk from CSV or XLSX files
+type ProjectImportService struct {
+	importRepo     *persistence.ProjectImportRepository
+	projectRepo    *persistence.ProjectRepository
+	projectService *ProjectService
+	groupService   *ProjectGroupService
+	headers        map[string]*projectImportField
+	maxRows        int
+	maxSize        int64
+}
+
+// NewProjectImportService creates a new project import service
+func NewProjectImportService(
+	importRepo *persistence.ProjectImportRepository,
+	projectRepo *persistence.ProjectRepository,
+	projectService *ProjectService,
+	groupService *ProjectGroupService,
+	importConfig config.ImportConfig,
+	maxSize int64,
+) *ProjectImportService {
+	return &ProjectImportService{
+		importRepo:     importRepo,
+		projectRepo:    projectRepo,
+		projectService: projectService,
+		groupService:   groupService,
+		headers:        projectImportHeaders(importConfig.HeaderAliases),
+		maxRows:        importConfig.MaxRows,
+		maxSize:        maxSize,
+	}
+}
+
+// Import checks the file and its header row, then processes the rows in the background. Rows are matched
+// to existing projects by ProjectCode; a dry run validates every row without saving anything.
+func (s *ProjectImportService) Import(
+	ctx context.Context,
+	userID int,
+	fileName string,
+	file io.Reader,
+	size int64,
+	input *dtos.ProjectImportRequestDto,
+) (*dtos.ProjectImportDto, error) {
+	if size > s.maxSize {
+		return nil, apperrors.Validation("file exceeds the %d MB upload limit", s.maxSize>>20)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, s.maxSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read uploaded file: %w", err)
+	}
+
+	format, err := spreadsheet.DetectFormat(fileName, data[:min(len(data), 8)])
+	if err != nil {
+		return nil, apperrors.Validation("%v", err)
+	}
+	rows, err := spreadsheet.ReadAll(bytes.NewReader(data), format)
+	if err != nil {
+		return nil, apperrors.Validation("could not read the file: %v", err)
+	}
+	if len(rows) == 0 {
+		return nil, apperrors.Validation("the file is empty")
+	}
+
+	columns, err := newProjectImportColumns(rows[0], input.Mapping, s.headers)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]projectImportLine, 0, len(rows)-1)
+	for i, cells := range rows[1:] {
+		if isBlankRow(cells) {
+			continue
+		}
+		lines = append(lines, projectImportLine{number: i + 2, cells: cells})
+	}
+	if len(lines) == 0 {
+		return nil, apperrors.Validation("the file has no data rows")
+	}
+	if s.maxRows > 0 && len(lines) > s.maxRows {
+		return nil, apperrors.Validation("the file has %d rows; at most %d can be imported at once", len(lines), s.maxRows)
+	}
+
+	projectImport := &entities.ProjectImport{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: multitenancy.TenantIDFromContext(ctx)},
+		FileName:          filepath.Base(fileName),
+		Format:            string(format),
+		DryRun:            input.DryRun,
+		Status:            entities.ProjectImportStatusQueued,
+		TotalRows:         len(lines),
+		TriggeredByUserID: &userID,
+	}
+	if err := s.importRepo.Insert(ctx, projectImport); err != nil {
+		return nil, fmt.Errorf("failed to create project import: %w", err)
+	}
+
+	// The import row must be committed before the background work updates it
+	background := persistence.WithoutUnitOfWork(context.WithoutCancel(ctx))
+	persistence.AfterCommit(ctx, func() {
+		go s.process(background, projectImport, columns, lines, userID)
+	})
+
+	dto := mapProjectImportToDto(projectImport)
+	dto.Columns = columns.headers
+	dto.IgnoredColumns = columns.ignored
+	return &dto, nil
+}
+
+// GetByID returns the progress and counters of an import
+func (s *ProjectImportService) GetByID(ctx context.Context, id int) (*dtos.ProjectImportDto, error) {
+	projectImport, err := s.importRepo.GetByIDForTenant(ctx, id, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	dto := mapProjectImportToDto(projectImport)
+	return &dto, nil
+}
+
+// GetRows pages through the per-row report of an import
+func (s *ProjectImportService) GetRows(ctx context.Context, id int, request *dtos.ProjectImportRowsRequestDto) (*dtos.PagedResultDto[dtos.ProjectImportRowDto], error) {
+	if _, err := s.importRepo.GetByIDForTenant(ctx, id, multitenancy.TenantIDFromContext(ctx)); err != nil {
+		return nil, err
+	}
+
+	var action *entities.ProjectImportRowAction
+	if request.Action != nil {
+		a := entities.ProjectImportRowAction(*request.Action)
+		action = &a
+	}
+
+	rows, totalCount, err := s.importRepo.GetRows(ctx, id, action, request.PageNumber, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dtos.ProjectImportRowDto, len(rows))
+	for i := range rows {
+		items[i] = mapProjectImportRowToDto(&rows[i])
+	}
+
+	return &dtos.PagedResultDto[dtos.ProjectImportRowDto]{
+		TotalCount: int(totalCount),
+		Items:      items,
+	}, nil
+}
+
+func (s *ProjectImportService) process(
+	ctx context.Context,
+	projectImport *entities.ProjectImport,
+	columns *projectImportColumns,
+	lines []projectImportLine,
+	userID int,
+) {
+	projectImport.Start()
+	if err := s.importRepo.SaveProgress(ctx, projectImport, nil); err != nil {
+		log.Printf("Project import %d: %v", projectImport.ID, err)
+		return
+	}
+
+	existing, err := s.existingProjects(ctx, columns, lines)
+	if err != nil {
+		s.fail(ctx, projectImport, err)
+		return
+	}
+
+	seen := make(map[string]int, len(lines))
+	batch := make([]entities.ProjectImportRow, 0, projectImportBatchSize)
+	for _, line := range lines {
+		row := s.importLine(ctx, projectImport.DryRun, columns, line, existing, seen, userID)
+		projectImport.Record(&row)
+		batch = append(batch, row)
+
+		if len(batch) == projectImportBatchSize {
+			if err := s.importRepo.SaveProgress(ctx, projectImport, batch); err != nil {
+				s.fail(ctx, projectImport, err)
+				return
+			}
+			batch = make([]entities.ProjectImportRow, 0, projectImportBatchSize)
+		}
+	}
+
+	projectImport.Complete()
+	if err := s.importRepo.SaveProgress(ctx, projectImport, batch); err != nil {
+		s.fail(ctx, projectImport, err)
+	}
+}
+
+// importLine validates one row and, unless this is a dry run, creates or updates its project
+func (s *ProjectImportService) importLine(
+	ctx context.Context,
+	dryRun bool,
+	columns *projectImportColumns,
+	line projectImportLine,
+	existing map[string]*entities.Project,
+	seen map[string]int,
+	userID int,
+) entities.ProjectImportRow {
+	code := columns.Value(line.cells, "projectCode")
+	row := entities.ProjectImportRow{
+		RowNumber:   line.number,
+		ProjectCode: truncateRunes(code, 100),
+	}
+
+	if code == "" {
+		return failedImportRow(row, "projectCode: is required")
+	}
+	if first, ok := seen[code]; ok {
+		return failedImportRow(row, fmt.Sprintf("projectCode: duplicate of row %d", first))
+	}
+	seen[code] = line.number
+
+	var input dtos.CreateProjectDto
+	project := existing[code]
+	if project != nil {
+		if project.IsDeleted || !sameTenant(project.TenantID, multitenancy.TenantIDFromContext(ctx)) {
+			return failedImportRow(row, "projectCode: already used by a deleted project or by another tenant")
+		}
+		input = projectToCreateDto(project)
+	}
+
+	problems := columns.Apply(&input, line.cells)
+	if input.ProjectName == "" {
+		problems = append(problems, "projectName: is required")
+	}
+	if len(problems) > 0 {
+		return failedImportRow(row, problems...)
+	}
+
+	if project == nil {
+		row.Action = entities.ProjectImportRowCreated
+		if dryRun {
+			if err := s.groupService.EnsureVisible(ctx, input.GroupID, userID); err != nil {
+				return failedImportRow(row, err.Error())
+			}
+			return row
+		}
+
+		created, err := s.projectService.Create(ctx, &input, userID)
+		if err != nil {
+			return failedImportRow(row, err.Error())
+		}
+		row.ProjectID = &created.ID
+		return row
+	}
+
+	row.Action = entities.ProjectImportRowUpdated
+	row.ProjectID = &project.ID
+	if dryRun {
+		if err := s.projectService.ensureProjectVisible(ctx, project, userID); err != nil {
+			return failedImportRow(row, err.Error())
+		}
+		if err := s.groupService.EnsureVisible(ctx, input.GroupID, userID); err != nil {
+			return failedImportRow(row, err.Error())
+		}
+		return row
+	}
+
+	if _, err := s.projectService.Update(ctx, project.ID, &dtos.UpdateProjectDto{CreateProjectDto: input}, userID, nil); err != nil {
+		return failedImportRow(row, err.Error())
+	}
+	return row
+}
+
+// existingProjects loads the projects already using the codes of the file, keyed by code
+func (s *ProjectImportService) existingProjects(
+	ctx context.Context,
+	columns *projectImportColumns,
+	lines []projectImportLine,
+) (map[string]*entities.Project, error) {
+	codes := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if code := columns.Value(line.cells, "projectCode"); code != "" {
+			codes = append(codes, code)
+		}
+	}
+
+	existing := make(map[string]*entities.Project, len(codes))
+	for start := 0; start < len(codes); start += projectCodeLookupSize {
+		end := min(start+projectCodeLookupSize, len(codes))
+		projects, err := s.projectRepo.GetByProjectCodes(ctx, codes[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for i := range projects {
+			existing[projects[i].ProjectCode] = &projects[i]
+		}
+	}
+	return existing, nil
+}
+
+func (s *ProjectImportService) fail(ctx context.Context, projectImport *entities.ProjectImport, err error) {
+	log.Printf("Project import %d: %v", projectImport.ID, err)
+	projectImport.Fail(err)
+	if err := s.importRepo.SaveProgress(ctx, projectImport, nil); err != nil {
+		log.Printf("Project import %d: %v", projectImport.ID, err)
+	}
+}
+
+func failedImportRow(row entities.ProjectImportRow, problems ...string) entities.ProjectImportRow {
+	row.Action = entities.ProjectImportRowFailed
+	row.ProjectID = nil
+	row.Errors = strings.Join(problems, "\n")
+	return row
+}
+
+// projectToCreateDto copies the importable fields of a project so a row only overwrites the cells it fills
+func projectToCreateDto(project *entities.Project) dtos.CreateProjectDto {
+	return dtos.CreateProjectDto{
+		ProjectName:          project.ProjectName,
+		ProjectCode:          project.ProjectCode,
+		ProjectComment:       project.ProjectComment,
+		ProjectMuellef:       project.ProjectMuellef,
+		Ada:                  project.Ada,
+		Parsel:               project.Parsel,
+		TalepGucu:            project.TalepGucu,
+		KuruluGuc:            project.KuruluGuc,
+		BagimsizBS:           project.BagimsizBS,
+		BlokS:                project.BlokS,
+		YapiYuksekligi:       project.YapiYuksekligi,
+		RuhsatGecerlilikDate: project.RuhsatGecerlilikDate,
+		YapiSahibi:           project.YapiSahibi,
+		Adress:               project.Adress,
+		GroupID:              project.GroupID,
+		BildirimNo:           project.BildirimNo,
+	}
+}
+
+func sameTenant(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func isBlankRow(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func truncateRunes(value string, maxLength int) string {
+	runes := []rune(value)
+	if len(runes) <= maxLength {
+		return value
+	}
+	return string(runes[:maxLength])
+}
+
+func mapProjectImportToDto(projectImport *entities.ProjectImport) dtos.ProjectImportDto {
+	return dtos.ProjectImportDto{
+		ID:                projectImport.ID,
+		CreatedAt:         projectImport.CreatedAt,
+		FileName:          projectImport.FileName,
+		Format:            projectImport.Format,
+		DryRun:            projectImport.DryRun,
+		Status:            int(projectImport.Status),
+		StatusName:        projectImport.Status.String(),
+		Error:             projectImport.Error,
+		TotalRows:         projectImport.TotalRows,
+		ProcessedRows:     projectImport.ProcessedRows,
+		CreatedCount:      projectImport.CreatedCount,
+		UpdatedCount:      projectImport.UpdatedCount,
+		FailedCount:       projectImport.FailedCount,
+		TriggeredByUserID: projectImport.TriggeredByUserID,
+		StartedAt:         projectImport.StartedAt,
+		CompletedAt:       projectImport.CompletedAt,
+	}
+}
+
+func mapProjectImportRowToDto(row *entities.ProjectImportRow) dtos.ProjectImportRowDto {
+	dto := dtos.ProjectImportRowDto{
+		RowNumber:   row.RowNumber,
+		ProjectCode: row.ProjectCode,
+		Action:      int(row.Action),
+		ActionName:  row.Action.String(),
+		ProjectID:   row.ProjectID,
+	}
+	if row.Errors != "" {
+		dto.Errors = strings.Split(row.Errors, "\n")
+	}
+	return dto
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/infrastructure/storage"
+)
+
+// ProjectPurgeJobName is the scheduler name of the purge job; it also names its advisory lock
+const ProjectPurgeJobName = "project-purge"
+
+// defaultPurgeBatchSize is the number of deleted projects loaded at once when none is configured
+const defaultPurgeBatchSize = 100
+
+// projectPurgeDetails is the audit record of a purged project
+type projectPurgeDetails struct {
+	ProjectCode   string    `json:"projectCode"`
+	ProjectName   string    `json:"projectName"`
+	DeletedAt     time.Time `json:"deletedAt"`
+	DeleterUserID *int      `json:"deleterUserId,omitempty"`
+	OcrProjects   int64     `json:"ocrProjects"`
+	Documents     int64     `json:"documents"`
+	OcrRuns       int64     `json:"ocrRuns"`
+	Files         []string  `json:"files,omitempty"`
+}
+
+// ProjectPurgeService permanently removes projects that stayed soft-deleted past the retention period
+type ProjectPurgeService struct {
+	projectRepo   *persistence.ProjectRepository
+	auditLogRepo  *persistence.AuditLogRepository
+	unitOfWork    *persistence.UnitOfWork
+	fileStorage   storage.FileStorage
+	retentionDays int
+	batchSize     int
+}
+
+// NewProjectPurgeService creates a new project purge service
+func NewProjectPurgeService(
+	projectRepo *persistence.ProjectRepository,
+	auditLogRepo *persistence.AuditLogRepository,
+	unitOfWork *persistence.UnitOfWork,
+	fileStorage storage.FileStorage,
+	purgeConfig config.ProjectPurgeConfig,
+) *ProjectPurgeService {
+	batchSize := purgeConfig.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+
+	return &ProjectPurgeService{
+		projectRepo:   projectRepo,
+		auditLogRepo:  auditLogRepo,
+		unitOfWork:    unitOfWork,
+		fileStorage:   fileStorage,
+		retentionDays: purgeConfig.RetentionDays,
+		batchSize:     batchSize,
+	}
+}
+
+// PurgeExpired hard-deletes every project of every tenant deleted more than the retention period ago,
+// together with its OCR projects, documents and stored files. Each project is removed in its own
+// transaction with the audit log entry that records it; a project that fails is left for the next run.
+func (s *ProjectPurgeService) PurgeExpired(ctx context.Context) error {
+	if s.retentionDays <= 0 {
+		return nil
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -s.retentionDays)
+	var failures []error
+	purged, afterID := 0, 0
+	for {
+		projects, err := s.projectRepo.FindDeletedBefore(ctx, cutoff, afterID, s.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(projects) == 0 {
+			break
+		}
+
+		for i := range projects {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := s.purge(ctx, &projects[i]); err != nil {
+				failures = append(failures, fmt.Errorf("project %d: %w", projects[i].ID, err))
+				continue
+			}
+			purged++
+		}
+		afterID = projects[len(projects)-1].ID
+	}
+
+	log.Printf("Project purge: %d projects deleted before %s removed permanently", purged, cutoff.Format(time.DateOnly))
+	return errors.Join(failures...)
+}
+
+// purge removes one project and records it. Files are deleted only once the rows are gone for good;
+// a file that cannot be deleted is logged and stays behind as an orphan rather than failing the purge.
+func (s *ProjectPurgeService) purge(ctx context.Context, project *entities.Project) error {
+	var files []string
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		removed, err := s.projectRepo.HardDelete(ctx, project.ID)
+		if err != nil {
+			return err
+		}
+		files = removed.StorageKeys
+
+		details, err := json.Marshal(projectPurgeDetails{
+			ProjectCode:   project.ProjectCode,
+			ProjectName:   project.ProjectName,
+			DeletedAt:     *project.DeletionTime,
+			DeleterUserID: project.DeleterUserID,
+			OcrProjects:   removed.OcrProjects,
+			Documents:     removed.Documents,
+			OcrRuns:       removed.OcrRuns,
+			Files:         removed.StorageKeys,
+		})
+		if err != nil {
+			return err
+		}
+
+		entry := &entities.AuditLog{
+			MultiTenantEntity: entities.MultiTenantEntity{TenantID: project.TenantID},
+			Action:            entities.AuditActionProjectPurged,
+			EntityType:        "Project",
+			EntityID:          project.ID,
+			Details:           string(details),
+		}
+		if err := s.auditLogRepo.Insert(ctx, entry); err != nil {
+			return fmt.Errorf("failed to record purge in the audit log: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range files {
+		if err := s.fileStorage.Delete(ctx, key); err != nil {
+			log.Printf("Warning: project %d was purged but its file %s could not be deleted: %v", project.ID, key, err)
+		}
+	}
+	return nil
+}
@@ -3,51 +3,60 @@ package services
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"hatika-go/internal/application/dtos"
 	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/events"
+	"hatika-go/internal/domain/specifications"
+	"hatika-go/internal/infrastructure/eventbus"
 	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
 )
 
 // ProjectService handles project business logic
 type ProjectService struct {
-	projectRepo *persistence.ProjectRepository
+	projectRepo    *persistence.ProjectRepository
+	ocrProjectRepo *persistence.OcrProjectRepository
+	templateRepo   *persistence.OcrProjectTemplateRepository
+	groupService   *ProjectGroupService
+	unitOfWork     *persistence.UnitOfWork
+	eventBus       *eventbus.Bus
 }
 
 // NewProjectService creates a new project service
-func NewProjectService(projectRepo *persistence.ProjectRepository) *ProjectService {
+func NewProjectService(
+	projectRepo *persistence.ProjectRepository,
+	ocrProjectRepo *persistence.OcrProjectRepository,
+	templateRepo *persistence.OcrProjectTemplateRepository,
+	groupService *ProjectGroupService,
+	unitOfWork *persistence.UnitOfWork,
+	eventBus *eventbus.Bus,
+) *ProjectService {
 	return &ProjectService{
-		projectRepo: projectRepo,
+		projectRepo:    projectRepo,
+		ocrProjectRepo: ocrProjectRepo,
+		templateRepo:   templateRepo,
+		groupService:   groupService,
+		unitOfWork:     unitOfWork,
+		eventBus:       eventBus,
 	}
 }
 
-func (s *ProjectService) GetAll(ctx context.Context, request *dtos.PagedProjectResultRequestDto) (*dtos.PagedResultDto[dtos.ProjectDto], error) {
-	filters := make(map[string]interface{})
-
-	if request.GroupID != 0 {
-		filters["groupId"] = request.GroupID
-	}
-	if request.BildirimNo != "" {
-		filters["bildirimNo"] = request.BildirimNo
-	}
-	if request.ProjectCode != "" {
-		filters["projectCode"] = request.ProjectCode
-	}
-	if request.ProjectName != "" {
-		filters["projectName"] = request.ProjectName
-	}
-	if request.ProjectMuellef != "" {
-		filters["projectMuellef"] = request.ProjectMuellef
-	}
-	if len(request.IdList) > 0 {
-		filters["idList"] = request.IdList
+func (s *ProjectService) GetAll(ctx context.Context, request *dtos.PagedProjectResultRequestDto, userID int) (*dtos.PagedResultDto[dtos.ProjectDto], error) {
+	spec, err := s.listSpecification(ctx, &request.ProjectFilterDto, request.Filter, userID)
+	if err != nil {
+		return nil, err
 	}
 
 	projects, totalCount, err := s.projectRepo.GetAllIncludingOcrProjects(
 		ctx,
 		request.PageNumber,
 		request.PageSize,
-		filters,
+		request.Sorting,
+		spec,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get projects: %w", err)
@@ -64,17 +73,185 @@ func (s *ProjectService) GetAll(ctx context.Context, request *dtos.PagedProjectR
 	}, nil
 }
 
-func (s *ProjectService) GetByID(ctx context.Context, id int) (*dtos.ProjectDto, error) {
+// GetAllByCursor lists projects with keyset pagination, which stays fast on deep pages
+func (s *ProjectService) GetAllByCursor(ctx context.Context, request *dtos.CursorProjectResultRequestDto, userID int) (*dtos.CursorPagedResultDto[dtos.ProjectDto], error) {
+	spec, err := s.listSpecification(ctx, &request.ProjectFilterDto, request.Filter, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.projectRepo.GetByCursorIncludingOcrProjects(
+		ctx,
+		persistence.CursorRequest{
+			Cursor:       request.Cursor,
+			Limit:        request.Limit,
+			Sorting:      request.Sorting,
+			IncludeTotal: request.IncludeTotal,
+		},
+		spec,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	result := &dtos.CursorPagedResultDto[dtos.ProjectDto]{
+		Items:      make([]dtos.ProjectDto, len(page.Items)),
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	}
+	for i := range page.Items {
+		result.Items[i] = s.mapToDto(&page.Items[i])
+	}
+	if page.TotalCount != nil {
+		totalCount := int(*page.TotalCount)
+		result.TotalCount = &totalCount
+	}
+
+	return result, nil
+}
+
+// listSpecification combines the list filters, the filter expression and the group visibility of the user;
+// the repository adds the tenant of the context to every list built from it
+func (s *ProjectService) listSpecification(ctx context.Context, filter *dtos.ProjectFilterDto, expression string, userID int) (specifications.Specification, error) {
+	parsed, err := s.projectRepo.ParseFilter(expression)
+	if err != nil {
+		return nil, err
+	}
+	visibility, err := s.groupVisibility(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return specifications.And(projectSpecification(filter), parsed, visibility), nil
+}
+
+// groupVisibility restricts projects to the groups the user may see; ungrouped projects stay visible
+func (s *ProjectService) groupVisibility(ctx context.Context, userID int) (specifications.Specification, error) {
+	groupIDs, all, err := s.groupService.VisibleGroupIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if all {
+		return nil, nil
+	}
+	return specifications.Or(specifications.Equals("groupId", nil), specifications.In("groupId", groupIDs...)), nil
+}
+
+// visibleGroupIDs returns the groups whose projects the user may see besides the ungrouped ones;
+// nil means every group and an empty slice only ungrouped projects
+func (s *ProjectService) visibleGroupIDs(ctx context.Context, userID int) ([]int, error) {
+	groupIDs, all, err := s.groupService.VisibleGroupIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if all {
+		return nil, nil
+	}
+	if groupIDs == nil {
+		groupIDs = []int{}
+	}
+	return groupIDs, nil
+}
+
+// ensureProjectVisible hides projects of groups the user may not see
+func (s *ProjectService) ensureProjectVisible(ctx context.Context, project *entities.Project, userID int) error {
+	if project.GroupID == nil {
+		return nil
+	}
+	if err := s.groupService.EnsureVisible(ctx, project.GroupID, userID); err != nil {
+		if apperrors.IsKind(err, apperrors.KindValidation) {
+			return apperrors.NotFound("project with ID %d not found", project.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+// projectSpecification builds the filter of the project list; empty filters are left out
+func projectSpecification(filter *dtos.ProjectFilterDto) specifications.Specification {
+	var specs []specifications.Specification
+
+	if filter.GroupID != 0 {
+		specs = append(specs, specifications.Equals("groupId", filter.GroupID))
+	}
+	if filter.BildirimNo != "" {
+		specs = append(specs, specifications.Contains("bildirimNo", filter.BildirimNo))
+	}
+	if filter.ProjectCode != "" {
+		specs = append(specs, specifications.Contains("projectCode", filter.ProjectCode))
+	}
+	if filter.ProjectName != "" {
+		specs = append(specs, specifications.Contains("projectName", filter.ProjectName))
+	}
+	if filter.ProjectMuellef != "" {
+		specs = append(specs, specifications.Contains("projectMuellef", filter.ProjectMuellef))
+	}
+	if len(filter.IdList) > 0 {
+		specs = append(specs, specifications.In("id", filter.IdList...))
+	}
+
+	return specifications.And(specs...)
+}
+
+// Search ranks projects by how well their fields and the OCR text of their documents match the query
+func (s *ProjectService) Search(ctx context.Context, request *dtos.ProjectSearchRequestDto, userID int) (*dtos.PagedResultDto[dtos.ProjectSearchResultDto], error) {
+	query := strings.TrimSpace(request.Query)
+	if query == "" {
+		return nil, apperrors.Validation("search query is empty")
+	}
+
+	groupIDs, err := s.visibleGroupIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	hits, totalCount, err := s.projectRepo.Search(ctx, multitenancy.TenantIDFromContext(ctx), query, groupIDs, request.PageNumber, request.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search projects: %w", err)
+	}
+
+	items := make([]dtos.ProjectSearchResultDto, len(hits))
+	for i, hit := range hits {
+		items[i] = dtos.ProjectSearchResultDto{
+			ID:          hit.ID,
+			ProjectCode: hit.ProjectCode,
+			ProjectName: hit.ProjectName,
+			GroupID:     hit.GroupID,
+			Rank:        hit.Rank,
+			Highlights:  hit.Highlights,
+		}
+		if hit.DocumentID != nil {
+			items[i].OcrMatch = &dtos.OcrTextMatchDto{
+				DocumentID: *hit.DocumentID,
+				PageNumber: *hit.PageNumber,
+				Snippet:    *hit.PageSnippet,
+			}
+		}
+	}
+
+	return &dtos.PagedResultDto[dtos.ProjectSearchResultDto]{
+		TotalCount: int(totalCount),
+		Items:      items,
+	}, nil
+}
+
+func (s *ProjectService) GetByID(ctx context.Context, id int, userID int) (*dtos.ProjectDto, error) {
 	project, err := s.projectRepo.GetByIDIncludingOcrProjects(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
+	if err := s.ensureProjectVisible(ctx, project, userID); err != nil {
+		return nil, err
+	}
 
 	dto := s.mapToDto(project)
 	return &dto, nil
 }
 
-func (s *ProjectService) Create(ctx context.Context, input *dtos.CreateProjectDto) (*dtos.ProjectDto, error) {
+func (s *ProjectService) Create(ctx context.Context, input *dtos.CreateProjectDto, userID int) (*dtos.ProjectDto, error) {
+	if err := s.groupService.EnsureVisible(ctx, input.GroupID, userID); err != nil {
+		return nil, err
+	}
+
 	project := &entities.Project{
 		ProjectName:          input.ProjectName,
 		ProjectCode:          input.ProjectCode,
@@ -93,29 +270,65 @@ func (s *ProjectService) Create(ctx context.Context, input *dtos.CreateProjectDt
 		GroupID:              input.GroupID,
 		BildirimNo:           input.BildirimNo,
 	}
+	project.TenantID = multitenancy.TenantIDFromContext(ctx)
+	project.CreatorUserID = &userID
 
-	// TODO: Set CreatorUserID from context/JWT
-
-	if err := s.projectRepo.CreateWithOcrProjects(ctx, project); err != nil {
-		return nil, fmt.Errorf("failed to create project: %w", err)
+	template, err := resolveOcrProjectTemplate(ctx, s.templateRepo, project.TenantID, project.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve OCR project template: %w", err)
 	}
 
-	// Fetch the created project with OCR projects
-	createdProject, err := s.projectRepo.GetByIDIncludingOcrProjects(ctx, project.ID)
+	var createdProject *entities.Project
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.projectRepo.CreateWithOcrProjects(ctx, project, template); err != nil {
+			return fmt.Errorf("failed to create project: %w", err)
+		}
+
+		// Fetch the created project with OCR projects
+		var err error
+		createdProject, err = s.projectRepo.GetByIDIncludingOcrProjects(ctx, project.ID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch created project: %w", err)
+		}
+
+		ocrProjectIDs := make([]int, len(createdProject.OcrProjects))
+		for i := range createdProject.OcrProjects {
+			ocrProjectIDs[i] = createdProject.OcrProjects[i].ID
+		}
+		return s.eventBus.Raise(ctx, events.ProjectCreated{
+			ProjectID:     createdProject.ID,
+			TenantID:      createdProject.TenantID,
+			ProjectCode:   createdProject.ProjectCode,
+			ProjectName:   createdProject.ProjectName,
+			GroupID:       createdProject.GroupID,
+			OcrProjectIDs: ocrProjectIDs,
+			UserID:        userID,
+		})
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch created project: %w", err)
+		return nil, err
 	}
 
 	dto := s.mapToDto(createdProject)
 	return &dto, nil
 }
 
-// Update updates an existing project
-func (s *ProjectService) Update(ctx context.Context, id int, input *dtos.UpdateProjectDto) (*dtos.ProjectDto, error) {
+// Update updates an existing project. With an expected version the update fails with a
+// concurrency conflict unless the project is still at that version.
+func (s *ProjectService) Update(ctx context.Context, id int, input *dtos.UpdateProjectDto, userID int, expectedVersion *int) (*dtos.ProjectDto, error) {
 	project, err := s.projectRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
+	if err := s.ensureProjectVisible(ctx, project, userID); err != nil {
+		return nil, err
+	}
+	if err := ensureVersion("project", project.ID, project.Version, expectedVersion); err != nil {
+		return nil, err
+	}
+	if err := s.groupService.EnsureVisible(ctx, input.GroupID, userID); err != nil {
+		return nil, err
+	}
 
 	// Update fields
 	project.ProjectName = input.ProjectName
@@ -134,25 +347,275 @@ func (s *ProjectService) Update(ctx context.Context, id int, input *dtos.UpdateP
 	project.Adress = input.Adress
 	project.GroupID = input.GroupID
 	project.BildirimNo = input.BildirimNo
+	project.LastModifierID = &userID
+
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.projectRepo.Update(ctx, project); err != nil {
+			return fmt.Errorf("failed to update project: %w", err)
+		}
+		return s.eventBus.Raise(ctx, events.ProjectUpdated{
+			ProjectID:   project.ID,
+			TenantID:    project.TenantID,
+			ProjectCode: project.ProjectCode,
+			ProjectName: project.ProjectName,
+			GroupID:     project.GroupID,
+			UserID:      userID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO: Set LastModifierID from context/JWT
+	dto := s.mapToDto(project)
+	return &dto, nil
+}
 
-	if err := s.projectRepo.Update(ctx, project); err != nil {
-		return nil, fmt.Errorf("failed to update project: %w", err)
+// Patch partially updates a project. apply receives the project's current values and changes the
+// ones the client sent; only the columns whose values differ are written.
+func (s *ProjectService) Patch(ctx context.Context, id int, apply func(input *dtos.UpdateProjectDto) error, userID int, expectedVersion *int) (*dtos.ProjectDto, error) {
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if err := s.ensureProjectVisible(ctx, project, userID); err != nil {
+		return nil, err
+	}
+	if err := ensureVersion("project", project.ID, project.Version, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	input := dtos.UpdateProjectDto{CreateProjectDto: dtos.CreateProjectDto{
+		ProjectName:          project.ProjectName,
+		ProjectCode:          project.ProjectCode,
+		ProjectComment:       project.ProjectComment,
+		ProjectMuellef:       project.ProjectMuellef,
+		Ada:                  project.Ada,
+		Parsel:               project.Parsel,
+		TalepGucu:            project.TalepGucu,
+		KuruluGuc:            project.KuruluGuc,
+		BagimsizBS:           project.BagimsizBS,
+		BlokS:                project.BlokS,
+		YapiYuksekligi:       project.YapiYuksekligi,
+		RuhsatGecerlilikDate: project.RuhsatGecerlilikDate,
+		YapiSahibi:           project.YapiSahibi,
+		Adress:               project.Adress,
+		GroupID:              project.GroupID,
+		BildirimNo:           project.BildirimNo,
+	}}
+	if err := apply(&input); err != nil {
+		return nil, err
+	}
+
+	var columns []string
+	patchField(&columns, "project_name", &project.ProjectName, input.ProjectName)
+	patchField(&columns, "project_code", &project.ProjectCode, input.ProjectCode)
+	patchField(&columns, "project_comment", &project.ProjectComment, input.ProjectComment)
+	patchField(&columns, "project_muellef", &project.ProjectMuellef, input.ProjectMuellef)
+	patchField(&columns, "ada", &project.Ada, input.Ada)
+	patchField(&columns, "parsel", &project.Parsel, input.Parsel)
+	patchField(&columns, "talep_gucu", &project.TalepGucu, input.TalepGucu)
+	patchField(&columns, "kurulu_guc", &project.KuruluGuc, input.KuruluGuc)
+	patchField(&columns, "bagimsiz_bs", &project.BagimsizBS, input.BagimsizBS)
+	patchField(&columns, "blok_s", &project.BlokS, input.BlokS)
+	patchField(&columns, "yapi_yuksekligi", &project.YapiYuksekligi, input.YapiYuksekligi)
+	patchField(&columns, "ruhsat_gecerlilik_date", &project.RuhsatGecerlilikDate, input.RuhsatGecerlilikDate)
+	patchField(&columns, "yapi_sahibi", &project.YapiSahibi, input.YapiSahibi)
+	patchField(&columns, "adress", &project.Adress, input.Adress)
+	patchField(&columns, "bildirim_no", &project.BildirimNo, input.BildirimNo)
+	if patchField(&columns, "group_id", &project.GroupID, input.GroupID) {
+		if err := s.groupService.EnsureVisible(ctx, project.GroupID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(columns) > 0 {
+		project.LastModifierID = &userID
+		columns = append(columns, "last_modifier_id")
+
+		err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+			if err := s.projectRepo.UpdateColumns(ctx, project, columns...); err != nil {
+				return fmt.Errorf("failed to update project: %w", err)
+			}
+			return s.eventBus.Raise(ctx, events.ProjectUpdated{
+				ProjectID:   project.ID,
+				TenantID:    project.TenantID,
+				ProjectCode: project.ProjectCode,
+				ProjectName: project.ProjectName,
+				GroupID:     project.GroupID,
+				UserID:      userID,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	dto := s.mapToDto(project)
 	return &dto, nil
 }
 
-// Delete deletes a project (soft delete)
-func (s *ProjectService) Delete(ctx context.Context, id int, userID int) error {
-	if err := s.projectRepo.SoftDelete(ctx, id, userID); err != nil {
+// Delete deletes a project (soft delete), optionally only if it is still at the expected version
+func (s *ProjectService) Delete(ctx context.Context, id int, userID int, expectedVersion *int) error {
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
 		return fmt.Errorf("failed to delete project: %w", err)
 	}
+	if err := s.ensureProjectVisible(ctx, project, userID); err != nil {
+		return err
+	}
+	if err := ensureVersion("project", project.ID, project.Version, expectedVersion); err != nil {
+		return err
+	}
+
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.projectRepo.SoftDelete(ctx, id, userID); err != nil {
+			return fmt.Errorf("failed to delete project: %w", err)
+		}
+		return s.eventBus.Raise(ctx, events.ProjectSoftDeleted{
+			ProjectID:   project.ID,
+			TenantID:    project.TenantID,
+			ProjectCode: project.ProjectCode,
+			UserID:      userID,
+		})
+	})
+}
+
+// Restore takes a soft-deleted project, with the OCR projects and documents deleted along with it, back
+// out of the recycle bin, optionally only if it is still at the expected version
+func (s *ProjectService) Restore(ctx context.Context, id int, userID int, expectedVersion *int) (*dtos.ProjectDto, error) {
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if err := s.ensureProjectVisible(ctx, project, userID); err != nil {
+		return nil, err
+	}
+	if !project.IsDeleted {
+		return nil, apperrors.Conflict("project %d is not deleted", id)
+	}
+	if err := ensureVersion("project", project.ID, project.Version, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	project.LastModifierID = &userID
+
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.projectRepo.Restore(ctx, project); err != nil {
+			return err
+		}
+		return s.eventBus.Raise(ctx, events.ProjectRestored{
+			ProjectID:   project.ID,
+			TenantID:    project.TenantID,
+			ProjectCode: project.ProjectCode,
+			UserID:      userID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dto := s.mapToDto(project)
+	return &dto, nil
+}
+
+// AddOcrProjectSlot adds a document slot to an existing project, named by the project's template
+func (s *ProjectService) AddOcrProjectSlot(ctx context.Context, projectID int, input *dtos.AddOcrProjectSlotDto, userID int) (*dtos.OcrProjectDto, error) {
+	ocrProjectType := entities.OcrProjectType(input.Type)
+	if !ocrProjectType.IsValid() {
+		return nil, apperrors.Validation("unknown OCR project type %d", input.Type)
+	}
+
+	project, err := s.projectRepo.GetByIDIncludingOcrProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if project.IsDeleted {
+		return nil, apperrors.NotFound("project with ID %d not found", projectID)
+	}
+	if err := s.ensureProjectVisible(ctx, project, userID); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.ocrProjectRepo.ExistsActiveOfType(ctx, projectID, ocrProjectType)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, apperrors.Conflict("project already has a %s document", ocrProjectType)
+	}
+
+	template, err := resolveOcrProjectTemplate(ctx, s.templateRepo, project.TenantID, project.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve OCR project template: %w", err)
+	}
+
+	slotCount, err := s.ocrProjectRepo.CountByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := entities.OcrProjectTemplateItem{Type: ocrProjectType, IsOptional: input.IsOptional}
+	ocrProject := template.NewOcrProject(project, item, int(slotCount)+1)
+	ocrProject.CreatorUserID = &userID
+	if err := s.ocrProjectRepo.Insert(ctx, &ocrProject); err != nil {
+		return nil, fmt.Errorf("failed to add OCR project: %w", err)
+	}
+
+	dto := mapOcrProjectToDto(&ocrProject)
+	return &dto, nil
+}
+
+// RemoveOcrProjectSlot soft deletes a document slot of a project, optionally only if the slot is
+// still at the expected version
+func (s *ProjectService) RemoveOcrProjectSlot(ctx context.Context, projectID int, ocrProjectID int, userID int, expectedVersion *int) error {
+	ocrProject, err := s.ocrProjectRepo.GetByIDIncludingFieldResults(ctx, ocrProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get OCR project: %w", err)
+	}
+	if ocrProject.ProjectID != projectID || ocrProject.IsDeleted {
+		return apperrors.NotFound("OCR project with ID %d not found in project %d", ocrProjectID, projectID)
+	}
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	if err := s.ensureProjectVisible(ctx, project, userID); err != nil {
+		return err
+	}
+
+	if err := ensureVersion("OCR project", ocrProject.ID, ocrProject.Version, expectedVersion); err != nil {
+		return err
+	}
+	if ocrProject.Status == entities.OcrProjectStatusProcessing {
+		return apperrors.Conflict("cannot remove an OCR project while it is being processed")
+	}
+
+	if err := s.ocrProjectRepo.SoftDelete(ctx, ocrProjectID, userID); err != nil {
+		return fmt.Errorf("failed to remove OCR project: %w", err)
+	}
 	return nil
 }
 
+// ensureVersion fails with a concurrency conflict when the client changes a resource based on a
+// version other than the current one. Without an expected version any version is accepted.
+func ensureVersion(resource string, id int, current int, expected *int) error {
+	if expected == nil || *expected == current {
+		return nil
+	}
+	return apperrors.ConcurrencyConflict("%s %d is at version %d, not %d; reload it and try again", resource, id, current, *expected).
+		WithDetails(map[string]int{"currentVersion": current})
+}
+
+// patchField sets a field to its patched value and records the column when the value changes
+func patchField[T any](columns *[]string, column string, field *T, value T) bool {
+	if reflect.DeepEqual(*field, value) {
+		return false
+	}
+	*field = value
+	*columns = append(*columns, column)
+	return true
+}
+
 // mapToDto converts a project entity to DTO
 func (s *ProjectService) mapToDto(project *entities.Project) dtos.ProjectDto {
 	dto := dtos.ProjectDto{
@@ -169,6 +632,7 @@ func (s *ProjectService) mapToDto(project *entities.Project) dtos.ProjectDto {
 			DeleterUserID: project.DeleterUserID,
 			DeletionTime:  project.DeletionTime,
 			IsDeleted:     project.IsDeleted,
+			Version:       project.Version,
 		},
 		ProjectName:          project.ProjectName,
 		ProjectCode:          project.ProjectCode,
@@ -191,41 +655,8 @@ func (s *ProjectService) mapToDto(project *entities.Project) dtos.ProjectDto {
 	// Map OCR projects if loaded
 	if project.OcrProjects != nil {
 		dto.OcrProjects = make([]dtos.OcrProjectDto, len(project.OcrProjects))
-		for i, ocrProj := range project.OcrProjects {
-			dto.OcrProjects[i] = dtos.OcrProjectDto{
-				FullAuditedEntityDto: dtos.FullAuditedEntityDto{
-					AuditedEntityDto: dtos.AuditedEntityDto{
-						EntityDto: dtos.EntityDto{
-							ID: ocrProj.ID,
-						},
-						CreatedAt:      ocrProj.CreatedAt,
-						UpdatedAt:      ocrProj.UpdatedAt,
-						CreatorUserID:  ocrProj.CreatorUserID,
-						LastModifierID: ocrProj.LastModifierID,
-					},
-					DeleterUserID: ocrProj.DeleterUserID,
-					DeletionTime:  ocrProj.DeletionTime,
-					IsDeleted:     ocrProj.IsDeleted,
-				},
-				ProjectName:          ocrProj.ProjectName,
-				ProjectCode:          ocrProj.ProjectCode,
-				ProjectComment:       ocrProj.ProjectComment,
-				ProjectMuellef:       ocrProj.ProjectMuellef,
-				Ada:                  ocrProj.Ada,
-				Parsel:               ocrProj.Parsel,
-				TalepGucu:            ocrProj.TalepGucu,
-				KuruluGuc:            ocrProj.KuruluGuc,
-				BagimsizBS:           ocrProj.BagimsizBS,
-				BlokS:                ocrProj.BlokS,
-				YapiYuksekligi:       ocrProj.YapiYuksekligi,
-				RuhsatGecerlilikDate: ocrProj.RuhsatGecerlilikDate,
-				YapiSahibi:           ocrProj.YapiSahibi,
-				Adress:               ocrProj.Adress,
-				Type:                 int(ocrProj.Type),
-				TypeName:             ocrProj.Type.String(),
-				ProjectID:            ocrProj.ProjectID,
-				PdfPath:              ocrProj.PdfPath,
-			}
+		for i := range project.OcrProjects {
+			dto.OcrProjects[i] = mapOcrProjectToDto(&project.OcrProjects[i])
 		}
 	}
 
@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/events"
+	"hatika-go/internal/infrastructure/eventbus"
+	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+)
+
+// ReconciledFieldNames are the fields shared by a project and its OCR documents
+var ReconciledFieldNames = []string{
+	entities.FieldAda,
+	entities.FieldParsel,
+	entities.FieldYapiSahibi,
+	entities.FieldAdress,
+	entities.FieldKuruluGuc,
+}
+
+// ReconciliationService compares the values of a project's approved OCR documents with each other
+// and with the project. Extractions still pending, processing, in review or rejected are left out, so
+// only reviewed values can reach the project.
+type ReconciliationService struct {
+	projectRepo    *persistence.ProjectRepository
+	projectService *ProjectService
+	unitOfWork     *persistence.UnitOfWork
+	eventBus       *eventbus.Bus
+}
+
+// NewReconciliationService creates a new reconciliation service
+func NewReconciliationService(
+	projectRepo *persistence.ProjectRepository,
+	projectService *ProjectService,
+	unitOfWork *persistence.UnitOfWork,
+	eventBus *eventbus.Bus,
+) *ReconciliationService {
+	return &ReconciliationService{
+		projectRepo:    projectRepo,
+		projectService: projectService,
+		unitOfWork:     unitOfWork,
+		eventBus:       eventBus,
+	}
+}
+
+// GetReport builds the discrepancy report of a live project the user may see
+func (s *ReconciliationService) GetReport(ctx context.Context, projectID int, userID int) (*dtos.ReconciliationReportDto, error) {
+	project, err := s.projectRepo.GetByIDIncludingOcrProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if project.IsDeleted {
+		return nil, apperrors.NotFound("project with ID %d not found", projectID)
+	}
+	if err := s.projectService.ensureProjectVisible(ctx, project, userID); err != nil {
+		return nil, err
+	}
+
+	return s.buildReport(project)
+}
+
+// Apply copies values the approved documents agree on onto the project in one transaction. Without
+// field names every agreed field is applied; naming a field the documents disagree on fails.
+// ProjectUpdated is raised when a value changed.
+func (s *ReconciliationService) Apply(ctx context.Context, projectID int, input *dtos.ApplyReconciliationDto, userID int) (*dtos.ReconciliationReportDto, error) {
+	for _, fieldName := range input.FieldNames {
+		if !isReconciledField(fieldName) {
+			return nil, apperrors.Validation("field %q is not reconciled across documents", fieldName)
+		}
+	}
+
+	var project *entities.Project
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		changed := false
+		var err error
+		project, err = s.projectRepo.UpdateWithLock(ctx, projectID, func(project *entities.Project) error {
+			if err := s.projectService.ensureProjectVisible(ctx, project, userID); err != nil {
+				return err
+			}
+			report, err := s.buildReport(project)
+			if err != nil {
+				return err
+			}
+
+			for _, field := range report.Fields {
+				if len(input.FieldNames) > 0 && !containsString(input.FieldNames, field.FieldName) {
+					continue
+				}
+				if field.AgreedValue == nil {
+					if len(input.FieldNames) > 0 {
+						return apperrors.Conflict("documents do not agree on field %q", field.FieldName)
+					}
+					continue
+				}
+				if field.ProjectValue == *field.AgreedValue {
+					continue
+				}
+				if err := project.SetOcrField(field.FieldName, *field.AgreedValue); err != nil {
+					return apperrors.Validation("%v", err)
+				}
+				changed = true
+			}
+
+			if changed {
+				project.LastModifierID = &userID
+			}
+			return nil
+		})
+		if err != nil || !changed {
+			return err
+		}
+
+		return s.eventBus.Raise(ctx, events.ProjectUpdated{
+			ProjectID:   project.ID,
+			TenantID:    project.TenantID,
+			ProjectCode: project.ProjectCode,
+			ProjectName: project.ProjectName,
+			GroupID:     project.GroupID,
+			UserID:      userID,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply reconciliation: %w", err)
+	}
+
+	return s.buildReport(project)
+}
+
+func (s *ReconciliationService) buildReport(project *entities.Project) (*dtos.ReconciliationReportDto, error) {
+	report := &dtos.ReconciliationReportDto{
+		ProjectID: project.ID,
+		Fields:    make([]dtos.FieldReconciliationDto, 0, len(ReconciledFieldNames)),
+	}
+
+	for _, fieldName := range ReconciledFieldNames {
+		projectValue, err := project.GetOcrField(fieldName)
+		if err != nil {
+			return nil, err
+		}
+
+		field := dtos.FieldReconciliationDto{
+			FieldName:      fieldName,
+			ProjectValue:   projectValue,
+			DocumentValues: []dtos.DocumentFieldValueDto{},
+		}
+
+		for i := range project.OcrProjects {
+			ocrProject := &project.OcrProjects[i]
+			if ocrProject.IsDeleted || ocrProject.Status != entities.OcrProjectStatusApproved {
+				continue
+			}
+
+			value, err := ocrProject.GetOcrField(fieldName)
+			if err != nil {
+				return nil, err
+			}
+			if value == "" {
+				continue
+			}
+
+			field.DocumentValues = append(field.DocumentValues, dtos.DocumentFieldValueDto{
+				OcrProjectID: ocrProject.ID,
+				Type:         int(ocrProject.Type),
+				TypeName:     ocrProject.Type.String(),
+				StatusName:   ocrProject.Status.String(),
+				Value:        value,
+			})
+		}
+
+		reconcileField(&field)
+		report.HasDiscrepancies = report.HasDiscrepancies || !field.Consistent
+		report.Fields = append(report.Fields, field)
+	}
+
+	return report, nil
+}
+
+// reconcileField finds the value all documents agree on and describes every mismatch
+func reconcileField(field *dtos.FieldReconciliationDto) {
+	field.Consistent = true
+
+	for i := 0; i < len(field.DocumentValues); i++ {
+		for j := i + 1; j < len(field.DocumentValues); j++ {
+			a, b := field.DocumentValues[i], field.DocumentValues[j]
+			if normalizeFieldValue(a.Value) != normalizeFieldValue(b.Value) {
+				field.Consistent = false
+				field.Discrepancies = append(field.Discrepancies, fmt.Sprintf(
+					"%s has %q but %s has %q", a.TypeName, a.Value, b.TypeName, b.Value,
+				))
+			}
+		}
+	}
+
+	if len(field.DocumentValues) == 0 || !field.Consistent {
+		return
+	}
+
+	agreed := field.DocumentValues[0].Value
+	field.AgreedValue = &agreed
+
+	if normalizeFieldValue(field.ProjectValue) != normalizeFieldValue(agreed) {
+		field.Consistent = false
+		field.Discrepancies = append(field.Discrepancies, fmt.Sprintf(
+			"project has %q but documents have %q", field.ProjectValue, agreed,
+		))
+	}
+}
+
+// normalizeFieldValue ignores case (with Turkish casing rules) and repeated whitespace
+func normalizeFieldValue(value string) string {
+	return strings.ToUpperSpecial(unicode.TurkishCase, strings.Join(strings.Fields(value), " "))
+}
+
+func isReconciledField(fieldName string) bool {
+	return containsString(ReconciledFieldNames, fieldName)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
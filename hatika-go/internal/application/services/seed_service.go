@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/valueobjects"
+	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
+)
+
+// Seed sets, in the order they run
+const (
+	SeedSetRoles       = "roles"
+	SeedSetPermissions = "permissions"
+	SeedSetDemo        = "demo"
+)
+
+// SeedSets lists every seed set in the order they run, since later sets rely on earlier ones
+var SeedSets = []string{SeedSetRoles, SeedSetPermissions, SeedSetDemo}
+
+// DefaultSeedSets is what a fresh installation needs
+var DefaultSeedSets = []string{SeedSetRoles, SeedSetPermissions}
+
+// demoGroupName names the project group holding the demo projects
+const demoGroupName = "Demo"
+
+// seedUserID stands in for the caller of seeded writes; groups without permissions are visible to it
+const seedUserID = 0
+
+var demoProjects = []struct {
+	code, name, owner, address string
+	ada, parsel                int
+}{
+	{"DEMO-001", "Demo Konut Projesi", "Ayşe Yılmaz", "Çankaya, Ankara", 1021, 3},
+	{"DEMO-002", "Demo Ticari Bina", "Mehmet Demir", "Kadıköy, İstanbul", 874, 12},
+	{"DEMO-003", "Demo Depo", "Deniz İnşaat", "Bornova, İzmir", 455, 7},
+}
+
+// SeedService fills a database with the data the application expects. Every set is idempotent, so
+// seeding again only adds what is missing.
+type SeedService struct {
+	roleRepo            *persistence.RoleRepository
+	tenantRepo          *persistence.TenantRepository
+	projectRepo         *persistence.ProjectRepository
+	permissionService   *PermissionService
+	projectService      *ProjectService
+	projectGroupService *ProjectGroupService
+}
+
+// NewSeedService creates a new seed service
+func NewSeedService(
+	roleRepo *persistence.RoleRepository,
+	tenantRepo *persistence.TenantRepository,
+	projectRepo *persistence.ProjectRepository,
+	permissionService *PermissionService,
+	projectService *ProjectService,
+	projectGroupService *ProjectGroupService,
+) *SeedService {
+	return &SeedService{
+		roleRepo:            roleRepo,
+		tenantRepo:          tenantRepo,
+		projectRepo:         projectRepo,
+		permissionService:   permissionService,
+		projectService:      projectService,
+		projectGroupService: projectGroupService,
+	}
+}
+
+// Seed runs the given sets in their canonical order. Demo data goes to the tenant named by
+// tenancyName, or to the host when it is empty.
+func (s *SeedService) Seed(ctx context.Context, sets []string, tenancyName string) error {
+	selected := make(map[string]bool, len(sets))
+	for _, set := range sets {
+		set = strings.ToLower(strings.TrimSpace(set))
+		if !isSeedSet(set) {
+			return apperrors.Validation("unknown seed set %q, expected one of %s", set, strings.Join(SeedSets, ", "))
+		}
+		selected[set] = true
+	}
+
+	for _, set := range SeedSets {
+		if !selected[set] {
+			continue
+		}
+		var err error
+		switch set {
+		case SeedSetRoles:
+			err = s.seedRoles(ctx)
+		case SeedSetPermissions:
+			err = s.seedPermissions(ctx)
+		case SeedSetDemo:
+			err = s.seedDemo(ctx, tenancyName)
+		}
+		if err != nil {
+			return fmt.Errorf("seed set %s failed: %w", set, err)
+		}
+	}
+	return nil
+}
+
+func (s *SeedService) seedRoles(ctx context.Context) error {
+	created := 0
+	for _, staticRole := range entities.StaticRoles {
+		_, err := s.roleRepo.GetByName(ctx, staticRole.Name)
+		if err == nil {
+			continue
+		}
+		if !apperrors.IsKind(err, apperrors.KindNotFound) {
+			return err
+		}
+
+		role := staticRole
+		if err := s.roleRepo.Insert(ctx, &role); err != nil {
+			return fmt.Errorf("failed to create role %s: %w", role.Name, err)
+		}
+		created++
+	}
+	log.Printf("Seeded roles: %d created, %d already present", created, len(entities.StaticRoles)-created)
+	return nil
+}
+
+func (s *SeedService) seedPermissions(ctx context.Context) error {
+	result, err := s.permissionService.Sync(ctx, false)
+	if err != nil {
+		return err
+	}
+	log.Printf("Seeded permissions: %d created, %d updated, %d granted to %s",
+		len(result.Created), len(result.Updated), len(result.Granted), entities.AdminRoleName)
+	if len(result.Stale) > 0 {
+		log.Printf("Warning: %d permissions are no longer defined: %s; run `permissions sync --prune` to delete them",
+			len(result.Stale), strings.Join(result.Stale, ", "))
+	}
+	return nil
+}
+
+// seedDemo adds a demo project group and a few projects to it. Project codes are unique over the
+// whole table, so a demo project already seeded into another tenant is skipped.
+func (s *SeedService) seedDemo(ctx context.Context, tenancyName string) error {
+	if tenancyName != "" {
+		tenant, err := s.tenantRepo.GetByTenancyName(ctx, tenancyName)
+		if err != nil {
+			return err
+		}
+		ctx = multitenancy.WithTenantID(ctx, &tenant.ID)
+	}
+
+	groupID, err := s.demoGroup(ctx)
+	if err != nil {
+		return err
+	}
+
+	codes := make([]string, len(demoProjects))
+	for i, demo := range demoProjects {
+		codes[i] = demo.code
+	}
+	existing, err := s.projectRepo.GetByProjectCodes(ctx, codes)
+	if err != nil {
+		return err
+	}
+	taken := make(map[string]bool, len(existing))
+	for _, project := range existing {
+		taken[project.ProjectCode] = true
+	}
+
+	created := 0
+	for _, demo := range demoProjects {
+		if taken[demo.code] {
+			continue
+		}
+		ada, parsel := valueobjects.Ada(demo.ada), valueobjects.Parsel(demo.parsel)
+		input := &dtos.CreateProjectDto{
+			ProjectName: demo.name,
+			ProjectCode: demo.code,
+			YapiSahibi:  demo.owner,
+			Adress:      demo.address,
+			Ada:         &ada,
+			Parsel:      &parsel,
+			GroupID:     &groupID,
+		}
+		if _, err := s.projectService.Create(ctx, input, seedUserID); err != nil {
+			return fmt.Errorf("failed to create demo project %s: %w", demo.code, err)
+		}
+		created++
+	}
+	log.Printf("Seeded demo data: %d projects created, %d already present", created, len(demoProjects)-created)
+	return nil
+}
+
+// demoGroup returns the top-level demo group of the current tenant, creating it when missing
+func (s *SeedService) demoGroup(ctx context.Context) (int, error) {
+	groups, err := s.projectGroupService.GetAll(ctx, seedUserID)
+	if err != nil {
+		return 0, err
+	}
+	for _, group := range groups {
+		if group.ParentID == nil && group.Name == demoGroupName {
+			return group.ID, nil
+		}
+	}
+
+	group, err := s.projectGroupService.Create(ctx, &dtos.CreateProjectGroupDto{
+		Name:        demoGroupName,
+		Description: "Sample projects added by the demo seed",
+	}, seedUserID)
+	if err != nil {
+		return 0, err
+	}
+	return group.ID, nil
+}
+
+func isSeedSet(set string) bool {
+	for _, known := range SeedSets {
+		if set == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+)
+
+// TenantService manages tenants
+type TenantService struct {
+	tenantRepo *persistence.TenantRepository
+}
+
+// NewTenantService creates a new tenant service
+func NewTenantService(tenantRepo *persistence.TenantRepository) *TenantService {
+	return &TenantService{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// Create adds an active tenant; tenancy names are unique, deleted tenants included
+func (s *TenantService) Create(ctx context.Context, input *dtos.CreateTenantDto) (*dtos.TenantDto, error) {
+	exists, err := s.tenantRepo.ExistsByTenancyName(ctx, input.TenancyName)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, apperrors.Conflict("a tenant named %q already exists", input.TenancyName)
+	}
+
+	tenant := &entities.Tenant{
+		TenancyName: input.TenancyName,
+		Name:        input.Name,
+		IsActive:    true,
+	}
+	if err := s.tenantRepo.Insert(ctx, tenant); err != nil {
+		return nil, fmt.Errorf("failed to create tenant: %w", err)
+	}
+
+	dto := mapTenantToDto(tenant)
+	return &dto, nil
+}
+
+// GetByTenancyName returns a tenant by its tenancy name
+func (s *TenantService) GetByTenancyName(ctx context.Context, tenancyName string) (*dtos.TenantDto, error) {
+	tenant, err := s.tenantRepo.GetByTenancyName(ctx, tenancyName)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := mapTenantToDto(tenant)
+	return &dto, nil
+}
+
+func mapTenantToDto(tenant *entities.Tenant) dtos.TenantDto {
+	return dtos.TenantDto{
+		FullAuditedEntityDto: dtos.FullAuditedEntityDto{
+			AuditedEntityDto: dtos.AuditedEntityDto{
+				EntityDto: dtos.EntityDto{
+					ID: tenant.ID,
+				},
+				CreatedAt:      tenant.CreatedAt,
+				UpdatedAt:      tenant.UpdatedAt,
+				CreatorUserID:  tenant.CreatorUserID,
+				LastModifierID: tenant.LastModifierID,
+			},
+			DeleterUserID: tenant.DeleterUserID,
+			DeletionTime:  tenant.DeletionTime,
+			IsDeleted:     tenant.IsDeleted,
+			Version:       tenant.Version,
+		},
+		TenancyName: tenant.TenancyName,
+		Name:        tenant.Name,
+		IsActive:    tenant.IsActive,
+	}
+}
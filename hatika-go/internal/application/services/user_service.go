@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/persistence"
+	apperrors "hatika-go/pkg/errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// UserService manages user accounts
+type UserService struct {
+	userRepo   *persistence.UserRepository
+	roleRepo   *persistence.RoleRepository
+	tenantRepo *persistence.TenantRepository
+}
+
+// NewUserService creates a new user service
+func NewUserService(
+	userRepo *persistence.UserRepository,
+	roleRepo *persistence.RoleRepository,
+	tenantRepo *persistence.TenantRepository,
+) *UserService {
+	return &UserService{
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		tenantRepo: tenantRepo,
+	}
+}
+
+// CreateAdmin creates an active user holding the Admin role, in the tenant named by the input or
+// on the host when no tenant is named
+func (s *UserService) CreateAdmin(ctx context.Context, input *dtos.CreateAdminUserDto) (*dtos.UserDto, error) {
+	var tenantID *int
+	if input.TenancyName != "" {
+		tenant, err := s.tenantRepo.GetByTenancyName(ctx, input.TenancyName)
+		if err != nil {
+			return nil, err
+		}
+		tenantID = &tenant.ID
+	}
+
+	usernameTaken, emailTaken, err := s.userRepo.FindTaken(ctx, input.Username, input.Email)
+	if err != nil {
+		return nil, err
+	}
+	if usernameTaken {
+		return nil, apperrors.Conflict("username %q is already taken", input.Username)
+	}
+	if emailTaken {
+		return nil, apperrors.Conflict("email %q is already taken", input.Email)
+	}
+
+	adminRole, err := s.roleRepo.GetByName(ctx, entities.AdminRoleName)
+	if err != nil {
+		if apperrors.IsKind(err, apperrors.KindNotFound) {
+			return nil, apperrors.NotFound("the %s role does not exist; seed the roles first", entities.AdminRoleName)
+		}
+		return nil, err
+	}
+
+	passwordHash, err := hashPassword(input.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &entities.User{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: tenantID},
+		Username:          input.Username,
+		Email:             input.Email,
+		PasswordHash:      passwordHash,
+		Name:              input.Name,
+		Surname:           input.Surname,
+		IsActive:          true,
+		EmailConfirmed:    true,
+	}
+	if err := s.userRepo.CreateWithRoles(ctx, user, []entities.Role{*adminRole}); err != nil {
+		return nil, err
+	}
+
+	dto := mapUserToDto(user)
+	return &dto, nil
+}
+
+// GetByUsername returns a user by their username
+func (s *UserService) GetByUsername(ctx context.Context, username string) (*dtos.UserDto, error) {
+	user, err := s.userRepo.GetByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := mapUserToDto(user)
+	return &dto, nil
+}
+
+// ResetPassword sets a new password for a user and unlocks the account
+func (s *UserService) ResetPassword(ctx context.Context, input *dtos.ResetPasswordDto) error {
+	passwordHash, err := hashPassword(input.NewPassword)
+	if err != nil {
+		return err
+	}
+	return s.userRepo.SetPassword(ctx, input.UserID, passwordHash)
+}
+
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+func mapUserToDto(user *entities.User) dtos.UserDto {
+	roles := make([]dtos.RoleDto, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roles = append(roles, dtos.RoleDto{
+			FullAuditedEntityDto: dtos.FullAuditedEntityDto{
+				AuditedEntityDto: dtos.AuditedEntityDto{
+					EntityDto: dtos.EntityDto{
+						ID: role.ID,
+					},
+				},
+			},
+			Name:        role.Name,
+			DisplayName: role.DisplayName,
+			IsStatic:    role.IsStatic,
+			IsDefault:   role.IsDefault,
+		})
+	}
+
+	return dtos.UserDto{
+		FullAuditedEntityDto: dtos.FullAuditedEntityDto{
+			AuditedEntityDto: dtos.AuditedEntityDto{
+				EntityDto: dtos.EntityDto{
+					ID: user.ID,
+				},
+				CreatedAt:      user.CreatedAt,
+				UpdatedAt:      user.UpdatedAt,
+				CreatorUserID:  user.CreatorUserID,
+				LastModifierID: user.LastModifierID,
+			},
+			DeleterUserID: user.DeleterUserID,
+			DeletionTime:  user.DeletionTime,
+			IsDeleted:     user.IsDeleted,
+			Version:       user.Version,
+		},
+		Username:       user.Username,
+		Email:          user.Email,
+		Name:           user.Name,
+		Surname:        user.Surname,
+		FullName:       user.FullName(),
+		IsActive:       user.IsActive,
+		EmailConfirmed: user.EmailConfirmed,
+		PhoneNumber:    user.PhoneNumber,
+		Roles:          roles,
+	}
+}
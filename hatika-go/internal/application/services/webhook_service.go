@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/events"
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/infrastructure/webhooks"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
+)
+
+// webhookSecretPrefix marks generated signing secrets, so they are recognizable in configuration
+const webhookSecretPrefix = "whsec_"
+
+// WebhookService manages the webhook subscriptions of the current tenant and their delivery logs
+type WebhookService struct {
+	subscriptionRepo *persistence.WebhookSubscriptionRepository
+	deliveryRepo     *persistence.WebhookDeliveryRepository
+	config           config.WebhooksConfig
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(
+	subscriptionRepo *persistence.WebhookSubscriptionRepository,
+	deliveryRepo *persistence.WebhookDeliveryRepository,
+	webhooksConfig config.WebhooksConfig,
+) *WebhookService {
+	return &WebhookService{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		config:           webhooksConfig,
+	}
+}
+
+// GetEventTypes lists the event types a webhook can subscribe to
+func (s *WebhookService) GetEventTypes() []string {
+	return append([]string{entities.WebhookAllEvents}, events.Names...)
+}
+
+// GetAll lists the subscriptions of the current tenant
+func (s *WebhookService) GetAll(ctx context.Context) ([]dtos.WebhookSubscriptionDto, error) {
+	subscriptions, err := s.subscriptionRepo.GetAllForTenant(ctx, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtos.WebhookSubscriptionDto, len(subscriptions))
+	for i := range subscriptions {
+		result[i] = mapWebhookSubscriptionToDto(&subscriptions[i])
+	}
+	return result, nil
+}
+
+func (s *WebhookService) GetByID(ctx context.Context, id int) (*dtos.WebhookSubscriptionDto, error) {
+	subscription, err := s.subscriptionRepo.GetByIDForTenant(ctx, id, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	dto := mapWebhookSubscriptionToDto(subscription)
+	return &dto, nil
+}
+
+// Create subscribes an endpoint to events of the current tenant. The response carries the signing
+// secret, which cannot be read again later.
+func (s *WebhookService) Create(ctx context.Context, input *dtos.CreateWebhookSubscriptionDto, userID int) (*dtos.WebhookSubscriptionCreatedDto, error) {
+	subscription := &entities.WebhookSubscription{
+		FullAuditedEntity: entities.FullAuditedEntity{CreatorUserID: &userID},
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: multitenancy.TenantIDFromContext(ctx)},
+		IsActive:          true,
+	}
+	if err := s.applyInput(ctx, subscription, input); err != nil {
+		return nil, err
+	}
+	if subscription.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		subscription.Secret = secret
+	}
+
+	if err := s.subscriptionRepo.Insert(ctx, subscription); err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	return &dtos.WebhookSubscriptionCreatedDto{
+		WebhookSubscriptionDto: mapWebhookSubscriptionToDto(subscription),
+		Secret:                 subscription.Secret,
+	}, nil
+}
+
+// Update changes the endpoint, events or secret of a subscription. Deliveries already queued are
+// sent to the new URL with the new secret.
+func (s *WebhookService) Update(ctx context.Context, id int, input *dtos.UpdateWebhookSubscriptionDto, userID int) (*dtos.WebhookSubscriptionDto, error) {
+	subscription, err := s.subscriptionRepo.GetByIDForTenant(ctx, id, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyInput(ctx, subscription, &input.CreateWebhookSubscriptionDto); err != nil {
+		return nil, err
+	}
+	subscription.LastModifierID = &userID
+
+	if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return nil, fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+
+	dto := mapWebhookSubscriptionToDto(subscription)
+	return &dto, nil
+}
+
+// Delete removes a subscription; its pending deliveries are dead-lettered when they come due
+func (s *WebhookService) Delete(ctx context.Context, id int, userID int) error {
+	subscription, err := s.subscriptionRepo.GetByIDForTenant(ctx, id, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	subscription.SoftDelete(userID)
+	if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// GetDeliveries pages through the delivery log of a subscription, newest first
+func (s *WebhookService) GetDeliveries(ctx context.Context, id int, request *dtos.WebhookDeliveryListRequestDto) (*dtos.PagedResultDto[dtos.WebhookDeliveryDto], error) {
+	if _, err := s.subscriptionRepo.GetByIDForTenant(ctx, id, multitenancy.TenantIDFromContext(ctx)); err != nil {
+		return nil, err
+	}
+
+	deliveries, totalCount, err := s.deliveryRepo.GetPageForSubscription(
+		ctx, id, entities.WebhookDeliveryStatus(request.Status), request.PageNumber, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dtos.WebhookDeliveryDto, len(deliveries))
+	for i := range deliveries {
+		items[i] = mapWebhookDeliveryToDto(&deliveries[i])
+	}
+
+	return &dtos.PagedResultDto[dtos.WebhookDeliveryDto]{
+		TotalCount: int(totalCount),
+		Items:      items,
+	}, nil
+}
+
+// Redeliver queues a delivery of the subscription to be sent again with a fresh set of attempts,
+// whatever its outcome so far
+func (s *WebhookService) Redeliver(ctx context.Context, id int, deliveryID int, userID int) (*dtos.WebhookDeliveryDto, error) {
+	subscription, err := s.subscriptionRepo.GetByIDForTenant(ctx, id, multitenancy.TenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if !subscription.IsActive {
+		return nil, apperrors.Conflict("webhook subscription %d is not active", id)
+	}
+
+	delivery, err := s.deliveryRepo.GetByIDForSubscription(ctx, deliveryID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Redeliver(userID)
+	if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to queue webhook redelivery: %w", err)
+	}
+
+	dto := mapWebhookDeliveryToDto(delivery)
+	return &dto, nil
+}
+
+// applyInput validates the input and copies it onto the subscription. The URL has to lead to the public
+// internet unless private networks are allowed; the delivery worker checks the address again when it connects.
+func (s *WebhookService) applyInput(ctx context.Context, subscription *entities.WebhookSubscription, input *dtos.CreateWebhookSubscriptionDto) error {
+	endpoint, err := url.Parse(strings.TrimSpace(input.URL))
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Hostname() == "" {
+		return apperrors.Validation("webhook URL must be an absolute http or https URL")
+	}
+	if !s.config.AllowPrivateNetworks {
+		if err := webhooks.CheckHost(ctx, endpoint.Hostname()); err != nil {
+			return apperrors.Validation("webhook URL must lead to a public address: %v", err)
+		}
+	}
+
+	eventTypes := make([]string, 0, len(input.EventTypes))
+	seen := make(map[string]bool, len(input.EventTypes))
+	for _, eventType := range input.EventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if eventType != entities.WebhookAllEvents && !events.IsKnown(eventType) {
+			return apperrors.Validation("unknown event type %q", eventType).
+				WithDetails(map[string][]string{"eventTypes": append([]string{entities.WebhookAllEvents}, events.Names...)})
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	subscription.URL = endpoint.String()
+	subscription.EventTypes = eventTypes
+	subscription.Description = input.Description
+	if input.IsActive != nil {
+		subscription.IsActive = *input.IsActive
+	}
+	if input.Secret != "" {
+		subscription.Secret = input.Secret
+	}
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return webhookSecretPrefix + hex.EncodeToString(b), nil
+}
+
+func mapWebhookSubscriptionToDto(subscription *entities.WebhookSubscription) dtos.WebhookSubscriptionDto {
+	return dtos.WebhookSubscriptionDto{
+		FullAuditedEntityDto: dtos.FullAuditedEntityDto{
+			AuditedEntityDto: dtos.AuditedEntityDto{
+				EntityDto: dtos.EntityDto{
+					ID: subscription.ID,
+				},
+				CreatedAt:      subscription.CreatedAt,
+				UpdatedAt:      subscription.UpdatedAt,
+				CreatorUserID:  subscription.CreatorUserID,
+				LastModifierID: subscription.LastModifierID,
+			},
+			DeleterUserID: subscription.DeleterUserID,
+			DeletionTime:  subscription.DeletionTime,
+			IsDeleted:     subscription.IsDeleted,
+			Version:       subscription.Version,
+		},
+		TenantID:    subscription.TenantID,
+		URL:         subscription.URL,
+		EventTypes:  subscription.EventTypes,
+		Description: subscription.Description,
+		IsActive:    subscription.IsActive,
+	}
+}
+
+func mapWebhookDeliveryToDto(delivery *entities.WebhookDelivery) dtos.WebhookDeliveryDto {
+	dto := dtos.WebhookDeliveryDto{
+		ID:                  delivery.ID,
+		CreatedAt:           delivery.CreatedAt,
+		SubscriptionID:      delivery.SubscriptionID,
+		EventID:             delivery.EventID,
+		EventName:           delivery.EventName,
+		Status:              string(delivery.Status),
+		Attempts:            delivery.Attempts,
+		LastAttemptAt:       delivery.LastAttemptAt,
+		ResponseStatusCode:  delivery.ResponseStatusCode,
+		ResponseBody:        delivery.ResponseBody,
+		DurationMs:          delivery.DurationMs,
+		LastError:           delivery.LastError,
+		DeliveredAt:         delivery.DeliveredAt,
+		RedeliveredByUserID: delivery.RedeliveredByUserID,
+		RedeliveredAt:       delivery.RedeliveredAt,
+		Body:                json.RawMessage(delivery.Body),
+	}
+	if delivery.Status == entities.WebhookDeliveryStatusPending {
+		nextAttemptAt := delivery.NextAttemptAt
+		dto.NextAttemptAt = &nextAttemptAt
+	}
+	return dto
+}
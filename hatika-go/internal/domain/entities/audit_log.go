@@ -0,0 +1,27 @@
+package entities
+
+// AuditAction names what an audit log entry records
+type AuditAction string
+
+const (
+	// AuditActionProjectPurged records that a soft-deleted project was permanently removed
+	AuditActionProjectPurged AuditAction = "ProjectPurged"
+)
+
+// AuditLog is an append-only record of an action that must stay traceable after the data it concerns
+// is gone. UserID is empty for actions of scheduled jobs.
+type AuditLog struct {
+	BaseEntity
+	MultiTenantEntity
+
+	Action     AuditAction `gorm:"size:50;not null;index" json:"action"`
+	EntityType string      `gorm:"size:50;not null;index:idx_audit_logs_entity,priority:1" json:"entityType"`
+	EntityID   int         `gorm:"not null;index:idx_audit_logs_entity,priority:2" json:"entityId"`
+	UserID     *int        `json:"userId,omitempty"`
+	Details    string      `gorm:"type:text" json:"details,omitempty"`
+}
+
+// TableName overrides the table name
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
@@ -17,6 +17,8 @@ type FullAuditedEntity struct {
 	DeleterUserID  *int       `gorm:"index" json:"deleterUserId,omitempty"`
 	DeletionTime   *time.Time `json:"deletionTime,omitempty"`
 	IsDeleted      bool       `gorm:"default:false;index" json:"isDeleted"`
+	// Version is incremented by every update; an update made from an older version fails
+	Version int `gorm:"not null;default:1" json:"version"`
 }
 
 func (e *FullAuditedEntity) SoftDelete(userID int) {
@@ -26,6 +28,13 @@ func (e *FullAuditedEntity) SoftDelete(userID int) {
 	e.DeleterUserID = &userID
 }
 
+// Restore takes a soft-deleted entity back out of the recycle bin
+func (e *FullAuditedEntity) Restore() {
+	e.IsDeleted = false
+	e.DeletionTime = nil
+	e.DeleterUserID = nil
+}
+
 type MultiTenantEntity struct {
 	TenantID *int `gorm:"index" json:"tenantId,omitempty"`
 }
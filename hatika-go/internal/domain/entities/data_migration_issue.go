@@ -0,0 +1,18 @@
+package entities
+
+// DataMigrationIssue records a stored value a data migration could not convert or found invalid.
+// The original text is kept so the row can be corrected by hand.
+type DataMigrationIssue struct {
+	BaseEntity
+
+	Migration   string `gorm:"size:100;not null;index" json:"migration"`
+	SourceTable string `gorm:"size:100;not null" json:"sourceTable"`
+	RowID       int    `gorm:"not null" json:"rowId"`
+	Column      string `gorm:"size:100;not null" json:"column"`
+	Value       string `gorm:"type:text" json:"value"`
+	Reason      string `gorm:"type:text" json:"reason"`
+}
+
+func (DataMigrationIssue) TableName() string {
+	return "data_migration_issues"
+}
@@ -0,0 +1,36 @@
+package entities
+
+import "time"
+
+// NotificationType classifies notifications
+type NotificationType string
+
+const (
+	NotificationTypePermitExpiry NotificationType = "PermitExpiry"
+	NotificationTypeOcrReview    NotificationType = "OcrReview"
+)
+
+// Notification is an in-app message to one user
+type Notification struct {
+	BaseEntity
+	MultiTenantEntity
+
+	UserID    int              `gorm:"not null;index" json:"userId"`
+	Type      NotificationType `gorm:"size:50;not null" json:"type"`
+	Title     string           `gorm:"size:255;not null" json:"title"`
+	Message   string           `gorm:"type:text" json:"message"`
+	ProjectID *int             `gorm:"index" json:"projectId,omitempty"`
+	ReadAt    *time.Time       `json:"readAt,omitempty"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// MarkRead records when the user read the notification; reading it again keeps the first time
+func (n *Notification) MarkRead() {
+	if n.ReadAt == nil {
+		now := time.Now()
+		n.ReadAt = &now
+	}
+}
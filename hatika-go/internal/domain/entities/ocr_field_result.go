@@ -0,0 +1,33 @@
+package entities
+
+import "time"
+
+// OcrFieldResult is a single value extracted from an OCR document with its confidence
+type OcrFieldResult struct {
+	BaseEntity
+	MultiTenantEntity
+
+	OcrProjectID     int        `gorm:"not null;index" json:"ocrProjectId"`
+	FieldName        string     `gorm:"size:64;not null" json:"fieldName"`
+	Value            string     `gorm:"type:text" json:"value"`
+	Confidence       float64    `gorm:"not null;default:0" json:"confidence"`
+	NeedsReview      bool       `gorm:"default:false;index" json:"needsReview"`
+	IsApproved       bool       `gorm:"default:false" json:"isApproved"`
+	ApprovedValue    *string    `gorm:"type:text" json:"approvedValue,omitempty"`
+	ApprovedByUserID *int       `json:"approvedByUserId,omitempty"`
+	ApprovedAt       *time.Time `json:"approvedAt,omitempty"`
+}
+
+// TableName overrides the table name
+func (OcrFieldResult) TableName() string {
+	return "ocr_field_results"
+}
+
+// Approve records the accepted value; userID is nil when the value was accepted automatically
+func (r *OcrFieldResult) Approve(value string, userID *int) {
+	now := time.Now()
+	r.IsApproved = true
+	r.ApprovedValue = &value
+	r.ApprovedByUserID = userID
+	r.ApprovedAt = &now
+}
@@ -0,0 +1,230 @@
+package entities
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"hatika-go/internal/domain/valueobjects"
+)
+
+// Field names extracted by OCR; they match the JSON names of Project and OcrProject
+const (
+	FieldProjectMuellef       = "projectMuellef"
+	FieldAda                  = "ada"
+	FieldParsel               = "parsel"
+	FieldTalepGucu            = "talepGucu"
+	FieldKuruluGuc            = "kuruluGuc"
+	FieldBagimsizBS           = "bagimsizBS"
+	FieldBlokS                = "blokS"
+	FieldYapiYuksekligi       = "yapiYuksekligi"
+	FieldRuhsatGecerlilikDate = "ruhsatGecerlilikDate"
+	FieldYapiSahibi           = "yapiSahibi"
+	FieldAdress               = "adress"
+)
+
+// OcrFieldNames lists every field an OCR document can fill
+var OcrFieldNames = []string{
+	FieldProjectMuellef,
+	FieldAda,
+	FieldParsel,
+	FieldTalepGucu,
+	FieldKuruluGuc,
+	FieldBagimsizBS,
+	FieldBlokS,
+	FieldYapiYuksekligi,
+	FieldRuhsatGecerlilikDate,
+	FieldYapiSahibi,
+	FieldAdress,
+}
+
+// IsOcrField reports whether name is a known OCR field
+func IsOcrField(name string) bool {
+	for _, field := range OcrFieldNames {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
+
+type ocrFields struct {
+	ProjectMuellef       *string
+	Ada                  **valueobjects.Ada
+	Parsel               **valueobjects.Parsel
+	TalepGucu            **valueobjects.Power
+	KuruluGuc            **valueobjects.Power
+	BagimsizBS           **int
+	BlokS                **int
+	YapiYuksekligi       **float64
+	RuhsatGecerlilikDate **valueobjects.PermitDate
+	YapiSahibi           *string
+	Adress               *string
+}
+
+func (f ocrFields) refs() map[string]interface{} {
+	return map[string]interface{}{
+		FieldProjectMuellef:       f.ProjectMuellef,
+		FieldAda:                  f.Ada,
+		FieldParsel:               f.Parsel,
+		FieldTalepGucu:            f.TalepGucu,
+		FieldKuruluGuc:            f.KuruluGuc,
+		FieldBagimsizBS:           f.BagimsizBS,
+		FieldBlokS:                f.BlokS,
+		FieldYapiYuksekligi:       f.YapiYuksekligi,
+		FieldRuhsatGecerlilikDate: f.RuhsatGecerlilikDate,
+		FieldYapiSahibi:           f.YapiSahibi,
+		FieldAdress:               f.Adress,
+	}
+}
+
+func (f ocrFields) get(name string) (string, error) {
+	ref, ok := f.refs()[name]
+	if !ok {
+		return "", fmt.Errorf("unknown OCR field %q", name)
+	}
+
+	switch r := ref.(type) {
+	case *string:
+		return *r, nil
+	case **int:
+		if *r == nil {
+			return "", nil
+		}
+		return strconv.Itoa(**r), nil
+	case **float64:
+		if *r == nil {
+			return "", nil
+		}
+		return strconv.FormatFloat(**r, 'f', -1, 64), nil
+	case **valueobjects.Ada:
+		return formatOptionalInt(*r), nil
+	case **valueobjects.Parsel:
+		return formatOptionalInt(*r), nil
+	case **valueobjects.Power:
+		return formatOptionalInt(*r), nil
+	case **valueobjects.PermitDate:
+		if *r == nil {
+			return "", nil
+		}
+		return (*r).String(), nil
+	}
+	return "", fmt.Errorf("unsupported OCR field %q", name)
+}
+
+func (f ocrFields) set(name, value string) error {
+	ref, ok := f.refs()[name]
+	if !ok {
+		return fmt.Errorf("unknown OCR field %q", name)
+	}
+
+	value = strings.TrimSpace(value)
+	switch r := ref.(type) {
+	case *string:
+		*r = value
+	case **int:
+		if value == "" {
+			*r = nil
+			return nil
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("field %q expects an integer, got %q", name, value)
+		}
+		*r = &parsed
+	case **float64:
+		if value == "" {
+			*r = nil
+			return nil
+		}
+		parsed, err := strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+		if err != nil {
+			return fmt.Errorf("field %q expects a number, got %q", name, value)
+		}
+		*r = &parsed
+	case **valueobjects.Ada:
+		return setOptional(r, name, value, valueobjects.ParseAda)
+	case **valueobjects.Parsel:
+		return setOptional(r, name, value, valueobjects.ParseParsel)
+	case **valueobjects.Power:
+		return setOptional(r, name, value, valueobjects.ParsePower)
+	case **valueobjects.PermitDate:
+		return setOptional(r, name, value, valueobjects.ParsePermitDate)
+	default:
+		return fmt.Errorf("unsupported OCR field %q", name)
+	}
+	return nil
+}
+
+func formatOptionalInt[T ~int](v *T) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*v))
+}
+
+// setOptional parses a value object into ref, clearing it for an empty value
+func setOptional[T any](ref **T, name, value string, parse func(string) (T, error)) error {
+	if value == "" {
+		*ref = nil
+		return nil
+	}
+	parsed, err := parse(value)
+	if err != nil {
+		return fmt.Errorf("field %q: %w", name, err)
+	}
+	*ref = &parsed
+	return nil
+}
+
+func (p *Project) ocrFields() ocrFields {
+	return ocrFields{
+		ProjectMuellef:       &p.ProjectMuellef,
+		Ada:                  &p.Ada,
+		Parsel:               &p.Parsel,
+		TalepGucu:            &p.TalepGucu,
+		KuruluGuc:            &p.KuruluGuc,
+		BagimsizBS:           &p.BagimsizBS,
+		BlokS:                &p.BlokS,
+		YapiYuksekligi:       &p.YapiYuksekligi,
+		RuhsatGecerlilikDate: &p.RuhsatGecerlilikDate,
+		YapiSahibi:           &p.YapiSahibi,
+		Adress:               &p.Adress,
+	}
+}
+
+// GetOcrField returns the string form of an OCR field, empty when unset
+func (p *Project) GetOcrField(name string) (string, error) {
+	return p.ocrFields().get(name)
+}
+
+// SetOcrField parses value into the named OCR field
+func (p *Project) SetOcrField(name, value string) error {
+	return p.ocrFields().set(name, value)
+}
+
+func (o *OcrProject) ocrFields() ocrFields {
+	return ocrFields{
+		ProjectMuellef:       &o.ProjectMuellef,
+		Ada:                  &o.Ada,
+		Parsel:               &o.Parsel,
+		TalepGucu:            &o.TalepGucu,
+		KuruluGuc:            &o.KuruluGuc,
+		BagimsizBS:           &o.BagimsizBS,
+		BlokS:                &o.BlokS,
+		YapiYuksekligi:       &o.YapiYuksekligi,
+		RuhsatGecerlilikDate: &o.RuhsatGecerlilikDate,
+		YapiSahibi:           &o.YapiSahibi,
+		Adress:               &o.Adress,
+	}
+}
+
+// GetOcrField returns the string form of an OCR field, empty when unset
+func (o *OcrProject) GetOcrField(name string) (string, error) {
+	return o.ocrFields().get(name)
+}
+
+// SetOcrField parses value into the named OCR field
+func (o *OcrProject) SetOcrField(name, value string) error {
+	return o.ocrFields().set(name, value)
+}
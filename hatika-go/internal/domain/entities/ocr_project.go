@@ -1,5 +1,13 @@
 package entities
 
+import (
+	"fmt"
+	"time"
+
+	"hatika-go/internal/domain/events"
+	"hatika-go/internal/domain/valueobjects"
+)
+
 type OcrProjectType int
 
 const (
@@ -7,37 +15,138 @@ const (
 	YapiRuhsati
 	YapiKullanimBelgesi
 	Tapu
+	IskanBelgesi
 )
 
+var ocrProjectTypeNames = [...]string{"ProjeAntenti", "YapiRuhsati", "YapiKullanimBelgesi", "Tapu", "IskanBelgesi"}
+
 func (t OcrProjectType) String() string {
-	return [...]string{"ProjeAntenti", "YapiRuhsati", "YapiKullanimBelgesi", "Tapu"}[t]
+	if !t.IsValid() {
+		return fmt.Sprintf("OcrProjectType(%d)", int(t))
+	}
+	return ocrProjectTypeNames[t]
+}
+
+// IsValid reports whether t is a known document type
+func (t OcrProjectType) IsValid() bool {
+	return t >= 0 && int(t) < len(ocrProjectTypeNames)
+}
+
+// DefaultOcrProjectTypes are the documents a project gets when no template applies
+var DefaultOcrProjectTypes = []OcrProjectType{ProjeAntenti, YapiRuhsati, YapiKullanimBelgesi, Tapu}
+
+// OcrProjectStatus is the lifecycle state of an OCR document
+type OcrProjectStatus int
+
+const (
+	OcrProjectStatusPending OcrProjectStatus = iota
+	OcrProjectStatusProcessing
+	OcrProjectStatusNeedsReview
+	OcrProjectStatusApproved
+	OcrProjectStatusRejected
+)
+
+func (s OcrProjectStatus) String() string {
+	return [...]string{"Pending", "Processing", "NeedsReview", "Approved", "Rejected"}[s]
+}
+
+// ocrProjectTransitions lists the states each status may move to
+var ocrProjectTransitions = map[OcrProjectStatus][]OcrProjectStatus{
+	OcrProjectStatusPending:     {OcrProjectStatusProcessing},
+	OcrProjectStatusProcessing:  {OcrProjectStatusNeedsReview, OcrProjectStatusApproved},
+	OcrProjectStatusNeedsReview: {OcrProjectStatusApproved, OcrProjectStatusRejected},
+	OcrProjectStatusApproved:    {},
+	OcrProjectStatusRejected:    {OcrProjectStatusProcessing},
+}
+
+// CanTransitionTo reports whether the status may move to next
+func (s OcrProjectStatus) CanTransitionTo(next OcrProjectStatus) bool {
+	for _, allowed := range ocrProjectTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
 }
 
 type OcrProject struct {
 	FullAuditedEntity
 	MultiTenantEntity
+	events.Recorder `gorm:"-" json:"-"`
+
+	ProjectName          string                   `gorm:"size:255" json:"projectName,omitempty"`
+	ProjectCode          string                   `gorm:"size:100" json:"projectCode,omitempty"`
+	ProjectComment       string                   `gorm:"type:text" json:"projectComment,omitempty"`
+	ProjectMuellef       string                   `gorm:"size:255" json:"projectMuellef,omitempty"`
+	Ada                  *valueobjects.Ada        `json:"ada,omitempty"`
+	Parsel               *valueobjects.Parsel     `json:"parsel,omitempty"`
+	TalepGucu            *valueobjects.Power      `json:"talepGucu,omitempty"`
+	KuruluGuc            *valueobjects.Power      `json:"kuruluGuc,omitempty"`
+	BagimsizBS           *int                     `json:"bagimsizBS,omitempty"`
+	BlokS                *int                     `json:"blokS,omitempty"`
+	YapiYuksekligi       *float64                 `json:"yapiYuksekligi,omitempty"`
+	RuhsatGecerlilikDate *valueobjects.PermitDate `json:"ruhsatGecerlilikDate,omitempty"`
+	YapiSahibi           string                   `gorm:"size:255" json:"yapiSahibi,omitempty"`
+	Adress               string                   `gorm:"type:text" json:"adress,omitempty"`
+	Type                 OcrProjectType           `gorm:"type:int;not null" json:"type"`
+	ProjectID            int                      `gorm:"not null;index" json:"projectId"`
+	PdfPath              string                   `gorm:"size:500" json:"pdfPath,omitempty"`
+	Status               OcrProjectStatus         `gorm:"type:int;not null;default:0;index" json:"status"`
+	ReviewerUserID       *int                     `gorm:"index" json:"reviewerUserId,omitempty"`
+	ApprovedByUserID     *int                     `json:"approvedByUserId,omitempty"`
+	ApprovedAt           *time.Time               `json:"approvedAt,omitempty"`
+	RejectionReason      string                   `gorm:"type:text" json:"rejectionReason,omitempty"`
+	IsOptional           bool                     `gorm:"default:false" json:"isOptional"`
 
-	ProjectName          string         `gorm:"size:255" json:"projectName,omitempty"`
-	ProjectCode          string         `gorm:"size:100" json:"projectCode,omitempty"`
-	ProjectComment       string         `gorm:"type:text" json:"projectComment,omitempty"`
-	ProjectMuellef       string         `gorm:"size:255" json:"projectMuellef,omitempty"`
-	Ada                  *int           `json:"ada,omitempty"`
-	Parsel               *int           `json:"parsel,omitempty"`
-	TalepGucu            *int           `json:"talepGucu,omitempty"`
-	KuruluGuc            *int           `json:"kuruluGuc,omitempty"`
-	BagimsizBS           *int           `json:"bagimsizBS,omitempty"`
-	BlokS                *int           `json:"blokS,omitempty"`
-	YapiYuksekligi       *float64       `json:"yapiYuksekligi,omitempty"`
-	RuhsatGecerlilikDate string         `gorm:"size:50" json:"ruhsatGecerlilikDate,omitempty"`
-	YapiSahibi           string         `gorm:"size:255" json:"yapiSahibi,omitempty"`
-	Adress               string         `gorm:"type:text" json:"adress,omitempty"`
-	Type                 OcrProjectType `gorm:"type:int;not null" json:"type"`
-	ProjectID            int            `gorm:"not null;index" json:"projectId"`
-	PdfPath              string         `gorm:"size:500" json:"pdfPath,omitempty"`
-
-	Project *Project `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	Project      *Project         `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	FieldResults []OcrFieldResult `gorm:"foreignKey:OcrProjectID" json:"fieldResults,omitempty"`
 }
 
 func (OcrProject) TableName() string {
 	return "ocr_projects"
 }
+
+// TransitionTo moves the OCR project to the next status if the workflow allows it. Leaving
+// processing records an OcrProjectProcessed event.
+func (o *OcrProject) TransitionTo(next OcrProjectStatus) error {
+	if !o.Status.CanTransitionTo(next) {
+		return fmt.Errorf("cannot move OCR project from %s to %s", o.Status, next)
+	}
+	processed := o.Status == OcrProjectStatusProcessing
+	o.Status = next
+
+	if processed {
+		o.RecordEvent(events.OcrProjectProcessed{
+			OcrProjectID:   o.ID,
+			ProjectID:      o.ProjectID,
+			TenantID:       o.TenantID,
+			ProjectCode:    o.ProjectCode,
+			Type:           o.Type.String(),
+			Status:         next.String(),
+			NeedsReview:    next == OcrProjectStatusNeedsReview,
+			ReviewerUserID: o.ReviewerUserID,
+		})
+	}
+	return nil
+}
+
+// Approve moves the OCR project to approved and records who approved it, nil when it was approved
+// automatically
+func (o *OcrProject) Approve(approvedByUserID *int) error {
+	if err := o.TransitionTo(OcrProjectStatusApproved); err != nil {
+		return err
+	}
+	now := time.Now()
+	o.ApprovedByUserID = approvedByUserID
+	o.ApprovedAt = &now
+
+	o.RecordEvent(events.OcrProjectApproved{
+		OcrProjectID:     o.ID,
+		ProjectID:        o.ProjectID,
+		TenantID:         o.TenantID,
+		ProjectCode:      o.ProjectCode,
+		Type:             o.Type.String(),
+		ApprovedByUserID: approvedByUserID,
+	})
+	return nil
+}
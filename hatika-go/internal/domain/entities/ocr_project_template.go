@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DefaultOcrCodePattern names OCR projects "<projectCode>-OCR-<n>"
+const DefaultOcrCodePattern = "{projectCode}-OCR-{index}"
+
+// OcrProjectTemplate describes the OCR documents created for new projects of a tenant,
+// optionally narrowed to one project group
+type OcrProjectTemplate struct {
+	FullAuditedEntity
+	MultiTenantEntity
+
+	Name        string `gorm:"size:128;not null" json:"name"`
+	GroupID     *int   `gorm:"index" json:"groupId,omitempty"`
+	CodePattern string `gorm:"size:128;not null" json:"codePattern"`
+	IsActive    bool   `gorm:"default:true" json:"isActive"`
+
+	// Navigation property
+	Items []OcrProjectTemplateItem `gorm:"foreignKey:TemplateID" json:"items,omitempty"`
+}
+
+// TableName overrides the table name
+func (OcrProjectTemplate) TableName() string {
+	return "ocr_project_templates"
+}
+
+// OcrProjectTemplateItem is one document slot of a template
+type OcrProjectTemplateItem struct {
+	BaseEntity
+
+	TemplateID int            `gorm:"not null;index" json:"templateId"`
+	Type       OcrProjectType `gorm:"type:int;not null" json:"type"`
+	IsOptional bool           `gorm:"default:false" json:"isOptional"`
+	SortOrder  int            `gorm:"default:0" json:"sortOrder"`
+}
+
+// TableName overrides the table name
+func (OcrProjectTemplateItem) TableName() string {
+	return "ocr_project_template_items"
+}
+
+// DefaultOcrProjectTemplate returns the built-in template used when a tenant has none
+func DefaultOcrProjectTemplate() *OcrProjectTemplate {
+	template := &OcrProjectTemplate{
+		Name:        "Default",
+		CodePattern: DefaultOcrCodePattern,
+		IsActive:    true,
+	}
+	for i, t := range DefaultOcrProjectTypes {
+		template.Items = append(template.Items, OcrProjectTemplateItem{Type: t, SortOrder: i})
+	}
+	return template
+}
+
+// FormatOcrProjectCode fills the {projectCode}, {index} and {type} placeholders of a code pattern
+func FormatOcrProjectCode(pattern, projectCode string, index int, t OcrProjectType) string {
+	if pattern == "" {
+		pattern = DefaultOcrCodePattern
+	}
+	return strings.NewReplacer(
+		"{projectCode}", projectCode,
+		"{index}", strconv.Itoa(index),
+		"{type}", t.String(),
+	).Replace(pattern)
+}
+
+// NewOcrProject creates the OCR project for slot number index (1-based) of a project
+func (t *OcrProjectTemplate) NewOcrProject(project *Project, item OcrProjectTemplateItem, index int) OcrProject {
+	return OcrProject{
+		MultiTenantEntity: MultiTenantEntity{TenantID: project.TenantID},
+		ProjectID:         project.ID,
+		ProjectName:       project.ProjectName,
+		ProjectCode:       FormatOcrProjectCode(t.CodePattern, project.ProjectCode, index, item.Type),
+		Type:              item.Type,
+		IsOptional:        item.IsOptional,
+		Status:            OcrProjectStatusPending,
+	}
+}
@@ -0,0 +1,81 @@
+package entities
+
+import "time"
+
+// OcrRunStatus is the state of a single extraction run
+type OcrRunStatus int
+
+const (
+	OcrRunStatusQueued OcrRunStatus = iota
+	OcrRunStatusRunning
+	OcrRunStatusCompleted
+	OcrRunStatusFailed
+)
+
+func (s OcrRunStatus) String() string {
+	return [...]string{"Queued", "Running", "Completed", "Failed"}[s]
+}
+
+// OcrRun records one extraction of an OCR project by a specific engine version.
+// Runs are kept so the output of different engines can be compared later.
+type OcrRun struct {
+	BaseEntity
+	MultiTenantEntity
+
+	OcrProjectID      int          `gorm:"not null;index" json:"ocrProjectId"`
+	EngineName        string       `gorm:"size:100;not null;index:idx_ocr_runs_engine" json:"engineName"`
+	EngineVersion     string       `gorm:"size:50;not null;index:idx_ocr_runs_engine" json:"engineVersion"`
+	BatchID           string       `gorm:"size:36;index" json:"batchId,omitempty"`
+	Status            OcrRunStatus `gorm:"type:int;not null;default:0" json:"status"`
+	Error             string       `gorm:"type:text" json:"error,omitempty"`
+	Apply             bool         `gorm:"default:false" json:"apply"`
+	IsApplied         bool         `gorm:"default:false" json:"isApplied"`
+	TriggeredByUserID *int         `json:"triggeredByUserId,omitempty"`
+	StartedAt         *time.Time   `json:"startedAt,omitempty"`
+	CompletedAt       *time.Time   `json:"completedAt,omitempty"`
+
+	Fields []OcrRunField `gorm:"foreignKey:RunID" json:"fields,omitempty"`
+}
+
+// TableName overrides the table name
+func (OcrRun) TableName() string {
+	return "ocr_runs"
+}
+
+// Start marks the run as running
+func (r *OcrRun) Start() {
+	now := time.Now()
+	r.Status = OcrRunStatusRunning
+	r.StartedAt = &now
+}
+
+// Complete stores the extracted fields and marks the run as completed
+func (r *OcrRun) Complete(fields []OcrRunField) {
+	now := time.Now()
+	r.Status = OcrRunStatusCompleted
+	r.Fields = fields
+	r.CompletedAt = &now
+}
+
+// Fail marks the run as failed with the given error
+func (r *OcrRun) Fail(err error) {
+	now := time.Now()
+	r.Status = OcrRunStatusFailed
+	r.Error = err.Error()
+	r.CompletedAt = &now
+}
+
+// OcrRunField is a value produced by a run, kept independently of the reviewed field results
+type OcrRunField struct {
+	BaseEntity
+
+	RunID      int     `gorm:"not null;index" json:"runId"`
+	FieldName  string  `gorm:"size:64;not null" json:"fieldName"`
+	Value      string  `gorm:"type:text" json:"value"`
+	Confidence float64 `gorm:"not null;default:0" json:"confidence"`
+}
+
+// TableName overrides the table name
+func (OcrRunField) TableName() string {
+	return "ocr_run_fields"
+}
@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+// OutboxMessageStatus is where an outbox message stands in delivery to the brokers
+type OutboxMessageStatus string
+
+const (
+	OutboxMessageStatusPending   OutboxMessageStatus = "Pending"
+	OutboxMessageStatusPublished OutboxMessageStatus = "Published"
+	// OutboxMessageStatusFailed messages ran out of attempts and wait for someone to look at them
+	OutboxMessageStatusFailed OutboxMessageStatus = "Failed"
+)
+
+// OutboxMessage is a domain event stored in the transaction that raised it, so it reaches the
+// brokers exactly when the change it describes is committed
+type OutboxMessage struct {
+	BaseEntity
+	MultiTenantEntity
+
+	EventID       string              `gorm:"size:32;not null;uniqueIndex" json:"eventId"`
+	EventName     string              `gorm:"size:128;not null;index" json:"eventName"`
+	Payload       string              `gorm:"type:text;not null" json:"payload"`
+	OccurredAt    time.Time           `gorm:"not null" json:"occurredAt"`
+	Status        OutboxMessageStatus `gorm:"size:20;not null;default:'Pending';index:idx_outbox_messages_due,priority:1" json:"status"`
+	NextAttemptAt time.Time           `gorm:"not null;index:idx_outbox_messages_due,priority:2" json:"nextAttemptAt"`
+	Attempts      int                 `gorm:"not null;default:0" json:"attempts"`
+	LastError     string              `gorm:"type:text" json:"lastError,omitempty"`
+	PublishedAt   *time.Time          `json:"publishedAt,omitempty"`
+}
+
+func (OutboxMessage) TableName() string {
+	return "outbox_messages"
+}
@@ -3,13 +3,13 @@ package entities
 // Permission represents a system permission
 type Permission struct {
 	BaseEntity
-	
-	Name         string  `gorm:"size:128;uniqueIndex;not null" json:"name" binding:"required"`
-	DisplayName  string  `gorm:"size:256;not null" json:"displayName" binding:"required"`
-	Description  string  `gorm:"type:text" json:"description,omitempty"`
-	
+
+	Name        string `gorm:"size:128;uniqueIndex;not null" json:"name" binding:"required"`
+	DisplayName string `gorm:"size:256;not null" json:"displayName" binding:"required"`
+	Description string `gorm:"type:text" json:"description,omitempty"`
+
 	// Navigation properties
-	Roles        []Role  `gorm:"many2many:role_permissions;" json:"roles,omitempty"`
+	Roles []Role `gorm:"many2many:role_permissions;" json:"roles,omitempty"`
 }
 
 // TableName overrides the table name
@@ -20,22 +20,80 @@ func (Permission) TableName() string {
 // Permission names (similar to PermissionNames.cs)
 const (
 	// Pages
-	PagesUsers      = "Pages.Users"
-	PagesRoles      = "Pages.Roles"
-	PagesProjects   = "Pages.Projects"
+	PagesUsers       = "Pages.Users"
+	PagesRoles       = "Pages.Roles"
+	PagesProjects    = "Pages.Projects"
 	PagesOcrProjects = "Pages.OcrProjects"
-	PagesTenants    = "Pages.Tenants"
-	
+	PagesTenants     = "Pages.Tenants"
+
+	PagesOcrProjectTemplates = "Pages.OcrProjectTemplates"
+	PagesProjectGroups       = "Pages.ProjectGroups"
+	PagesWebhooks            = "Pages.Webhooks"
+
 	// Actions
-	UsersCreate     = "Pages.Users.Create"
-	UsersEdit       = "Pages.Users.Edit"
-	UsersDelete     = "Pages.Users.Delete"
-	
-	RolesCreate     = "Pages.Roles.Create"
-	RolesEdit       = "Pages.Roles.Edit"
-	RolesDelete     = "Pages.Roles.Delete"
-	
-	ProjectsCreate  = "Pages.Projects.Create"
-	ProjectsEdit    = "Pages.Projects.Edit"
-	ProjectsDelete  = "Pages.Projects.Delete"
+	UsersCreate = "Pages.Users.Create"
+	UsersEdit   = "Pages.Users.Edit"
+	UsersDelete = "Pages.Users.Delete"
+
+	RolesCreate = "Pages.Roles.Create"
+	RolesEdit   = "Pages.Roles.Edit"
+	RolesDelete = "Pages.Roles.Delete"
+
+	ProjectsCreate = "Pages.Projects.Create"
+	ProjectsEdit   = "Pages.Projects.Edit"
+	ProjectsDelete = "Pages.Projects.Delete"
+	ProjectsImport = "Pages.Projects.Import"
+	ProjectsBulk   = "Pages.Projects.Bulk"
+	ProjectsExport = "Pages.Projects.Export"
+
+	ProjectGroupsCreate      = "Pages.ProjectGroups.Create"
+	ProjectGroupsEdit        = "Pages.ProjectGroups.Edit"
+	ProjectGroupsDelete      = "Pages.ProjectGroups.Delete"
+	ProjectGroupsPermissions = "Pages.ProjectGroups.Permissions"
+
+	WebhooksManage    = "Pages.Webhooks.Manage"
+	WebhooksRedeliver = "Pages.Webhooks.Redeliver"
+
+	OcrProjectsReview    = "Pages.OcrProjects.Review"
+	OcrProjectsReprocess = "Pages.OcrProjects.Reprocess"
 )
+
+// PermissionDefinition describes a permission the application checks; permissions sync keeps the
+// permissions table in line with these
+type PermissionDefinition struct {
+	Name        string
+	DisplayName string
+	Description string
+}
+
+// PermissionDefinitions lists every permission of the application
+var PermissionDefinitions = []PermissionDefinition{
+	{Name: PagesUsers, DisplayName: "Users", Description: "Access to users page"},
+	{Name: PagesRoles, DisplayName: "Roles", Description: "Access to roles page"},
+	{Name: PagesProjects, DisplayName: "Projects", Description: "Access to projects page"},
+	{Name: PagesOcrProjects, DisplayName: "OCR Projects", Description: "Access to OCR projects page"},
+	{Name: PagesTenants, DisplayName: "Tenants", Description: "Access to tenants page"},
+	{Name: UsersCreate, DisplayName: "Create User", Description: "Can create users"},
+	{Name: UsersEdit, DisplayName: "Edit User", Description: "Can edit users"},
+	{Name: UsersDelete, DisplayName: "Delete User", Description: "Can delete users"},
+	{Name: RolesCreate, DisplayName: "Create Role", Description: "Can create roles"},
+	{Name: RolesEdit, DisplayName: "Edit Role", Description: "Can edit roles"},
+	{Name: RolesDelete, DisplayName: "Delete Role", Description: "Can delete roles"},
+	{Name: ProjectsCreate, DisplayName: "Create Project", Description: "Can create projects"},
+	{Name: ProjectsEdit, DisplayName: "Edit Project", Description: "Can edit projects"},
+	{Name: ProjectsDelete, DisplayName: "Delete Project", Description: "Can delete projects"},
+	{Name: ProjectsImport, DisplayName: "Import Projects", Description: "Can create and update projects from CSV or XLSX files"},
+	{Name: ProjectsBulk, DisplayName: "Bulk Edit Projects", Description: "Can update, regroup, delete and restore many projects at once"},
+	{Name: ProjectsExport, DisplayName: "Export Projects", Description: "Can export project lists and PDF project summaries"},
+	{Name: PagesOcrProjectTemplates, DisplayName: "OCR Project Templates", Description: "Access to OCR project templates page"},
+	{Name: OcrProjectsReview, DisplayName: "Review OCR Project", Description: "Can review and approve OCR extractions"},
+	{Name: OcrProjectsReprocess, DisplayName: "Reprocess OCR Project", Description: "Can re-run OCR extraction and compare engines"},
+	{Name: PagesProjectGroups, DisplayName: "Project Groups", Description: "Access to project groups page"},
+	{Name: ProjectGroupsCreate, DisplayName: "Create Project Group", Description: "Can create project groups"},
+	{Name: ProjectGroupsEdit, DisplayName: "Edit Project Group", Description: "Can edit project groups"},
+	{Name: ProjectGroupsDelete, DisplayName: "Delete Project Group", Description: "Can delete project groups"},
+	{Name: ProjectGroupsPermissions, DisplayName: "Project Group Permissions", Description: "Can choose which users see a project group"},
+	{Name: PagesWebhooks, DisplayName: "Webhooks", Description: "Access to webhook subscriptions and their delivery logs"},
+	{Name: WebhooksManage, DisplayName: "Manage Webhooks", Description: "Can create, edit and delete webhook subscriptions"},
+	{Name: WebhooksRedeliver, DisplayName: "Redeliver Webhooks", Description: "Can send webhook deliveries again"},
+}
@@ -0,0 +1,18 @@
+package entities
+
+import "hatika-go/internal/domain/valueobjects"
+
+// PermitExpiryReminder records that the reminder of one window was sent for a permit date. A renewed
+// permit has a new date, so its reminders are sent again.
+type PermitExpiryReminder struct {
+	BaseEntity
+
+	ProjectID  int                     `gorm:"not null;uniqueIndex:idx_permit_expiry_reminders_key" json:"projectId"`
+	PermitDate valueobjects.PermitDate `gorm:"not null;uniqueIndex:idx_permit_expiry_reminders_key" json:"permitDate"`
+	WindowDays int                     `gorm:"not null;uniqueIndex:idx_permit_expiry_reminders_key" json:"windowDays"`
+	Recipients int                     `gorm:"not null" json:"recipients"`
+}
+
+func (PermitExpiryReminder) TableName() string {
+	return "permit_expiry_reminders"
+}
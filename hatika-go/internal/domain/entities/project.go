@@ -1,29 +1,32 @@
 package entities
 
+import "hatika-go/internal/domain/valueobjects"
+
 // Project represents a construction project
 type Project struct {
 	FullAuditedEntity
 	MultiTenantEntity
-	
-	ProjectName             string        `gorm:"size:255;not null" json:"projectName" binding:"required"`
-	ProjectCode             string        `gorm:"size:100;uniqueIndex" json:"projectCode" binding:"required"`
-	ProjectComment          string        `gorm:"type:text" json:"projectComment,omitempty"`
-	ProjectMuellef          string        `gorm:"size:255" json:"projectMuellef,omitempty"`
-	Ada                     *int          `json:"ada,omitempty"`
-	Parsel                  *int          `json:"parsel,omitempty"`
-	TalepGucu               *int          `json:"talepGucu,omitempty"`
-	KuruluGuc               *int          `json:"kuruluGuc,omitempty"`
-	BagimsizBS              *int          `json:"bagimsizBS,omitempty"`
-	BlokS                   *int          `json:"blokS,omitempty"`
-	YapiYuksekligi          *float64      `json:"yapiYuksekligi,omitempty"`
-	RuhsatGecerlilikDate    string        `gorm:"size:50" json:"ruhsatGecerlilikDate,omitempty"`
-	YapiSahibi              string        `gorm:"size:255" json:"yapiSahibi,omitempty"`
-	Adress                  string        `gorm:"type:text" json:"adress,omitempty"`
-	GroupID                 *int          `gorm:"index" json:"groupId,omitempty"`
-	BildirimNo              string        `gorm:"size:100" json:"bildirimNo,omitempty"`
-	
-	// Navigation property
-	OcrProjects             []OcrProject  `gorm:"foreignKey:ProjectID" json:"ocrProjects,omitempty"`
+
+	ProjectName          string                   `gorm:"size:255;not null" json:"projectName" binding:"required"`
+	ProjectCode          string                   `gorm:"size:100;uniqueIndex" json:"projectCode" binding:"required"`
+	ProjectComment       string                   `gorm:"type:text" json:"projectComment,omitempty"`
+	ProjectMuellef       string                   `gorm:"size:255" json:"projectMuellef,omitempty"`
+	Ada                  *valueobjects.Ada        `json:"ada,omitempty"`
+	Parsel               *valueobjects.Parsel     `json:"parsel,omitempty"`
+	TalepGucu            *valueobjects.Power      `json:"talepGucu,omitempty"`
+	KuruluGuc            *valueobjects.Power      `json:"kuruluGuc,omitempty"`
+	BagimsizBS           *int                     `json:"bagimsizBS,omitempty"`
+	BlokS                *int                     `json:"blokS,omitempty"`
+	YapiYuksekligi       *float64                 `json:"yapiYuksekligi,omitempty"`
+	RuhsatGecerlilikDate *valueobjects.PermitDate `gorm:"index" json:"ruhsatGecerlilikDate,omitempty"`
+	YapiSahibi           string                   `gorm:"size:255" json:"yapiSahibi,omitempty"`
+	Adress               string                   `gorm:"type:text" json:"adress,omitempty"`
+	GroupID              *int                     `gorm:"index" json:"groupId,omitempty"`
+	BildirimNo           string                   `gorm:"size:100" json:"bildirimNo,omitempty"`
+
+	// Navigation properties
+	Group       *ProjectGroup `gorm:"foreignKey:GroupID;constraint:OnDelete:RESTRICT" json:"group,omitempty"`
+	OcrProjects []OcrProject  `gorm:"foreignKey:ProjectID" json:"ocrProjects,omitempty"`
 }
 
 // TableName overrides the table name
@@ -0,0 +1,120 @@
+package entities
+
+import "time"
+
+// ProjectBulkOperationType is what a bulk operation does with every selected project
+type ProjectBulkOperationType string
+
+const (
+	ProjectBulkUpdateFields ProjectBulkOperationType = "UpdateFields"
+	ProjectBulkChangeGroup  ProjectBulkOperationType = "ChangeGroup"
+	ProjectBulkDelete       ProjectBulkOperationType = "Delete"
+	ProjectBulkRestore      ProjectBulkOperationType = "Restore"
+)
+
+// ProjectBulkOperationStatus is the state of a bulk operation
+type ProjectBulkOperationStatus int
+
+const (
+	ProjectBulkOperationStatusQueued ProjectBulkOperationStatus = iota
+	ProjectBulkOperationStatusRunning
+	ProjectBulkOperationStatusCompleted
+	ProjectBulkOperationStatusFailed
+)
+
+func (s ProjectBulkOperationStatus) String() string {
+	return [...]string{"Queued", "Running", "Completed", "Failed"}[s]
+}
+
+// ProjectBulkItemOutcome is what a bulk operation did with one project
+type ProjectBulkItemOutcome int
+
+const (
+	ProjectBulkItemSucceeded ProjectBulkItemOutcome = iota
+	// ProjectBulkItemSkipped means the project was already in the requested state
+	ProjectBulkItemSkipped
+	ProjectBulkItemNotFound
+	ProjectBulkItemForbidden
+	ProjectBulkItemFailed
+)
+
+func (o ProjectBulkItemOutcome) String() string {
+	return [...]string{"Succeeded", "Skipped", "NotFound", "Forbidden", "Failed"}[o]
+}
+
+// ProjectBulkOperation applies one operation to a selection of projects. Small selections run in
+// the request's transaction; larger ones run in the background, one transaction per chunk.
+type ProjectBulkOperation struct {
+	BaseEntity
+	MultiTenantEntity
+
+	Operation         ProjectBulkOperationType   `gorm:"size:32;not null" json:"operation"`
+	Background        bool                       `gorm:"default:false" json:"background"`
+	Status            ProjectBulkOperationStatus `gorm:"type:int;not null;default:0" json:"status"`
+	Error             string                     `gorm:"type:text" json:"error,omitempty"`
+	TotalCount        int                        `gorm:"not null;default:0" json:"totalCount"`
+	ProcessedCount    int                        `gorm:"not null;default:0" json:"processedCount"`
+	SucceededCount    int                        `gorm:"not null;default:0" json:"succeededCount"`
+	SkippedCount      int                        `gorm:"not null;default:0" json:"skippedCount"`
+	FailedCount       int                        `gorm:"not null;default:0" json:"failedCount"`
+	TriggeredByUserID *int                       `json:"triggeredByUserId,omitempty"`
+	StartedAt         *time.Time                 `json:"startedAt,omitempty"`
+	CompletedAt       *time.Time                 `json:"completedAt,omitempty"`
+
+	Items []ProjectBulkOperationItem `gorm:"foreignKey:OperationID" json:"items,omitempty"`
+}
+
+// TableName overrides the table name
+func (ProjectBulkOperation) TableName() string {
+	return "project_bulk_operations"
+}
+
+// Start marks the operation as running
+func (o *ProjectBulkOperation) Start() {
+	now := time.Now()
+	o.Status = ProjectBulkOperationStatusRunning
+	o.StartedAt = &now
+}
+
+// Record counts the outcome of a processed project
+func (o *ProjectBulkOperation) Record(item *ProjectBulkOperationItem) {
+	o.ProcessedCount++
+	switch item.Outcome {
+	case ProjectBulkItemSucceeded:
+		o.SucceededCount++
+	case ProjectBulkItemSkipped:
+		o.SkippedCount++
+	default:
+		o.FailedCount++
+	}
+}
+
+// Complete marks the operation as completed
+func (o *ProjectBulkOperation) Complete() {
+	now := time.Now()
+	o.Status = ProjectBulkOperationStatusCompleted
+	o.CompletedAt = &now
+}
+
+// Fail marks the operation as failed with the given error
+func (o *ProjectBulkOperation) Fail(err error) {
+	now := time.Now()
+	o.Status = ProjectBulkOperationStatusFailed
+	o.Error = err.Error()
+	o.CompletedAt = &now
+}
+
+// ProjectBulkOperationItem is the result of a bulk operation for one project
+type ProjectBulkOperationItem struct {
+	BaseEntity
+
+	OperationID int                    `gorm:"not null;index:idx_project_bulk_operation_items_operation_project,priority:1" json:"operationId"`
+	ProjectID   int                    `gorm:"not null;index:idx_project_bulk_operation_items_operation_project,priority:2" json:"projectId"`
+	Outcome     ProjectBulkItemOutcome `gorm:"type:int;not null" json:"outcome"`
+	Error       string                 `gorm:"type:text" json:"error,omitempty"`
+}
+
+// TableName overrides the table name
+func (ProjectBulkOperationItem) TableName() string {
+	return "project_bulk_operation_items"
+}
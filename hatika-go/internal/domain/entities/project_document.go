@@ -0,0 +1,54 @@
+package entities
+
+// ProjectDocumentStatus is the processing state of an uploaded document
+type ProjectDocumentStatus int
+
+const (
+	ProjectDocumentStatusUploaded ProjectDocumentStatus = iota
+	ProjectDocumentStatusSplit
+	ProjectDocumentStatusFailed
+)
+
+func (s ProjectDocumentStatus) String() string {
+	return [...]string{"Uploaded", "Split", "Failed"}[s]
+}
+
+// ProjectDocument is an uploaded file whose pages are split across a project's OCR projects
+type ProjectDocument struct {
+	FullAuditedEntity
+	MultiTenantEntity
+
+	ProjectID   int                   `gorm:"not null;index" json:"projectId"`
+	FileName    string                `gorm:"size:255;not null" json:"fileName"`
+	StorageKey  string                `gorm:"size:500;not null" json:"-"`
+	ContentType string                `gorm:"size:100" json:"contentType"`
+	SizeBytes   int64                 `json:"sizeBytes"`
+	PageCount   int                   `json:"pageCount"`
+	Status      ProjectDocumentStatus `gorm:"type:int;not null;default:0" json:"status"`
+
+	// Navigation property
+	Pages []DocumentPage `gorm:"foreignKey:DocumentID" json:"pages,omitempty"`
+}
+
+// TableName overrides the table name
+func (ProjectDocument) TableName() string {
+	return "project_documents"
+}
+
+// DocumentPage records which OCR project a page of an uploaded document belongs to
+type DocumentPage struct {
+	BaseEntity
+
+	DocumentID   int             `gorm:"not null;index;uniqueIndex:idx_document_pages_document_page" json:"documentId"`
+	PageNumber   int             `gorm:"not null;uniqueIndex:idx_document_pages_document_page" json:"pageNumber"`
+	DetectedType *OcrProjectType `gorm:"type:int" json:"detectedType,omitempty"`
+	Confidence   float64         `json:"confidence"`
+	OcrProjectID *int            `gorm:"index" json:"ocrProjectId,omitempty"`
+	IsManual     bool            `gorm:"default:false" json:"isManual"`
+	Text         string          `gorm:"type:text" json:"-"`
+}
+
+// TableName overrides the table name
+func (DocumentPage) TableName() string {
+	return "document_pages"
+}
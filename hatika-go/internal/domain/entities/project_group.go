@@ -0,0 +1,160 @@
+package entities
+
+import "sort"
+
+// ProjectGroup organizes a tenant's projects, e.g. by regional office. Groups nest through ParentID.
+type ProjectGroup struct {
+	FullAuditedEntity
+	MultiTenantEntity
+
+	Name        string `gorm:"size:128;not null" json:"name"`
+	Description string `gorm:"type:text" json:"description,omitempty"`
+	ParentID    *int   `gorm:"index" json:"parentId,omitempty"`
+
+	// Navigation property
+	Parent *ProjectGroup `gorm:"foreignKey:ParentID" json:"parent,omitempty"`
+}
+
+// TableName overrides the table name
+func (ProjectGroup) TableName() string {
+	return "project_groups"
+}
+
+// ProjectGroupPermission grants a user access to a group and its subgroups. A group without
+// grants on itself or an ancestor is visible to every user of the tenant.
+type ProjectGroupPermission struct {
+	BaseEntity
+
+	GroupID int `gorm:"not null;uniqueIndex:idx_project_group_permissions_group_user" json:"groupId"`
+	UserID  int `gorm:"not null;uniqueIndex:idx_project_group_permissions_group_user;index" json:"userId"`
+}
+
+// TableName overrides the table name
+func (ProjectGroupPermission) TableName() string {
+	return "project_group_permissions"
+}
+
+// ProjectGroupTree answers ancestry and visibility questions about the groups of one tenant
+type ProjectGroupTree struct {
+	ordered []*ProjectGroup
+	groups  map[int]*ProjectGroup
+	grants  map[int]map[int]bool
+}
+
+// NewProjectGroupTree indexes groups and their grants
+func NewProjectGroupTree(groups []ProjectGroup, grants []ProjectGroupPermission) *ProjectGroupTree {
+	tree := &ProjectGroupTree{
+		groups: make(map[int]*ProjectGroup, len(groups)),
+		grants: make(map[int]map[int]bool),
+	}
+	for i := range groups {
+		tree.ordered = append(tree.ordered, &groups[i])
+		tree.groups[groups[i].ID] = &groups[i]
+	}
+	for _, grant := range grants {
+		if tree.grants[grant.GroupID] == nil {
+			tree.grants[grant.GroupID] = make(map[int]bool)
+		}
+		tree.grants[grant.GroupID][grant.UserID] = true
+	}
+	return tree
+}
+
+// Groups returns the groups in the order they were loaded
+func (t *ProjectGroupTree) Groups() []*ProjectGroup {
+	return t.ordered
+}
+
+// Get returns a group of the tree, or nil
+func (t *ProjectGroupTree) Get(id int) *ProjectGroup {
+	return t.groups[id]
+}
+
+// Ancestors returns the IDs from the group's parent up to the root. It stops at a cycle.
+func (t *ProjectGroupTree) Ancestors(id int) []int {
+	var ancestors []int
+	seen := map[int]bool{id: true}
+	for group := t.groups[id]; group != nil && group.ParentID != nil; group = t.groups[*group.ParentID] {
+		if seen[*group.ParentID] {
+			break
+		}
+		seen[*group.ParentID] = true
+		ancestors = append(ancestors, *group.ParentID)
+	}
+	return ancestors
+}
+
+// IsDescendant reports whether candidate is id itself or lies below it
+func (t *ProjectGroupTree) IsDescendant(candidate, id int) bool {
+	if candidate == id {
+		return true
+	}
+	for _, ancestor := range t.Ancestors(candidate) {
+		if ancestor == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Subtree returns id and the IDs of every group below it
+func (t *ProjectGroupTree) Subtree(id int) []int {
+	var subtree []int
+	for groupID := range t.groups {
+		if t.IsDescendant(groupID, id) {
+			subtree = append(subtree, groupID)
+		}
+	}
+	sort.Ints(subtree)
+	return subtree
+}
+
+// IsVisibleTo reports whether a user may see a group. The nearest restricted group on the path to the
+// root decides: the user needs a grant there or on any group above it.
+func (t *ProjectGroupTree) IsVisibleTo(id, userID int) bool {
+	if t.groups[id] == nil {
+		return false
+	}
+	restricted := false
+	for _, groupID := range append([]int{id}, t.Ancestors(id)...) {
+		if grants := t.grants[groupID]; len(grants) > 0 {
+			if grants[userID] {
+				return true
+			}
+			restricted = true
+		}
+	}
+	return !restricted
+}
+
+// VisibleTo returns the IDs of the groups a user may see
+func (t *ProjectGroupTree) VisibleTo(userID int) []int {
+	var visible []int
+	for groupID := range t.groups {
+		if t.IsVisibleTo(groupID, userID) {
+			visible = append(visible, groupID)
+		}
+	}
+	sort.Ints(visible)
+	return visible
+}
+
+// Members returns the users granted the group or a group above it, who are the people responsible for
+// its projects
+func (t *ProjectGroupTree) Members(id int) []int {
+	if t.groups[id] == nil {
+		return nil
+	}
+	seen := make(map[int]bool)
+	var members []int
+	for _, groupID := range append([]int{id}, t.Ancestors(id)...) {
+		for userID := range t.grants[groupID] {
+			if !seen[userID] {
+				seen[userID] = true
+				members = append(members, userID)
+			}
+		}
+	}
+	sort.Ints(members)
+	return members
+}
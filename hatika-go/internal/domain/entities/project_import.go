@@ -0,0 +1,111 @@
+package entities
+
+import "time"
+
+// ProjectImportStatus is the state of a project import job
+type ProjectImportStatus int
+
+const (
+	ProjectImportStatusQueued ProjectImportStatus = iota
+	ProjectImportStatusRunning
+	ProjectImportStatusCompleted
+	ProjectImportStatusFailed
+)
+
+func (s ProjectImportStatus) String() string {
+	return [...]string{"Queued", "Running", "Completed", "Failed"}[s]
+}
+
+// ProjectImportRowAction is what an import did, or would do in a dry run, with one row
+type ProjectImportRowAction int
+
+const (
+	ProjectImportRowCreated ProjectImportRowAction = iota
+	ProjectImportRowUpdated
+	ProjectImportRowFailed
+)
+
+func (a ProjectImportRowAction) String() string {
+	return [...]string{"Created", "Updated", "Failed"}[a]
+}
+
+// ProjectImport is a background job that creates or updates projects from an uploaded CSV or XLSX file.
+// Rows are matched to existing projects by ProjectCode.
+type ProjectImport struct {
+	BaseEntity
+	MultiTenantEntity
+
+	FileName          string              `gorm:"size:255;not null" json:"fileName"`
+	Format            string              `gorm:"size:8;not null" json:"format"`
+	DryRun            bool                `gorm:"default:false" json:"dryRun"`
+	Status            ProjectImportStatus `gorm:"type:int;not null;default:0" json:"status"`
+	Error             string              `gorm:"type:text" json:"error,omitempty"`
+	TotalRows         int                 `gorm:"not null;default:0" json:"totalRows"`
+	ProcessedRows     int                 `gorm:"not null;default:0" json:"processedRows"`
+	CreatedCount      int                 `gorm:"not null;default:0" json:"createdCount"`
+	UpdatedCount      int                 `gorm:"not null;default:0" json:"updatedCount"`
+	FailedCount       int                 `gorm:"not null;default:0" json:"failedCount"`
+	TriggeredByUserID *int                `json:"triggeredByUserId,omitempty"`
+	StartedAt         *time.Time          `json:"startedAt,omitempty"`
+	CompletedAt       *time.Time          `json:"completedAt,omitempty"`
+
+	Rows []ProjectImportRow `gorm:"foreignKey:ImportID" json:"rows,omitempty"`
+}
+
+// TableName overrides the table name
+func (ProjectImport) TableName() string {
+	return "project_imports"
+}
+
+// Start marks the import as running
+func (i *ProjectImport) Start() {
+	now := time.Now()
+	i.Status = ProjectImportStatusRunning
+	i.StartedAt = &now
+}
+
+// Record counts the outcome of a processed row
+func (i *ProjectImport) Record(row *ProjectImportRow) {
+	i.ProcessedRows++
+	switch row.Action {
+	case ProjectImportRowCreated:
+		i.CreatedCount++
+	case ProjectImportRowUpdated:
+		i.UpdatedCount++
+	case ProjectImportRowFailed:
+		i.FailedCount++
+	}
+}
+
+// Complete marks the import as completed
+func (i *ProjectImport) Complete() {
+	now := time.Now()
+	i.Status = ProjectImportStatusCompleted
+	i.CompletedAt = &now
+}
+
+// Fail marks the import as failed with the given error
+func (i *ProjectImport) Fail(err error) {
+	now := time.Now()
+	i.Status = ProjectImportStatusFailed
+	i.Error = err.Error()
+	i.CompletedAt = &now
+}
+
+// ProjectImportRow is the result of one data row of an import. RowNumber is the line in the file,
+// counting the header as line 1.
+type ProjectImportRow struct {
+	BaseEntity
+
+	ImportID    int                    `gorm:"not null;index" json:"importId"`
+	RowNumber   int                    `gorm:"not null" json:"rowNumber"`
+	ProjectCode string                 `gorm:"size:100" json:"projectCode,omitempty"`
+	Action      ProjectImportRowAction `gorm:"type:int;not null" json:"action"`
+	ProjectID   *int                   `json:"projectId,omitempty"`
+	Errors      string                 `gorm:"type:text" json:"errors,omitempty"`
+}
+
+// TableName overrides the table name
+func (ProjectImportRow) TableName() string {
+	return "project_import_rows"
+}
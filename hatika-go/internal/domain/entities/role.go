@@ -26,3 +26,20 @@ const (
 	AdminRoleName = "Admin"
 	UserRoleName  = "User"
 )
+
+// StaticRoles are the roles every installation has; Admin is granted every permission
+var StaticRoles = []Role{
+	{
+		Name:        AdminRoleName,
+		DisplayName: "Administrator",
+		Description: "System administrator with full access",
+		IsStatic:    true,
+	},
+	{
+		Name:        UserRoleName,
+		DisplayName: "User",
+		Description: "Standard user with limited access",
+		IsStatic:    true,
+		IsDefault:   true,
+	},
+}
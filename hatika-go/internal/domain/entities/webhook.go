@@ -0,0 +1,85 @@
+package entities
+
+import "time"
+
+// WebhookAllEvents subscribes a webhook to every event, including ones added later
+const WebhookAllEvents = "*"
+
+// WebhookSubscription sends the tenant's domain events to an HTTP endpoint of the customer. Every
+// request is signed with the secret, so the receiver can tell it came from us.
+type WebhookSubscription struct {
+	FullAuditedEntity
+	MultiTenantEntity
+
+	URL         string   `gorm:"size:2048;not null" json:"url"`
+	Secret      string   `gorm:"size:128;not null" json:"-"`
+	EventTypes  []string `gorm:"type:text;not null;serializer:json" json:"eventTypes"`
+	Description string   `gorm:"size:500" json:"description,omitempty"`
+	IsActive    bool     `gorm:"not null;default:true" json:"isActive"`
+}
+
+// TableName overrides the table name
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Subscribes reports whether the subscription wants events with the name
+func (s *WebhookSubscription) Subscribes(eventName string) bool {
+	for _, eventType := range s.EventTypes {
+		if eventType == WebhookAllEvents || eventType == eventName {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus is where a delivery stands
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "Pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "Succeeded"
+	// WebhookDeliveryStatusDeadLettered deliveries ran out of attempts; only a manual redelivery
+	// sends them again
+	WebhookDeliveryStatusDeadLettered WebhookDeliveryStatus = "DeadLettered"
+)
+
+// WebhookDelivery is one event on its way to one subscription, and the log of how that went. The
+// body is fixed when the delivery is created, so redeliveries send exactly the same bytes.
+type WebhookDelivery struct {
+	BaseEntity
+	MultiTenantEntity
+
+	SubscriptionID      int                   `gorm:"not null;uniqueIndex:idx_webhook_deliveries_subscription_event,priority:1" json:"subscriptionId"`
+	EventID             string                `gorm:"size:32;not null;uniqueIndex:idx_webhook_deliveries_subscription_event,priority:2" json:"eventId"`
+	EventName           string                `gorm:"size:128;not null" json:"eventName"`
+	Body                string                `gorm:"type:text;not null" json:"body"`
+	Status              WebhookDeliveryStatus `gorm:"size:20;not null;default:'Pending';index:idx_webhook_deliveries_due,priority:1" json:"status"`
+	NextAttemptAt       time.Time             `gorm:"not null;index:idx_webhook_deliveries_due,priority:2" json:"nextAttemptAt"`
+	Attempts            int                   `gorm:"not null;default:0" json:"attempts"`
+	LastAttemptAt       *time.Time            `json:"lastAttemptAt,omitempty"`
+	ResponseStatusCode  *int                  `json:"responseStatusCode,omitempty"`
+	ResponseBody        string                `gorm:"type:text" json:"responseBody,omitempty"`
+	DurationMs          *int64                `json:"durationMs,omitempty"`
+	LastError           string                `gorm:"type:text" json:"lastError,omitempty"`
+	DeliveredAt         *time.Time            `json:"deliveredAt,omitempty"`
+	RedeliveredByUserID *int                  `json:"redeliveredByUserId,omitempty"`
+	RedeliveredAt       *time.Time            `json:"redeliveredAt,omitempty"`
+
+	Subscription *WebhookSubscription `gorm:"foreignKey:SubscriptionID" json:"subscription,omitempty"`
+}
+
+// TableName overrides the table name
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// Redeliver queues the delivery to be sent again right away, with a fresh set of attempts
+func (d *WebhookDelivery) Redeliver(userID int) {
+	now := time.Now()
+	d.Status = WebhookDeliveryStatusPending
+	d.NextAttemptAt = now
+	d.Attempts = 0
+	d.RedeliveredByUserID = &userID
+	d.RedeliveredAt = &now
+}
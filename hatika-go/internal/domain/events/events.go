@@ -0,0 +1,136 @@
+package events
+
+// Event is something that happened in the domain. Events are stored as JSON, so their fields must
+// marshal cleanly.
+type Event interface {
+	// EventName identifies the event type to handlers and brokers
+	EventName() string
+	// EventTenantID is the tenant the event belongs to, nil for the host
+	EventTenantID() *int
+}
+
+// Event names
+const (
+	ProjectCreatedName      = "ProjectCreated"
+	ProjectUpdatedName      = "ProjectUpdated"
+	ProjectSoftDeletedName  = "ProjectSoftDeleted"
+	ProjectRestoredName     = "ProjectRestored"
+	OcrProjectProcessedName = "OcrProjectProcessed"
+	OcrProjectApprovedName  = "OcrProjectApproved"
+)
+
+// Names lists every event the application raises, in the order they are documented
+var Names = []string{
+	ProjectCreatedName,
+	ProjectUpdatedName,
+	ProjectSoftDeletedName,
+	ProjectRestoredName,
+	OcrProjectProcessedName,
+	OcrProjectApprovedName,
+}
+
+// IsKnown reports whether name is an event the application raises
+func IsKnown(name string) bool {
+	for _, known := range Names {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ProjectCreated is raised when a project and its OCR project slots are created
+type ProjectCreated struct {
+	ProjectID     int    `json:"projectId"`
+	TenantID      *int   `json:"tenantId,omitempty"`
+	ProjectCode   string `json:"projectCode"`
+	ProjectName   string `json:"projectName"`
+	GroupID       *int   `json:"groupId,omitempty"`
+	OcrProjectIDs []int  `json:"ocrProjectIds"`
+	UserID        int    `json:"userId"`
+}
+
+func (e ProjectCreated) EventName() string   { return ProjectCreatedName }
+func (e ProjectCreated) EventTenantID() *int { return e.TenantID }
+
+// ProjectUpdated is raised when the fields of a project are edited
+type ProjectUpdated struct {
+	ProjectID   int    `json:"projectId"`
+	TenantID    *int   `json:"tenantId,omitempty"`
+	ProjectCode string `json:"projectCode"`
+	ProjectName string `json:"projectName"`
+	GroupID     *int   `json:"groupId,omitempty"`
+	UserID      int    `json:"userId"`
+}
+
+func (e ProjectUpdated) EventName() string   { return ProjectUpdatedName }
+func (e ProjectUpdated) EventTenantID() *int { return e.TenantID }
+
+// ProjectSoftDeleted is raised when a project is moved to the recycle bin
+type ProjectSoftDeleted struct {
+	ProjectID   int    `json:"projectId"`
+	TenantID    *int   `json:"tenantId,omitempty"`
+	ProjectCode string `json:"projectCode"`
+	UserID      int    `json:"userId"`
+}
+
+func (e ProjectSoftDeleted) EventName() string   { return ProjectSoftDeletedName }
+func (e ProjectSoftDeleted) EventTenantID() *int { return e.TenantID }
+
+// ProjectRestored is raised when a soft-deleted project is taken back out of the recycle bin
+type ProjectRestored struct {
+	ProjectID   int    `json:"projectId"`
+	TenantID    *int   `json:"tenantId,omitempty"`
+	ProjectCode string `json:"projectCode"`
+	UserID      int    `json:"userId"`
+}
+
+func (e ProjectRestored) EventName() string   { return ProjectRestoredName }
+func (e ProjectRestored) EventTenantID() *int { return e.TenantID }
+
+// OcrProjectProcessed is raised when extracted fields are stored for an OCR project, which then
+// either waits for review or is approved right away
+type OcrProjectProcessed struct {
+	OcrProjectID   int    `json:"ocrProjectId"`
+	ProjectID      int    `json:"projectId"`
+	TenantID       *int   `json:"tenantId,omitempty"`
+	ProjectCode    string `json:"projectCode"`
+	Type           string `json:"type"`
+	Status         string `json:"status"`
+	NeedsReview    bool   `json:"needsReview"`
+	ReviewerUserID *int   `json:"reviewerUserId,omitempty"`
+}
+
+func (e OcrProjectProcessed) EventName() string   { return OcrProjectProcessedName }
+func (e OcrProjectProcessed) EventTenantID() *int { return e.TenantID }
+
+// OcrProjectApproved is raised when the values of an OCR project are approved and copied onto its
+// project, by a reviewer or automatically when every field was confident enough
+type OcrProjectApproved struct {
+	OcrProjectID     int    `json:"ocrProjectId"`
+	ProjectID        int    `json:"projectId"`
+	TenantID         *int   `json:"tenantId,omitempty"`
+	ProjectCode      string `json:"projectCode"`
+	Type             string `json:"type"`
+	ApprovedByUserID *int   `json:"approvedByUserId,omitempty"`
+}
+
+func (e OcrProjectApproved) EventName() string   { return OcrProjectApprovedName }
+func (e OcrProjectApproved) EventTenantID() *int { return e.TenantID }
+
+// Recorder collects the events an entity raises until the service saving it publishes them
+type Recorder struct {
+	pending []Event
+}
+
+// RecordEvent queues an event
+func (r *Recorder) RecordEvent(event Event) {
+	r.pending = append(r.pending, event)
+}
+
+// PullEvents returns the queued events and forgets them
+func (r *Recorder) PullEvents() []Event {
+	pending := r.pending
+	r.pending = nil
+	return pending
+}
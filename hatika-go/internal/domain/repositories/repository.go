@@ -1,21 +1,27 @@
 package repositories
 
-import "context"
+import (
+	"context"
+
+	"hatika-go/internal/domain/specifications"
+)
 
 // IRepository is the base repository interface
 type IRepository[T any, ID comparable] interface {
 	// Query
 	GetByID(ctx context.Context, id ID) (*T, error)
 	GetAll(ctx context.Context) ([]T, error)
-	GetPaged(ctx context.Context, pageNumber, pageSize int) ([]T, int64, error)
-	Find(ctx context.Context, condition interface{}, args ...interface{}) ([]T, error)
-	FirstOrDefault(ctx context.Context, condition interface{}, args ...interface{}) (*T, error)
+	GetPaged(ctx context.Context, pageNumber, pageSize int, sorting string, spec specifications.Specification) ([]T, int64, error)
+	Find(ctx context.Context, spec specifications.Specification) ([]T, error)
+	FirstOrDefault(ctx context.Context, spec specifications.Specification) (*T, error)
 	Count(ctx context.Context) (int64, error)
+	ParseFilter(expression string) (specifications.Specification, error)
 	
 	// Command
 	Insert(ctx context.Context, entity *T) error
 	InsertMany(ctx context.Context, entities []T) error
 	Update(ctx context.Context, entity *T) error
+	UpdateColumns(ctx context.Context, entity *T, columns ...string) error
 	Delete(ctx context.Context, id ID) error
 	SoftDelete(ctx context.Context, id ID, userID int) error
 }
@@ -23,7 +29,7 @@ type IRepository[T any, ID comparable] interface {
 // IProjectRepository extends base repository with project-specific methods
 type IProjectRepository interface {
 	IRepository[interface{}, int]
-	GetAllIncludingOcrProjects(ctx context.Context, pageNumber, pageSize int, filters map[string]interface{}) ([]interface{}, int64, error)
+	GetAllIncludingOcrProjects(ctx context.Context, pageNumber, pageSize int, sorting string, spec specifications.Specification) ([]interface{}, int64, error)
 	GetByIDIncludingOcrProjects(ctx context.Context, id int) (interface{}, error)
 }
 
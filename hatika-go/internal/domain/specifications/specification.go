@@ -0,0 +1,139 @@
+package specifications
+
+// Specification is a typed, composable filter. Fields are the JSON names of the entity's DTO;
+// each repository translates them to columns through its own whitelist.
+type Specification interface {
+	isSpecification()
+}
+
+// EqualsSpec matches rows whose field equals Value; a nil Value matches NULL
+type EqualsSpec struct {
+	Field string
+	Value interface{}
+}
+
+// Operator is a comparison operator of a CompareSpec
+type Operator string
+
+const (
+	OpNotEquals      Operator = "<>"
+	OpGreaterThan    Operator = ">"
+	OpGreaterOrEqual Operator = ">="
+	OpLessThan       Operator = "<"
+	OpLessOrEqual    Operator = "<="
+)
+
+// CompareSpec matches rows whose field compares to Value with Operator
+type CompareSpec struct {
+	Field    string
+	Operator Operator
+	Value    interface{}
+}
+
+// ContainsSpec matches rows whose text field contains Value, ignoring case
+type ContainsSpec struct {
+	Field string
+	Value string
+}
+
+// InSpec matches rows whose field is one of Values; an empty list matches nothing
+type InSpec struct {
+	Field  string
+	Values []interface{}
+}
+
+// RangeSpec matches rows whose field lies between From and To, both inclusive; a nil bound is open
+type RangeSpec struct {
+	Field string
+	From  interface{}
+	To    interface{}
+}
+
+// AndSpec matches rows matching every Spec; an empty list matches everything
+type AndSpec struct {
+	Specs []Specification
+}
+
+// OrSpec matches rows matching at least one Spec; an empty list matches nothing
+type OrSpec struct {
+	Specs []Specification
+}
+
+// NotSpec matches rows not matching Spec
+type NotSpec struct {
+	Spec Specification
+}
+
+func (EqualsSpec) isSpecification()   {}
+func (CompareSpec) isSpecification()  {}
+func (ContainsSpec) isSpecification() {}
+func (InSpec) isSpecification()       {}
+func (RangeSpec) isSpecification()    {}
+func (AndSpec) isSpecification()      {}
+func (OrSpec) isSpecification()       {}
+func (NotSpec) isSpecification()      {}
+
+func Equals(field string, value interface{}) Specification {
+	return EqualsSpec{Field: field, Value: value}
+}
+
+// NotEquals matches rows whose field differs from value; a nil value matches non-NULL rows
+func NotEquals(field string, value interface{}) Specification {
+	return CompareSpec{Field: field, Operator: OpNotEquals, Value: value}
+}
+
+func GreaterThan(field string, value interface{}) Specification {
+	return CompareSpec{Field: field, Operator: OpGreaterThan, Value: value}
+}
+
+func GreaterOrEqual(field string, value interface{}) Specification {
+	return CompareSpec{Field: field, Operator: OpGreaterOrEqual, Value: value}
+}
+
+func LessThan(field string, value interface{}) Specification {
+	return CompareSpec{Field: field, Operator: OpLessThan, Value: value}
+}
+
+func LessOrEqual(field string, value interface{}) Specification {
+	return CompareSpec{Field: field, Operator: OpLessOrEqual, Value: value}
+}
+
+func Contains(field string, value string) Specification {
+	return ContainsSpec{Field: field, Value: value}
+}
+
+func In[T any](field string, values ...T) Specification {
+	spec := InSpec{Field: field, Values: make([]interface{}, len(values))}
+	for i, value := range values {
+		spec.Values[i] = value
+	}
+	return spec
+}
+
+func Range(field string, from, to interface{}) Specification {
+	return RangeSpec{Field: field, From: from, To: to}
+}
+
+// And combines specs; nil specs are skipped so optional filters can be passed directly
+func And(specs ...Specification) Specification {
+	return AndSpec{Specs: compact(specs)}
+}
+
+// Or combines specs; nil specs are skipped
+func Or(specs ...Specification) Specification {
+	return OrSpec{Specs: compact(specs)}
+}
+
+func Not(spec Specification) Specification {
+	return NotSpec{Spec: spec}
+}
+
+func compact(specs []Specification) []Specification {
+	result := make([]Specification, 0, len(specs))
+	for _, spec := range specs {
+		if spec != nil {
+			result = append(result, spec)
+		}
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+package valueobjects
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Largest block and parcel number accepted; land registry numbers have at most five digits
+const maxParcelNumber = 99999
+
+// Ada is the block number of a land registry parcel. Ada 0 is used for parcels outside any block,
+// as in many village registries.
+type Ada int
+
+// NewAda validates a block number
+func NewAda(n int) (Ada, error) {
+	if n < 0 || n > maxParcelNumber {
+		return 0, fmt.Errorf("ada must be between 0 and %d, got %d", maxParcelNumber, n)
+	}
+	return Ada(n), nil
+}
+
+// ParseAda reads a block number
+func ParseAda(s string) (Ada, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("ada must be a whole number, got %q", s)
+	}
+	return NewAda(n)
+}
+
+// UnmarshalJSON rejects block numbers out of range
+func (a *Ada) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("ada must be a whole number: %w", err)
+	}
+	parsed, err := NewAda(n)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// Parsel is the parcel number within a block; it starts at 1
+type Parsel int
+
+// NewParsel validates a parcel number
+func NewParsel(n int) (Parsel, error) {
+	if n < 1 || n > maxParcelNumber {
+		return 0, fmt.Errorf("parsel must be between 1 and %d, got %d", maxParcelNumber, n)
+	}
+	return Parsel(n), nil
+}
+
+// ParseParsel reads a parcel number
+func ParseParsel(s string) (Parsel, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("parsel must be a whole number, got %q", s)
+	}
+	return NewParsel(n)
+}
+
+// UnmarshalJSON rejects parcel numbers out of range
+func (p *Parsel) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("parsel must be a whole number: %w", err)
+	}
+	parsed, err := NewParsel(n)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
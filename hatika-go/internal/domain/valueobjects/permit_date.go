@@ -0,0 +1,160 @@
+package valueobjects
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const permitDateLayout = "2006-01-02"
+
+// Permit dates outside this range are treated as typos or misread documents
+const (
+	minPermitYear = 1900
+	maxPermitYear = 2100
+)
+
+// permitDateLayouts are accepted when parsing, ISO first, then the Turkish day-first forms
+var permitDateLayouts = []string{
+	permitDateLayout,
+	"2.1.2006",
+	"2/1/2006",
+	"2-1-2006",
+	"2 1 2006",
+	time.RFC3339,
+}
+
+// turkishMonths maps month names, folded to ASCII lower case, to their number
+var turkishMonths = map[string]string{
+	"ocak": "1", "subat": "2", "mart": "3", "nisan": "4", "mayis": "5", "haziran": "6",
+	"temmuz": "7", "agustos": "8", "eylul": "9", "ekim": "10", "kasim": "11", "aralik": "12",
+}
+
+var monthFolding = strings.NewReplacer("Ş", "s", "ş", "s", "I", "i", "ı", "i", "İ", "i", "Ğ", "g", "ğ", "g", "Ü", "u", "ü", "u")
+
+// PermitDate is the calendar date until which a building permit is valid. It has no time of day or
+// zone; it is stored in a date column and written as yyyy-MM-dd.
+type PermitDate struct {
+	t time.Time
+}
+
+// NewPermitDate creates a permit date from the calendar date of t
+func NewPermitDate(t time.Time) (PermitDate, error) {
+	d := PermitDate{t: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+	if year := d.t.Year(); year < minPermitYear || year > maxPermitYear {
+		return PermitDate{}, fmt.Errorf("permit date %s is out of range, the year must be between %d and %d", d, minPermitYear, maxPermitYear)
+	}
+	return d, nil
+}
+
+// ParsePermitDate reads yyyy-MM-dd, the Turkish dd.MM.yyyy form with '.', '/' or '-' separators,
+// or a date with a Turkish month name such as "31 Aralık 2025"
+func ParsePermitDate(s string) (PermitDate, error) {
+	s = strings.TrimSpace(s)
+	value := s
+	if fields := strings.Fields(s); len(fields) == 3 {
+		if month, ok := turkishMonths[strings.ToLower(monthFolding.Replace(fields[1]))]; ok {
+			value = fields[0] + " " + month + " " + fields[2]
+		}
+	}
+
+	for _, layout := range permitDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return NewPermitDate(t)
+		}
+	}
+	return PermitDate{}, fmt.Errorf("%q is not a valid date, expected yyyy-MM-dd or dd.MM.yyyy", s)
+}
+
+// Time returns the date at midnight UTC
+func (d PermitDate) Time() time.Time {
+	return d.t
+}
+
+// Before reports whether d is earlier than other
+func (d PermitDate) Before(other PermitDate) bool {
+	return d.t.Before(other.t)
+}
+
+// AddDays returns the date n calendar days later, or earlier for a negative n
+func (d PermitDate) AddDays(n int) PermitDate {
+	return PermitDate{t: d.t.AddDate(0, 0, n)}
+}
+
+// DaysUntil returns the number of calendar days from d to other, negative when other is earlier
+func (d PermitDate) DaysUntil(other PermitDate) int {
+	return int(other.t.Sub(d.t).Hours() / 24)
+}
+
+// String formats the date as yyyy-MM-dd
+func (d PermitDate) String() string {
+	return d.t.Format(permitDateLayout)
+}
+
+// MarshalJSON writes the date as a yyyy-MM-dd string
+func (d PermitDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts every form ParsePermitDate does
+func (d *PermitDate) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("ruhsatGecerlilikDate must be a date string: %w", err)
+	}
+	parsed, err := ParsePermitDate(s)
+	if err != nil {
+		return fmt.Errorf("ruhsatGecerlilikDate: %w", err)
+	}
+	*d = parsed
+	return nil
+}
+
+// UnmarshalText lets filter expressions and form values use the same formats
+func (d *PermitDate) UnmarshalText(text []byte) error {
+	parsed, err := ParsePermitDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// GormDataType stores permit dates in a date column
+func (PermitDate) GormDataType() string {
+	return "date"
+}
+
+// Value implements driver.Valuer
+func (d PermitDate) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner for date columns
+func (d *PermitDate) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case time.Time:
+		*d = PermitDate{t: time.Date(v.Year(), v.Month(), v.Day(), 0, 0, 0, 0, time.UTC)}
+		return nil
+	case string:
+		return d.scanText(v)
+	case []byte:
+		return d.scanText(string(v))
+	}
+	return fmt.Errorf("cannot scan %T into a permit date", value)
+}
+
+func (d *PermitDate) scanText(s string) error {
+	// Drivers without a date type return the stored text, possibly with a zero time of day
+	if len(s) > len(permitDateLayout) {
+		s = s[:len(permitDateLayout)]
+	}
+	t, err := time.Parse(permitDateLayout, s)
+	if err != nil {
+		return fmt.Errorf("cannot scan %q into a permit date", s)
+	}
+	*d = PermitDate{t: t}
+	return nil
+}
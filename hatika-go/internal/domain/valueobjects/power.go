@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Power is an electrical power in kW, such as the requested (talep) or installed (kurulu) power of a building
+type Power int
+
+// NewPower validates a power value
+func NewPower(kw int) (Power, error) {
+	if kw < 0 {
+		return 0, fmt.Errorf("power must not be negative, got %d kW", kw)
+	}
+	return Power(kw), nil
+}
+
+// ParsePower reads a power value in kW
+func ParsePower(s string) (Power, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("power must be a whole number of kW, got %q", s)
+	}
+	return NewPower(n)
+}
+
+// UnmarshalJSON rejects negative power values
+func (p *Power) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("power must be a whole number of kW: %w", err)
+	}
+	parsed, err := NewPower(n)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
@@ -8,25 +8,43 @@ import (
 
 // Config holds application configuration
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
+	Server    ServerConfig
+	Database  DatabaseConfig
+	JWT       JWTConfig
+	Ocr       OcrConfig
+	Storage   StorageConfig
+	Import    ImportConfig
+	Export    ExportConfig
+	Bulk      BulkConfig
+	Scheduler SchedulerConfig
+	Events    EventsConfig
 }
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
 	Port int
 	Host string
+	// UnitOfWork runs every POST, PUT, PATCH and DELETE request in one database transaction
+	UnitOfWork bool `mapstructure:"unit_of_work"`
 }
 
 // DatabaseConfig holds database configuration
 type DatabaseConfig struct {
-	Host     string
-	Port     int
-	User     string
-	Password string
-	DBName   string
-	SSLMode  string
+	Host       string
+	Port       int
+	User       string
+	Password   string
+	DBName     string
+	SSLMode    string
+	Migrations MigrationsConfig
+}
+
+// MigrationsConfig holds schema migration configuration. OnPending decides what the server does when
+// the database lacks migrations compiled into the binary: "fail" refuses to start, "apply" applies
+// them, "warn" logs and starts anyway. Dir is where `migrate create` writes new scripts.
+type MigrationsConfig struct {
+	OnPending string `mapstructure:"on_pending"`
+	Dir       string `mapstructure:"dir"`
 }
 
 type JWTConfig struct {
@@ -34,6 +52,145 @@ type JWTConfig struct {
 	TokenExpirationHours int
 }
 
+// OcrConfig holds OCR pipeline configuration
+type OcrConfig struct {
+	Review          OcrReviewConfig
+	DefaultEngine   string                   `mapstructure:"default_engine"`
+	Engines         []OcrEngineConfig        `mapstructure:"engines"`
+	TextRecognition OcrTextRecognitionConfig `mapstructure:"text_recognition"`
+}
+
+// OcrReviewConfig holds the confidence thresholds below which extracted fields need human review
+type OcrReviewConfig struct {
+	DefaultThreshold float64            `mapstructure:"default_threshold"`
+	FieldThresholds  map[string]float64 `mapstructure:"field_thresholds"`
+}
+
+// OcrEngineConfig describes an extraction engine reachable over HTTP
+type OcrEngineConfig struct {
+	Name           string `mapstructure:"name"`
+	Version        string `mapstructure:"version"`
+	URL            string `mapstructure:"url"`
+	TimeoutSeconds int    `mapstructure:"timeout_seconds"`
+}
+
+// OcrTextRecognitionConfig describes the HTTP service reading the text of scanned pages of uploaded
+// PDFs. Without a URL, uploads with pages that have no text layer are rejected.
+type OcrTextRecognitionConfig struct {
+	URL            string `mapstructure:"url"`
+	TimeoutSeconds int    `mapstructure:"timeout_seconds"`
+}
+
+// StorageConfig holds uploaded file storage configuration
+type StorageConfig struct {
+	RootPath        string `mapstructure:"root_path"`
+	MaxUploadSizeMB int64  `mapstructure:"max_upload_size_mb"`
+}
+
+// ImportConfig holds project import configuration. HeaderAliases adds column headers, keyed by
+// CreateProjectDto field name, to the built-in English and Turkish headers.
+type ImportConfig struct {
+	MaxRows       int                 `mapstructure:"max_rows"`
+	HeaderAliases map[string][]string `mapstructure:"header_aliases"`
+}
+
+// ExportConfig holds project export configuration. PdfFontPath is a TrueType font with Turkish
+// glyphs for PDF summaries; without it the built-in Helvetica is used.
+type ExportConfig struct {
+	PdfFontPath string `mapstructure:"pdf_font_path"`
+}
+
+// BulkConfig holds project bulk operation configuration. Selections of up to SyncLimit projects run
+// in the request's transaction; larger ones run in the background in chunks of ChunkSize, each in its
+// own transaction. MaxItems caps the size of a selection. A background operation that saved no
+// progress for StaleAfterMinutes is taken to have lost its server and is failed.
+type BulkConfig struct {
+	SyncLimit         int `mapstructure:"sync_limit"`
+	ChunkSize         int `mapstructure:"chunk_size"`
+	MaxItems          int `mapstructure:"max_items"`
+	StaleAfterMinutes int `mapstructure:"stale_after_minutes"`
+}
+
+// SchedulerConfig holds the in-process job scheduler configuration. Schedules are five-field cron
+// expressions evaluated in Timezone.
+type SchedulerConfig struct {
+	Enabled      bool               `mapstructure:"enabled"`
+	Timezone     string             `mapstructure:"timezone"`
+	PermitExpiry PermitExpiryConfig `mapstructure:"permit_expiry"`
+	ProjectPurge ProjectPurgeConfig `mapstructure:"project_purge"`
+	BulkSweep    BulkSweepConfig    `mapstructure:"bulk_sweep"`
+}
+
+// PermitExpiryConfig holds the permit expiry reminder job configuration. A reminder is sent once per
+// window when a permit comes within that many days of expiring.
+type PermitExpiryConfig struct {
+	Schedule    string `mapstructure:"schedule"`
+	WindowsDays []int  `mapstructure:"windows_days"`
+}
+
+// ProjectPurgeConfig holds the job that permanently removes projects, with their OCR projects and
+// stored documents, once they have been soft-deleted for RetentionDays. Zero retention days keeps
+// deleted projects forever.
+type ProjectPurgeConfig struct {
+	Schedule      string `mapstructure:"schedule"`
+	RetentionDays int    `mapstructure:"retention_days"`
+	BatchSize     int    `mapstructure:"batch_size"`
+}
+
+// BulkSweepConfig holds the schedule of the job failing background bulk operations whose server stopped
+type BulkSweepConfig struct {
+	Schedule string `mapstructure:"schedule"`
+}
+
+// EventsConfig holds domain event configuration. Events are written to the outbox with the change that
+// raised them; the relay publishes pending messages to every enabled broker.
+type EventsConfig struct {
+	Relay    OutboxRelayConfig    `mapstructure:"relay"`
+	Nats     NatsConfig           `mapstructure:"nats"`
+	Postgres PostgresNotifyConfig `mapstructure:"postgres"`
+	Webhooks WebhooksConfig       `mapstructure:"webhooks"`
+}
+
+// OutboxRelayConfig holds the outbox relay configuration. A message failing to publish is retried
+// with exponential backoff and marked failed after MaxAttempts; published messages are deleted after
+// RetentionDays.
+type OutboxRelayConfig struct {
+	Enabled         bool `mapstructure:"enabled"`
+	IntervalSeconds int  `mapstructure:"interval_seconds"`
+	BatchSize       int  `mapstructure:"batch_size"`
+	MaxAttempts     int  `mapstructure:"max_attempts"`
+	RetentionDays   int  `mapstructure:"retention_days"`
+}
+
+// NatsConfig holds the NATS broker configuration; events go to SubjectPrefix.<EventName>
+type NatsConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	URL           string `mapstructure:"url"`
+	SubjectPrefix string `mapstructure:"subject_prefix"`
+}
+
+// PostgresNotifyConfig holds the Postgres NOTIFY broker configuration, a stand-in for a real broker
+// that consumers reach with LISTEN on Channel
+type PostgresNotifyConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Channel string `mapstructure:"channel"`
+}
+
+// WebhooksConfig holds the outgoing webhook configuration. The relay turns each event into a delivery
+// per matching subscription; the delivery worker sends them, retrying failures with exponential
+// backoff until MaxAttempts dead-letters them. Succeeded deliveries are deleted after RetentionDays.
+// Endpoints on loopback and private network addresses are refused unless AllowPrivateNetworks is set,
+// e.g. for a receiver on the developer's machine.
+type WebhooksConfig struct {
+	Enabled              bool `mapstructure:"enabled"`
+	IntervalSeconds      int  `mapstructure:"interval_seconds"`
+	BatchSize            int  `mapstructure:"batch_size"`
+	MaxAttempts          int  `mapstructure:"max_attempts"`
+	TimeoutSeconds       int  `mapstructure:"timeout_seconds"`
+	RetentionDays        int  `mapstructure:"retention_days"`
+	AllowPrivateNetworks bool `mapstructure:"allow_private_networks"`
+}
+
 func LoadConfig(configPath string) (*Config, error) {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
@@ -46,14 +203,53 @@ func LoadConfig(configPath string) (*Config, error) {
 
 	viper.SetDefault("server.port", 8080)
 	viper.SetDefault("server.host", "0.0.0.0")
+	viper.SetDefault("server.unit_of_work", true)
 	viper.SetDefault("database.host", "localhost")
 	viper.SetDefault("database.port", 5433)
 	viper.SetDefault("database.user", "postgres")
 	viper.SetDefault("database.password", "postgres")
 	viper.SetDefault("database.dbname", "hatikago")
 	viper.SetDefault("database.sslmode", "disable")
+	viper.SetDefault("database.migrations.on_pending", "fail")
+	viper.SetDefault("database.migrations.dir", "internal/infrastructure/migrations/sql")
 	viper.SetDefault("jwt.secret_key", "your-secret-key-change-in-production")
 	viper.SetDefault("jwt.token_expiration_hours", 24)
+	viper.SetDefault("ocr.review.default_threshold", 0.85)
+	viper.SetDefault("ocr.text_recognition.url", "")
+	viper.SetDefault("ocr.text_recognition.timeout_seconds", 120)
+	viper.SetDefault("storage.root_path", "./data/uploads")
+	viper.SetDefault("storage.max_upload_size_mb", 50)
+	viper.SetDefault("import.max_rows", 10000)
+	viper.SetDefault("export.pdf_font_path", "")
+	viper.SetDefault("bulk.sync_limit", 100)
+	viper.SetDefault("bulk.chunk_size", 100)
+	viper.SetDefault("bulk.max_items", 10000)
+	viper.SetDefault("bulk.stale_after_minutes", 10)
+	viper.SetDefault("scheduler.enabled", true)
+	viper.SetDefault("scheduler.timezone", "Europe/Istanbul")
+	viper.SetDefault("scheduler.permit_expiry.schedule", "0 7 * * *")
+	viper.SetDefault("scheduler.permit_expiry.windows_days", []int{90, 30, 7})
+	viper.SetDefault("scheduler.project_purge.schedule", "30 3 * * *")
+	viper.SetDefault("scheduler.project_purge.retention_days", 90)
+	viper.SetDefault("scheduler.project_purge.batch_size", 100)
+	viper.SetDefault("scheduler.bulk_sweep.schedule", "*/5 * * * *")
+	viper.SetDefault("events.relay.enabled", true)
+	viper.SetDefault("events.relay.interval_seconds", 2)
+	viper.SetDefault("events.relay.batch_size", 100)
+	viper.SetDefault("events.relay.max_attempts", 10)
+	viper.SetDefault("events.relay.retention_days", 7)
+	viper.SetDefault("events.nats.enabled", false)
+	viper.SetDefault("events.nats.url", "nats://localhost:4222")
+	viper.SetDefault("events.nats.subject_prefix", "hatikago.events")
+	viper.SetDefault("events.postgres.enabled", true)
+	viper.SetDefault("events.postgres.channel", "hatikago_events")
+	viper.SetDefault("events.webhooks.enabled", true)
+	viper.SetDefault("events.webhooks.interval_seconds", 5)
+	viper.SetDefault("events.webhooks.batch_size", 20)
+	viper.SetDefault("events.webhooks.max_attempts", 8)
+	viper.SetDefault("events.webhooks.timeout_seconds", 10)
+	viper.SetDefault("events.webhooks.retention_days", 30)
+	viper.SetDefault("events.webhooks.allow_private_networks", false)
 
 	if err := viper.ReadInConfig(); err != nil {
 		log.Printf("Warning: Config file not found, using defaults and environment variables: %v", err)
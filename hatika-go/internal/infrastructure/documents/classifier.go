@@ -0,0 +1,19 @@
+package documents
+
+import (
+	"context"
+
+	"hatika-go/internal/domain/entities"
+)
+
+// Classification is the document type detected for a page
+type Classification struct {
+	Type       entities.OcrProjectType
+	Confidence float64
+	Matched    bool
+}
+
+// Classifier detects which document type a page belongs to
+type Classifier interface {
+	Classify(ctx context.Context, page Page) (Classification, error)
+}
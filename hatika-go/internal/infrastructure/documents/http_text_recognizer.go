@@ -0,0 +1,79 @@
+package documents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultHTTPTextRecognizerTimeout = 2 * time.Minute
+
+// HTTPTextRecognizer calls a text recognition service that accepts {"pdf": <base64>, "pages": [...]},
+// rasterizes the listed pages and answers with {"pages": [{"number": ..., "text": ...}]}
+type HTTPTextRecognizer struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPTextRecognizer creates a recognizer backed by an HTTP text recognition service
+func NewHTTPTextRecognizer(url string, timeout time.Duration) *HTTPTextRecognizer {
+	if timeout <= 0 {
+		timeout = defaultHTTPTextRecognizerTimeout
+	}
+	return &HTTPTextRecognizer{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (r *HTTPTextRecognizer) Recognize(ctx context.Context, file io.ReaderAt, size int64, pageNumbers []int) ([]Page, error) {
+	pdf, err := io.ReadAll(io.NewSectionReader(file, 0, size))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PDF: %w", err)
+	}
+
+	body, err := json.Marshal(struct {
+		Pdf   []byte `json:"pdf"`
+		Pages []int  `json:"pages"`
+	}{Pdf: pdf, Pages: pageNumbers})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode text recognition request: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create text recognition request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("text recognition request failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return nil, fmt.Errorf("text recognition service returned %d: %s", response.StatusCode, bytes.TrimSpace(message))
+	}
+
+	var result struct {
+		Pages []struct {
+			Number int    `json:"number"`
+			Text   string `json:"text"`
+		} `json:"pages"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode text recognition response: %w", err)
+	}
+
+	pages := make([]Page, len(result.Pages))
+	for i, page := range result.Pages {
+		pages[i] = Page{Number: page.Number, Text: page.Text}
+	}
+	return pages, nil
+}
@@ -0,0 +1,30 @@
+package documents
+
+import (
+	"context"
+	"io"
+	"strings"
+)
+
+// Page is one rendered page of an uploaded document
+type Page struct {
+	Number int
+	Text   string
+}
+
+// Renderer turns a document into its pages
+type Renderer interface {
+	Render(ctx context.Context, r io.ReaderAt, size int64) ([]Page, error)
+}
+
+// PagesWithoutText returns the numbers of the pages that have no text, such as scanned pages without
+// a text layer, which cannot be classified
+func PagesWithoutText(pages []Page) []int {
+	var numbers []int
+	for _, page := range pages {
+		if strings.TrimSpace(page.Text) == "" {
+			numbers = append(numbers, page.Number)
+		}
+	}
+	return numbers
+}
@@ -0,0 +1,53 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// PdfTextRenderer reads the text layer of each PDF page. Scanned pages without a text layer come
+// back empty; wrap it in a RecognizingRenderer to have them read from their images.
+type PdfTextRenderer struct{}
+
+// NewPdfTextRenderer creates a new PDF text renderer
+func NewPdfTextRenderer() *PdfTextRenderer {
+	return &PdfTextRenderer{}
+}
+
+func (r *PdfTextRenderer) Render(ctx context.Context, file io.ReaderAt, size int64) (pages []Page, err error) {
+	// The PDF parser panics on some malformed files
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			pages = nil
+			err = fmt.Errorf("failed to read PDF: %v", recovered)
+		}
+	}()
+
+	reader, err := pdf.NewReader(file, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PDF: %w", err)
+	}
+
+	pageCount := reader.NumPage()
+	pages = make([]Page, 0, pageCount)
+	for number := 1; number <= pageCount; number++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		page := Page{Number: number}
+		pdfPage := reader.Page(number)
+		if !pdfPage.V.IsNull() {
+			text, err := pdfPage.GetPlainText(nil)
+			if err == nil {
+				page.Text = text
+			}
+		}
+		pages = append(pages, page)
+	}
+
+	return pages, nil
+}
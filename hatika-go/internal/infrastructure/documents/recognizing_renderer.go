@@ -0,0 +1,60 @@
+package documents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrTextRecognition is returned when the text recognizer could not be reached or failed
+var ErrTextRecognition = errors.New("text recognition failed")
+
+// TextRecognizer reads the text of pages from their images, for scanned pages without a text layer
+type TextRecognizer interface {
+	Recognize(ctx context.Context, r io.ReaderAt, size int64, pageNumbers []int) ([]Page, error)
+}
+
+// RecognizingRenderer renders with another renderer and has the pages that came back without text
+// read by a text recognizer, so scanned PDFs can be classified and extracted like text PDFs
+type RecognizingRenderer struct {
+	renderer   Renderer
+	recognizer TextRecognizer
+}
+
+// NewRecognizingRenderer creates a renderer falling back to recognizer for pages without text
+func NewRecognizingRenderer(renderer Renderer, recognizer TextRecognizer) *RecognizingRenderer {
+	return &RecognizingRenderer{
+		renderer:   renderer,
+		recognizer: recognizer,
+	}
+}
+
+func (r *RecognizingRenderer) Render(ctx context.Context, file io.ReaderAt, size int64) ([]Page, error) {
+	pages, err := r.renderer.Render(ctx, file, size)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := PagesWithoutText(pages)
+	if len(missing) == 0 {
+		return pages, nil
+	}
+
+	recognized, err := r.recognizer.Recognize(ctx, file, size, missing)
+	if err != nil {
+		return nil, fmt.Errorf("%w for pages %v: %v", ErrTextRecognition, missing, err)
+	}
+
+	texts := make(map[int]string, len(recognized))
+	for _, page := range recognized {
+		texts[page.Number] = page.Text
+	}
+	for i := range pages {
+		if strings.TrimSpace(pages[i].Text) == "" {
+			pages[i].Text = texts[pages[i].Number]
+		}
+	}
+	return pages, nil
+}
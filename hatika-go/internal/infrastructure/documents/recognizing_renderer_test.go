@@ -0,0 +1,72 @@
+package documents_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"hatika-go/internal/infrastructure/documents"
+)
+
+type stubRenderer []documents.Page
+
+func (r stubRenderer) Render(ctx context.Context, file io.ReaderAt, size int64) ([]documents.Page, error) {
+	return append([]documents.Page(nil), r...), nil
+}
+
+func TestRecognizingRendererReadsPagesWithoutText(t *testing.T) {
+	pdf := "%PDF-1.4 scanned"
+	var requested struct {
+		Pdf   []byte `json:"pdf"`
+		Pages []int  `json:"pages"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&requested); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"pages": [{"number": 2, "text": "TAPU SENEDİ"}]}`))
+	}))
+	defer server.Close()
+
+	renderer := documents.NewRecognizingRenderer(
+		stubRenderer{{Number: 1, Text: "Yapı Ruhsatı"}, {Number: 2}, {Number: 3, Text: " "}},
+		documents.NewHTTPTextRecognizer(server.URL, 5*time.Second),
+	)
+	pages, err := renderer.Render(context.Background(), strings.NewReader(pdf), int64(len(pdf)))
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	if string(requested.Pdf) != pdf || len(requested.Pages) != 2 || requested.Pages[0] != 2 || requested.Pages[1] != 3 {
+		t.Errorf("recognizer got pdf %q and pages %v, want pages [2 3]", requested.Pdf, requested.Pages)
+	}
+	if pages[0].Text != "Yapı Ruhsatı" || pages[1].Text != "TAPU SENEDİ" {
+		t.Errorf("pages = %+v", pages)
+	}
+	if missing := documents.PagesWithoutText(pages); len(missing) != 1 || missing[0] != 3 {
+		t.Errorf("pages without text = %v, want [3]", missing)
+	}
+}
+
+func TestRecognizingRendererReportsRecognitionFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	renderer := documents.NewRecognizingRenderer(
+		stubRenderer{{Number: 1}},
+		documents.NewHTTPTextRecognizer(server.URL, 5*time.Second),
+	)
+	_, err := renderer.Render(context.Background(), strings.NewReader("%PDF-"), 5)
+	if !errors.Is(err, documents.ErrTextRecognition) || !strings.Contains(err.Error(), "503") {
+		t.Errorf("Render = %v, want a text recognition error", err)
+	}
+}
@@ -0,0 +1,73 @@
+package documents
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"hatika-go/internal/domain/entities"
+)
+
+// ClassificationRule matches a document type by keywords found in the page text
+type ClassificationRule struct {
+	Type     entities.OcrProjectType
+	Keywords []string
+}
+
+// RulesClassifier is the default keyword-based classifier
+type RulesClassifier struct {
+	rules []ClassificationRule
+}
+
+// NewRulesClassifier creates a classifier; without rules it uses DefaultClassificationRules
+func NewRulesClassifier(rules ...ClassificationRule) *RulesClassifier {
+	if len(rules) == 0 {
+		rules = DefaultClassificationRules()
+	}
+	return &RulesClassifier{rules: rules}
+}
+
+// DefaultClassificationRules returns the headings commonly printed on each document type.
+// On a tie the earlier rule wins, so more specific headings come first.
+func DefaultClassificationRules() []ClassificationRule {
+	return []ClassificationRule{
+		{Type: entities.YapiKullanimBelgesi, Keywords: []string{"yapı kullanma izin belgesi", "yapi kullanma izin belgesi", "yapı kullanma izni"}},
+		{Type: entities.IskanBelgesi, Keywords: []string{"iskan belgesi", "iskân belgesi", "iskan ruhsatı"}},
+		{Type: entities.YapiRuhsati, Keywords: []string{"yapı ruhsatı", "yapi ruhsati", "yapı ruhsat", "ruhsat no"}},
+		{Type: entities.Tapu, Keywords: []string{"tapu senedi", "tapu müdürlüğü", "tapu sicil", "tapu kütüğü"}},
+		{Type: entities.ProjeAntenti, Keywords: []string{"proje antet", "mimari proje", "vaziyet planı", "proje müellifi"}},
+	}
+}
+
+// Classify picks the rule with the most keyword hits; confidence grows with the number of hits
+func (c *RulesClassifier) Classify(ctx context.Context, page Page) (Classification, error) {
+	text := normalizeText(page.Text)
+	if text == "" {
+		return Classification{}, nil
+	}
+
+	best := Classification{}
+	bestHits := 0
+	for _, rule := range c.rules {
+		hits := 0
+		for _, keyword := range rule.Keywords {
+			if strings.Contains(text, normalizeText(keyword)) {
+				hits++
+			}
+		}
+		if hits > bestHits {
+			bestHits = hits
+			best = Classification{Type: rule.Type, Matched: true}
+		}
+	}
+
+	if best.Matched {
+		best.Confidence = 0.6 + 0.4*float64(bestHits-1)/float64(bestHits)
+	}
+	return best, nil
+}
+
+// normalizeText lowercases with Turkish rules and collapses whitespace
+func normalizeText(text string) string {
+	return strings.Join(strings.Fields(strings.ToLowerSpecial(unicode.TurkishCase, text)), " ")
+}
@@ -0,0 +1,50 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+)
+
+// continuationConfidenceFactor lowers the confidence of pages that only follow a classified page
+const continuationConfidenceFactor = 0.5
+
+// PageAssignment is the classification result of one page
+type PageAssignment struct {
+	Page       Page
+	Type       *entities.OcrProjectType
+	Confidence float64
+}
+
+// ClassifyPages classifies every page. A page without a match continues the document of the
+// page before it, since only the first page of a document usually carries its heading.
+func ClassifyPages(ctx context.Context, classifier Classifier, pages []Page) ([]PageAssignment, error) {
+	assignments := make([]PageAssignment, len(pages))
+	var currentType *entities.OcrProjectType
+	var currentConfidence float64
+
+	for i, page := range pages {
+		classification, err := classifier.Classify(ctx, page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to classify page %d: %w", page.Number, err)
+		}
+
+		assignment := PageAssignment{Page: page}
+		switch {
+		case classification.Matched:
+			detectedType := classification.Type
+			currentType = &detectedType
+			currentConfidence = classification.Confidence
+			assignment.Type = currentType
+			assignment.Confidence = currentConfidence
+		case currentType != nil:
+			assignment.Type = currentType
+			assignment.Confidence = currentConfidence * continuationConfidenceFactor
+		}
+
+		assignments[i] = assignment
+	}
+
+	return assignments, nil
+}
@@ -0,0 +1,127 @@
+package eventbus
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/events"
+	"hatika-go/internal/infrastructure/persistence"
+)
+
+// Handler reacts to a domain event in-process
+type Handler func(ctx context.Context, event events.Event) error
+
+// Bus records domain events in the outbox and dispatches them to in-process handlers. Raising joins
+// the unit of work of the context, so the outbox row commits or rolls back with the change that
+// raised the event; handlers run only after the commit.
+type Bus struct {
+	outboxRepo *persistence.OutboxRepository
+
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// New creates an event bus writing to the outbox
+func New(outboxRepo *persistence.OutboxRepository) *Bus {
+	return &Bus{
+		outboxRepo: outboxRepo,
+		handlers:   make(map[string][]Handler),
+	}
+}
+
+// Subscribe registers a handler for the events with the name
+func (b *Bus) Subscribe(name string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+// Subscribe registers a handler for one event type
+func Subscribe[E events.Event](b *Bus, handler func(ctx context.Context, event E) error) {
+	var zero E
+	b.Subscribe(zero.EventName(), func(ctx context.Context, event events.Event) error {
+		typed, ok := event.(E)
+		if !ok {
+			return fmt.Errorf("unexpected event type %T for %s", event, zero.EventName())
+		}
+		return handler(ctx, typed)
+	})
+}
+
+// Raise writes the events to the outbox and queues their dispatch for after the commit. Outside a
+// unit of work each event is stored on its own and dispatched right away.
+func (b *Bus) Raise(ctx context.Context, raised ...events.Event) error {
+	for _, event := range raised {
+		message, err := newOutboxMessage(event)
+		if err != nil {
+			return err
+		}
+		if err := b.outboxRepo.Insert(ctx, message); err != nil {
+			return fmt.Errorf("failed to store %s event: %w", event.EventName(), err)
+		}
+
+		event := event
+		persistence.AfterCommit(ctx, func() {
+			b.dispatch(persistence.WithoutUnitOfWork(context.WithoutCancel(ctx)), event)
+		})
+	}
+	return nil
+}
+
+// dispatch runs the handlers of an event one after another. A failing handler is logged and does not
+// stop the others; the event is committed already and brokers get it from the outbox regardless.
+func (b *Bus) dispatch(ctx context.Context, event events.Event) {
+	b.mu.RLock()
+	handlers := b.handlers[event.EventName()]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		func() {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					log.Printf("Handler of %s event panicked: %v", event.EventName(), recovered)
+				}
+			}()
+			if err := handler(ctx, event); err != nil {
+				log.Printf("Handler of %s event failed: %v", event.EventName(), err)
+			}
+		}()
+	}
+}
+
+func newOutboxMessage(event events.Event) (*entities.OutboxMessage, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s event: %w", event.EventName(), err)
+	}
+	eventID, err := newEventID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &entities.OutboxMessage{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: event.EventTenantID()},
+		EventID:           eventID,
+		EventName:         event.EventName(),
+		Payload:           string(payload),
+		OccurredAt:        now,
+		Status:            entities.OutboxMessageStatusPending,
+		NextAttemptAt:     now,
+	}, nil
+}
+
+func newEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate event ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,58 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"hatika-go/internal/infrastructure/config"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsFlushTimeout bounds the wait for the server to acknowledge a publish
+const natsFlushTimeout = 5 * time.Second
+
+// NatsPublisher publishes messages to NATS on <subject prefix>.<event name>. The event ID goes in the
+// Nats-Msg-Id header, which JetStream streams use to drop duplicates.
+type NatsPublisher struct {
+	conn          *nats.Conn
+	subjectPrefix string
+}
+
+// NewNatsPublisher connects to NATS; the connection reconnects on its own after it is established
+func NewNatsPublisher(natsConfig config.NatsConfig) (*NatsPublisher, error) {
+	conn, err := nats.Connect(natsConfig.URL, nats.Name("hatikago-api"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS at %s: %w", natsConfig.URL, err)
+	}
+	return &NatsPublisher{conn: conn, subjectPrefix: natsConfig.SubjectPrefix}, nil
+}
+
+func (p *NatsPublisher) Name() string {
+	return "nats"
+}
+
+// Publish sends the message and waits until the server has it
+func (p *NatsPublisher) Publish(ctx context.Context, message *Message) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(p.subjectPrefix + "." + message.Name)
+	msg.Header.Set(nats.MsgIdHdr, message.ID)
+	msg.Data = data
+	if err := p.conn.PublishMsg(msg); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, natsFlushTimeout)
+	defer cancel()
+	return p.conn.FlushWithContext(ctx)
+}
+
+func (p *NatsPublisher) Close() {
+	p.conn.Close()
+}
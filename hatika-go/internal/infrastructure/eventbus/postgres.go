@@ -0,0 +1,49 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+
+	"hatika-go/internal/infrastructure/config"
+
+	"gorm.io/gorm"
+)
+
+// notifyPayloadLimit stays under the 8000 byte limit Postgres puts on NOTIFY payloads
+const notifyPayloadLimit = 7900
+
+// PostgresPublisher announces messages with NOTIFY, a stand-in for a broker that needs nothing
+// beyond the database. Consumers LISTEN on the channel; NOTIFY keeps nothing for consumers that are
+// not listening. Messages too large for a notification are sent without their payload, which
+// consumers read from outbox_messages by event ID.
+type PostgresPublisher struct {
+	db      *gorm.DB
+	channel string
+}
+
+// NewPostgresPublisher creates a publisher notifying on the configured channel
+func NewPostgresPublisher(db *gorm.DB, notifyConfig config.PostgresNotifyConfig) *PostgresPublisher {
+	return &PostgresPublisher{db: db, channel: notifyConfig.Channel}
+}
+
+func (p *PostgresPublisher) Name() string {
+	return "postgres"
+}
+
+func (p *PostgresPublisher) Publish(ctx context.Context, message *Message) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if len(data) > notifyPayloadLimit {
+		reference := *message
+		reference.Payload = nil
+		if data, err = json.Marshal(reference); err != nil {
+			return err
+		}
+	}
+
+	return p.db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", p.channel, string(data)).Error
+}
+
+func (p *PostgresPublisher) Close() {}
@@ -0,0 +1,180 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/persistence"
+)
+
+const (
+	// retryBaseDelay is the wait before the first retry; it doubles with every failed attempt
+	retryBaseDelay = 5 * time.Second
+	retryMaxDelay  = time.Hour
+	// pruneInterval is how often published messages past their retention are deleted
+	pruneInterval = time.Hour
+)
+
+// Message is what brokers receive for an outbox message. ID is stable across retries, so consumers
+// can drop the duplicates at-least-once delivery brings.
+type Message struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	TenantID   *int            `json:"tenantId,omitempty"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+}
+
+// Publisher delivers messages to an external broker
+type Publisher interface {
+	Name() string
+	Publish(ctx context.Context, message *Message) error
+	Close()
+}
+
+// Relay moves pending outbox messages to the brokers. Every message goes to every publisher; when
+// one of them fails the whole message is retried later, so brokers may see a message more than once
+// but never lose one.
+type Relay struct {
+	outboxRepo *persistence.OutboxRepository
+	publishers []Publisher
+	config     config.OutboxRelayConfig
+
+	cancel    context.CancelFunc
+	done      chan struct{}
+	lastPrune time.Time
+}
+
+// NewRelay creates a relay publishing to the given brokers
+func NewRelay(outboxRepo *persistence.OutboxRepository, publishers []Publisher, relayConfig config.OutboxRelayConfig) *Relay {
+	return &Relay{
+		outboxRepo: outboxRepo,
+		publishers: publishers,
+		config:     relayConfig,
+	}
+}
+
+// Start polls the outbox in the background until Stop
+func (r *Relay) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	names := make([]string, len(r.publishers))
+	for i, publisher := range r.publishers {
+		names[i] = publisher.Name()
+	}
+	log.Printf("Outbox relay publishing to %s every %ds", strings.Join(names, ", "), r.config.IntervalSeconds)
+
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(time.Duration(r.config.IntervalSeconds) * time.Second)
+		defer ticker.Stop()
+		for {
+			r.tick(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop waits for the current batch to finish and closes the publishers
+func (r *Relay) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+		<-r.done
+	}
+	for _, publisher := range r.publishers {
+		publisher.Close()
+	}
+}
+
+func (r *Relay) tick(ctx context.Context) {
+	// Drain the backlog batch by batch before waiting for the next tick
+	for ctx.Err() == nil {
+		count, err := r.RelayOnce(ctx)
+		if err != nil {
+			log.Printf("Outbox relay failed: %v", err)
+			break
+		}
+		if count < r.config.BatchSize {
+			break
+		}
+	}
+
+	if r.config.RetentionDays > 0 && time.Since(r.lastPrune) >= pruneInterval {
+		r.lastPrune = time.Now()
+		cutoff := time.Now().AddDate(0, 0, -r.config.RetentionDays)
+		if deleted, err := r.outboxRepo.DeletePublishedBefore(ctx, cutoff); err != nil {
+			log.Printf("Outbox relay failed to prune: %v", err)
+		} else if deleted > 0 {
+			log.Printf("Outbox relay pruned %d published messages", deleted)
+		}
+	}
+}
+
+// RelayOnce publishes one batch of due messages and returns how many it handled
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	return r.outboxRepo.ProcessDue(ctx, now, r.config.BatchSize, func(messages []entities.OutboxMessage) {
+		for i := range messages {
+			r.publish(ctx, &messages[i], now)
+		}
+	})
+}
+
+// publish sends a message to every publisher and records the outcome on it
+func (r *Relay) publish(ctx context.Context, outboxMessage *entities.OutboxMessage, now time.Time) {
+	message := &Message{
+		ID:         outboxMessage.EventID,
+		Name:       outboxMessage.EventName,
+		TenantID:   outboxMessage.TenantID,
+		OccurredAt: outboxMessage.OccurredAt,
+		Payload:    json.RawMessage(outboxMessage.Payload),
+	}
+
+	outboxMessage.Attempts++
+	var failures []string
+	for _, publisher := range r.publishers {
+		if err := publisher.Publish(ctx, message); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", publisher.Name(), err))
+		}
+	}
+
+	if len(failures) == 0 {
+		outboxMessage.Status = entities.OutboxMessageStatusPublished
+		outboxMessage.PublishedAt = &now
+		outboxMessage.LastError = ""
+		return
+	}
+
+	outboxMessage.LastError = strings.Join(failures, "; ")
+	if outboxMessage.Attempts >= r.config.MaxAttempts {
+		outboxMessage.Status = entities.OutboxMessageStatusFailed
+		log.Printf("Outbox message %d (%s) failed %d times and was given up: %s",
+			outboxMessage.ID, outboxMessage.EventName, outboxMessage.Attempts, outboxMessage.LastError)
+		return
+	}
+	outboxMessage.NextAttemptAt = now.Add(retryDelay(outboxMessage.Attempts))
+}
+
+// retryDelay is the exponential backoff after the given number of failed attempts
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,182 @@
+package migrations_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/migrations"
+	"hatika-go/internal/testutil"
+)
+
+// The entities as the startup AutoMigrate created them before versioned migrations replaced it
+
+type baselineAudited struct {
+	ID             int       `gorm:"primaryKey;autoIncrement"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+	CreatorUserID  *int      `gorm:"index"`
+	LastModifierID *int      `gorm:"index"`
+	DeleterUserID  *int      `gorm:"index"`
+	DeletionTime   *time.Time
+	IsDeleted      bool `gorm:"default:false;index"`
+}
+
+type baselineUser struct {
+	baselineAudited
+	TenantID             *int   `gorm:"index"`
+	Username             string `gorm:"size:256;uniqueIndex;not null"`
+	Email                string `gorm:"size:256;uniqueIndex;not null"`
+	PasswordHash         string `gorm:"size:512;not null"`
+	Name                 string `gorm:"size:64"`
+	Surname              string `gorm:"size:64"`
+	IsActive             bool   `gorm:"default:true"`
+	EmailConfirmed       bool   `gorm:"default:false"`
+	PhoneNumber          string `gorm:"size:32"`
+	PhoneNumberConfirmed bool   `gorm:"default:false"`
+	LockoutEnabled       bool   `gorm:"default:false"`
+	LockoutEndDate       *time.Time
+	AccessFailedCount    int            `gorm:"default:0"`
+	Roles                []baselineRole `gorm:"many2many:user_roles;joinForeignKey:UserID;joinReferences:RoleID"`
+}
+
+func (baselineUser) TableName() string { return "users" }
+
+type baselineRole struct {
+	baselineAudited
+	TenantID    *int                 `gorm:"index"`
+	Name        string               `gorm:"size:128;uniqueIndex;not null"`
+	DisplayName string               `gorm:"size:256;not null"`
+	Description string               `gorm:"type:text"`
+	IsStatic    bool                 `gorm:"default:false"`
+	IsDefault   bool                 `gorm:"default:false"`
+	Permissions []baselinePermission `gorm:"many2many:role_permissions;joinForeignKey:RoleID;joinReferences:PermissionID"`
+}
+
+func (baselineRole) TableName() string { return "roles" }
+
+type baselinePermission struct {
+	ID          int       `gorm:"primaryKey;autoIncrement"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	Name        string    `gorm:"size:128;uniqueIndex;not null"`
+	DisplayName string    `gorm:"size:256;not null"`
+	Description string    `gorm:"type:text"`
+}
+
+func (baselinePermission) TableName() string { return "permissions" }
+
+type baselineTenant struct {
+	baselineAudited
+	TenancyName      string `gorm:"size:128;uniqueIndex;not null"`
+	Name             string `gorm:"size:256;not null"`
+	ConnectionString string `gorm:"size:1024"`
+	IsActive         bool   `gorm:"default:true"`
+	EditionID        *int   `gorm:"index"`
+}
+
+func (baselineTenant) TableName() string { return "tenants" }
+
+type baselineDocumentFields struct {
+	ProjectComment       string `gorm:"type:text"`
+	ProjectMuellef       string `gorm:"size:255"`
+	Ada                  *int
+	Parsel               *int
+	TalepGucu            *int
+	KuruluGuc            *int
+	BagimsizBS           *int
+	BlokS                *int
+	YapiYuksekligi       *float64
+	RuhsatGecerlilikDate string `gorm:"size:50"`
+	YapiSahibi           string `gorm:"size:255"`
+	Adress               string `gorm:"type:text"`
+}
+
+type baselineProject struct {
+	baselineAudited
+	TenantID    *int   `gorm:"index"`
+	ProjectName string `gorm:"size:255;not null"`
+	ProjectCode string `gorm:"size:100;uniqueIndex"`
+	baselineDocumentFields
+	GroupID     *int                 `gorm:"index"`
+	BildirimNo  string               `gorm:"size:100"`
+	OcrProjects []baselineOcrProject `gorm:"foreignKey:ProjectID"`
+}
+
+func (baselineProject) TableName() string { return "projects" }
+
+type baselineOcrProject struct {
+	baselineAudited
+	TenantID    *int   `gorm:"index"`
+	ProjectName string `gorm:"size:255"`
+	ProjectCode string `gorm:"size:100"`
+	baselineDocumentFields
+	Type      int    `gorm:"type:int;not null"`
+	ProjectID int    `gorm:"not null;index"`
+	PdfPath   string `gorm:"size:500"`
+}
+
+func (baselineOcrProject) TableName() string { return "ocr_projects" }
+
+func TestMigrationsAdoptAutoMigrateBaseline(t *testing.T) {
+	db := testutil.NewEmptyPostgresDatabase(t)
+	ctx := context.Background()
+
+	if err := db.AutoMigrate(
+		&baselineUser{},
+		&baselineRole{},
+		&baselinePermission{},
+		&baselineTenant{},
+		&baselineProject{},
+		&baselineOcrProject{},
+	); err != nil {
+		t.Fatalf("failed to create the baseline schema: %v", err)
+	}
+	project := &baselineProject{ProjectName: "Legacy", ProjectCode: "LEGACY-1"}
+	project.RuhsatGecerlilikDate = "31.12.2025"
+	if err := db.Create(project).Error; err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	ocrProject := &baselineOcrProject{ProjectID: project.ID, Type: int(entities.Tapu)}
+	if err := db.Create(ocrProject).Error; err != nil {
+		t.Fatalf("failed to create OCR project: %v", err)
+	}
+
+	migrator, err := migrations.New(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Up on the baseline schema: %v", err)
+	}
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("%d migrations still pending", len(pending))
+	}
+
+	for _, column := range []string{"status", "reviewer_user_id", "approved_by_user_id", "approved_at", "rejection_reason", "is_optional", "version"} {
+		if !db.Migrator().HasColumn(&entities.OcrProject{}, column) {
+			t.Errorf("ocr_projects has no %s column", column)
+		}
+	}
+	for _, index := range []string{"idx_ocr_projects_status", "idx_ocr_projects_reviewer_user_id"} {
+		if !db.Migrator().HasIndex(&entities.OcrProject{}, index) {
+			t.Errorf("ocr_projects has no %s index", index)
+		}
+	}
+
+	var adopted entities.Project
+	if err := db.Preload("OcrProjects").First(&adopted, project.ID).Error; err != nil {
+		t.Fatalf("failed to load the adopted project: %v", err)
+	}
+	if adopted.RuhsatGecerlilikDate == nil || adopted.RuhsatGecerlilikDate.String() != "2025-12-31" {
+		t.Errorf("permit date = %v, want 2025-12-31", adopted.RuhsatGecerlilikDate)
+	}
+	if len(adopted.OcrProjects) != 1 || adopted.OcrProjects[0].Status != entities.OcrProjectStatusPending || adopted.OcrProjects[0].Version != 1 {
+		t.Errorf("adopted OCR projects = %+v, want one pending at version 1", adopted.OcrProjects)
+	}
+}
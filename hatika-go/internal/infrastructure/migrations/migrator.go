@@ -0,0 +1,258 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// lockKey is the advisory lock every migrator takes, "hatikago:migrate" as a 64-bit number
+const lockKey int64 = 0x6861_7469_6b61_6d67
+
+// schemaMigration records an applied migration in schema_migrations
+type schemaMigration struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"size:255;not null"`
+	Checksum  string    `gorm:"size:64;not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// State is where a migration stands against the database
+type State string
+
+const (
+	StatePending  State = "pending"
+	StateApplied  State = "applied"
+	StateModified State = "modified" // applied, but its up script changed since
+	StateMissing  State = "missing"  // applied, but no longer known to this binary
+)
+
+// Status is the state of one migration
+type Status struct {
+	Version   int64
+	Name      string
+	State     State
+	AppliedAt *time.Time
+}
+
+// Migrator applies and rolls back versioned migrations, recording them in schema_migrations. Each
+// migration runs in its own transaction unless marked otherwise. On Postgres a session advisory lock
+// serializes migrators, so replicas starting together apply every migration exactly once.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// New creates a migrator for the migrations compiled into the binary
+func New(db *gorm.DB) (*Migrator, error) {
+	migrations, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Status lists the known migrations in version order, followed by applied versions this binary
+// does not know
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for i := range m.migrations {
+		migration := &m.migrations[i]
+		status := Status{Version: migration.Version, Name: migration.Name, State: StatePending}
+		if record, ok := applied[migration.Version]; ok {
+			status.State = StateApplied
+			if record.Checksum != migration.Checksum() {
+				status.State = StateModified
+			}
+			appliedAt := record.AppliedAt
+			status.AppliedAt = &appliedAt
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, record := range sortedRecords(applied) {
+		appliedAt := record.AppliedAt
+		statuses = append(statuses, Status{Version: record.Version, Name: record.Name, State: StateMissing, AppliedAt: &appliedAt})
+	}
+	return statuses, nil
+}
+
+// Pending returns the migrations not applied yet, in version order
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies every pending migration in version order and returns how many were applied. It stops
+// at the first failure; migrations applied before it stay applied.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func() error {
+		if err := m.db.WithContext(ctx).AutoMigrate(&schemaMigration{}); err != nil {
+			return fmt.Errorf("failed to create schema_migrations: %w", err)
+		}
+		pending, err := m.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		for i := range pending {
+			migration := &pending[i]
+			started := time.Now()
+			if err := m.run(ctx, migration.Up, func(tx *gorm.DB) error {
+				return tx.Create(&schemaMigration{
+					Version:   migration.Version,
+					Name:      migration.Name,
+					Checksum:  migration.Checksum(),
+					AppliedAt: time.Now().UTC(),
+				}).Error
+			}); err != nil {
+				return fmt.Errorf("migration %06d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			count++
+			log.Printf("Applied migration %06d_%s in %s", migration.Version, migration.Name, time.Since(started).Round(time.Millisecond))
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Down rolls back the latest applied migrations, at most steps of them, and returns how many were
+// rolled back
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func() error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+		records := sortedRecords(applied)
+		for i := len(records) - 1; i >= 0 && count < steps; i-- {
+			record := records[i]
+			migration := m.find(record.Version)
+			if migration == nil {
+				return fmt.Errorf("migration %06d_%s is applied but unknown to this binary", record.Version, record.Name)
+			}
+			if !migration.HasDown() {
+				return fmt.Errorf("migration %06d_%s has no down script", migration.Version, migration.Name)
+			}
+			if err := m.run(ctx, migration.Down, func(tx *gorm.DB) error {
+				return tx.Delete(&schemaMigration{}, migration.Version).Error
+			}); err != nil {
+				return fmt.Errorf("rollback of migration %06d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			count++
+			log.Printf("Rolled back migration %06d_%s", migration.Version, migration.Name)
+		}
+		return nil
+	})
+	return count, err
+}
+
+// run executes a script and its bookkeeping, together in one transaction unless the script opts out
+func (m *Migrator) run(ctx context.Context, script string, record func(tx *gorm.DB) error) error {
+	if !runsInTransaction(script) {
+		if _, err := m.db.WithContext(ctx).Statement.ConnPool.ExecContext(ctx, script); err != nil {
+			return err
+		}
+		return record(m.db.WithContext(ctx))
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The script goes to the driver as is; gorm would treat ? and @ in it as placeholders
+		if _, err := tx.Statement.ConnPool.ExecContext(ctx, script); err != nil {
+			return err
+		}
+		return record(tx)
+	})
+}
+
+// applied returns the recorded migrations by version; none before schema_migrations exists
+func (m *Migrator) applied(ctx context.Context) (map[int64]schemaMigration, error) {
+	db := m.db.WithContext(ctx)
+	if !db.Migrator().HasTable(&schemaMigration{}) {
+		return map[int64]schemaMigration{}, nil
+	}
+
+	var records []schemaMigration
+	if err := db.Order("version ASC").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	applied := make(map[int64]schemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+func (m *Migrator) find(version int64) *Migration {
+	for i := range m.migrations {
+		if m.migrations[i].Version == version {
+			return &m.migrations[i]
+		}
+	}
+	return nil
+}
+
+// withLock runs fn while holding the migration advisory lock, waiting for other migrators to finish.
+// Session locks belong to a connection, so the lock lives on one dedicated connection of the pool.
+func (m *Migrator) withLock(ctx context.Context, fn func() error) error {
+	if m.db.Dialector.Name() != "postgres" {
+		return fn()
+	}
+
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a connection for the migration lock: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("failed to take the migration lock: %w", err)
+	}
+	defer unlock(conn)
+
+	return fn()
+}
+
+func unlock(conn *sql.Conn) {
+	if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey); err != nil {
+		log.Printf("Warning: failed to release the migration lock: %v", err)
+	}
+}
+
+func sortedRecords(applied map[int64]schemaMigration) []schemaMigration {
+	records := make([]schemaMigration, 0, len(applied))
+	for _, record := range applied {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Version < records[j].Version })
+	return records
+}
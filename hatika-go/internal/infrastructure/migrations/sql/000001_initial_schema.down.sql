@@ -0,0 +1,24 @@
+DROP TABLE IF EXISTS "permit_expiry_reminders";
+DROP TABLE IF EXISTS "notifications";
+DROP TABLE IF EXISTS "data_migration_issues";
+DROP TABLE IF EXISTS "project_import_rows";
+DROP TABLE IF EXISTS "project_imports";
+DROP TABLE IF EXISTS "ocr_run_fields";
+DROP TABLE IF EXISTS "ocr_runs";
+DROP TABLE IF EXISTS "document_pages";
+DROP TABLE IF EXISTS "project_documents";
+DROP TABLE IF EXISTS "ocr_project_template_items";
+DROP TABLE IF EXISTS "ocr_project_templates";
+DROP TABLE IF EXISTS "ocr_field_results";
+DROP TABLE IF EXISTS "ocr_projects";
+DROP TABLE IF EXISTS "projects";
+DROP TABLE IF EXISTS "tenants";
+DROP TABLE IF EXISTS "role_permissions";
+DROP TABLE IF EXISTS "permissions";
+DROP TABLE IF EXISTS "user_roles";
+DROP TABLE IF EXISTS "roles";
+DROP TABLE IF EXISTS "users";
+DROP TABLE IF EXISTS "project_group_permissions";
+DROP TABLE IF EXISTS "project_groups";
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS turkish_unaccent;
@@ -0,0 +1,474 @@
+-- Baseline schema. Every statement is guarded with IF NOT EXISTS so databases created by the former
+-- startup AutoMigrate can adopt this version. Columns added to their tables since are added before the
+-- indexes on them; the group IDs and text permit dates of databases from before project groups and typed
+-- permit dates are brought over by 000007_project_group_backfill and 000008_permit_date_values.
+
+CREATE TABLE IF NOT EXISTS "project_groups" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "creator_user_id" bigint,
+    "last_modifier_id" bigint,
+    "deleter_user_id" bigint,
+    "deletion_time" timestamptz,
+    "is_deleted" boolean DEFAULT false,
+    "tenant_id" bigint,
+    "name" varchar(128) NOT NULL,
+    "description" text,
+    "parent_id" bigint,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_project_groups_parent" FOREIGN KEY ("parent_id") REFERENCES "project_groups"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_project_groups_creator_user_id" ON "project_groups" ("creator_user_id");
+CREATE INDEX IF NOT EXISTS "idx_project_groups_deleter_user_id" ON "project_groups" ("deleter_user_id");
+CREATE INDEX IF NOT EXISTS "idx_project_groups_is_deleted" ON "project_groups" ("is_deleted");
+CREATE INDEX IF NOT EXISTS "idx_project_groups_last_modifier_id" ON "project_groups" ("last_modifier_id");
+CREATE INDEX IF NOT EXISTS "idx_project_groups_parent_id" ON "project_groups" ("parent_id");
+CREATE INDEX IF NOT EXISTS "idx_project_groups_tenant_id" ON "project_groups" ("tenant_id");
+
+CREATE TABLE IF NOT EXISTS "project_group_permissions" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "group_id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_project_group_permissions_group_user" ON "project_group_permissions" ("group_id","user_id");
+CREATE INDEX IF NOT EXISTS "idx_project_group_permissions_user_id" ON "project_group_permissions" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "users" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "creator_user_id" bigint,
+    "last_modifier_id" bigint,
+    "deleter_user_id" bigint,
+    "deletion_time" timestamptz,
+    "is_deleted" boolean DEFAULT false,
+    "tenant_id" bigint,
+    "username" varchar(256) NOT NULL,
+    "email" varchar(256) NOT NULL,
+    "password_hash" varchar(512) NOT NULL,
+    "name" varchar(64),
+    "surname" varchar(64),
+    "is_active" boolean DEFAULT true,
+    "email_confirmed" boolean DEFAULT false,
+    "phone_number" varchar(32),
+    "phone_number_confirmed" boolean DEFAULT false,
+    "lockout_enabled" boolean DEFAULT false,
+    "lockout_end_date" timestamptz,
+    "access_failed_count" bigint DEFAULT 0,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_users_creator_user_id" ON "users" ("creator_user_id");
+CREATE INDEX IF NOT EXISTS "idx_users_deleter_user_id" ON "users" ("deleter_user_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_users_email" ON "users" ("email");
+CREATE INDEX IF NOT EXISTS "idx_users_is_deleted" ON "users" ("is_deleted");
+CREATE INDEX IF NOT EXISTS "idx_users_last_modifier_id" ON "users" ("last_modifier_id");
+CREATE INDEX IF NOT EXISTS "idx_users_tenant_id" ON "users" ("tenant_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_users_username" ON "users" ("username");
+
+CREATE TABLE IF NOT EXISTS "roles" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "creator_user_id" bigint,
+    "last_modifier_id" bigint,
+    "deleter_user_id" bigint,
+    "deletion_time" timestamptz,
+    "is_deleted" boolean DEFAULT false,
+    "tenant_id" bigint,
+    "name" varchar(128) NOT NULL,
+    "display_name" varchar(256) NOT NULL,
+    "description" text,
+    "is_static" boolean DEFAULT false,
+    "is_default" boolean DEFAULT false,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_roles_creator_user_id" ON "roles" ("creator_user_id");
+CREATE INDEX IF NOT EXISTS "idx_roles_deleter_user_id" ON "roles" ("deleter_user_id");
+CREATE INDEX IF NOT EXISTS "idx_roles_is_deleted" ON "roles" ("is_deleted");
+CREATE INDEX IF NOT EXISTS "idx_roles_last_modifier_id" ON "roles" ("last_modifier_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_roles_name" ON "roles" ("name");
+CREATE INDEX IF NOT EXISTS "idx_roles_tenant_id" ON "roles" ("tenant_id");
+
+CREATE TABLE IF NOT EXISTS "user_roles" (
+    "role_id" bigint,
+    "user_id" bigint,
+    PRIMARY KEY ("role_id","user_id"),
+    CONSTRAINT "fk_user_roles_role" FOREIGN KEY ("role_id") REFERENCES "roles"("id"),
+    CONSTRAINT "fk_user_roles_user" FOREIGN KEY ("user_id") REFERENCES "users"("id")
+);
+
+CREATE TABLE IF NOT EXISTS "permissions" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "name" varchar(128) NOT NULL,
+    "display_name" varchar(256) NOT NULL,
+    "description" text,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_permissions_name" ON "permissions" ("name");
+
+CREATE TABLE IF NOT EXISTS "role_permissions" (
+    "permission_id" bigint,
+    "role_id" bigint,
+    PRIMARY KEY ("permission_id","role_id"),
+    CONSTRAINT "fk_role_permissions_permission" FOREIGN KEY ("permission_id") REFERENCES "permissions"("id"),
+    CONSTRAINT "fk_role_permissions_role" FOREIGN KEY ("role_id") REFERENCES "roles"("id")
+);
+
+CREATE TABLE IF NOT EXISTS "tenants" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "creator_user_id" bigint,
+    "last_modifier_id" bigint,
+    "deleter_user_id" bigint,
+    "deletion_time" timestamptz,
+    "is_deleted" boolean DEFAULT false,
+    "tenancy_name" varchar(128) NOT NULL,
+    "name" varchar(256) NOT NULL,
+    "connection_string" varchar(1024),
+    "is_active" boolean DEFAULT true,
+    "edition_id" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_tenants_creator_user_id" ON "tenants" ("creator_user_id");
+CREATE INDEX IF NOT EXISTS "idx_tenants_deleter_user_id" ON "tenants" ("deleter_user_id");
+CREATE INDEX IF NOT EXISTS "idx_tenants_edition_id" ON "tenants" ("edition_id");
+CREATE INDEX IF NOT EXISTS "idx_tenants_is_deleted" ON "tenants" ("is_deleted");
+CREATE INDEX IF NOT EXISTS "idx_tenants_last_modifier_id" ON "tenants" ("last_modifier_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tenants_tenancy_name" ON "tenants" ("tenancy_name");
+
+CREATE TABLE IF NOT EXISTS "projects" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "creator_user_id" bigint,
+    "last_modifier_id" bigint,
+    "deleter_user_id" bigint,
+    "deletion_time" timestamptz,
+    "is_deleted" boolean DEFAULT false,
+    "tenant_id" bigint,
+    "project_name" varchar(255) NOT NULL,
+    "project_code" varchar(100),
+    "project_comment" text,
+    "project_muellef" varchar(255),
+    "ada" bigint,
+    "parsel" bigint,
+    "talep_gucu" bigint,
+    "kurulu_guc" bigint,
+    "bagimsiz_bs" bigint,
+    "blok_s" bigint,
+    "yapi_yuksekligi" decimal,
+    "ruhsat_gecerlilik_date" date,
+    "yapi_sahibi" varchar(255),
+    "adress" text,
+    "group_id" bigint,
+    "bildirim_no" varchar(100),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_projects_group" FOREIGN KEY ("group_id") REFERENCES "project_groups"("id") ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS "idx_projects_creator_user_id" ON "projects" ("creator_user_id");
+CREATE INDEX IF NOT EXISTS "idx_projects_deleter_user_id" ON "projects" ("deleter_user_id");
+CREATE INDEX IF NOT EXISTS "idx_projects_group_id" ON "projects" ("group_id");
+CREATE INDEX IF NOT EXISTS "idx_projects_is_deleted" ON "projects" ("is_deleted");
+CREATE INDEX IF NOT EXISTS "idx_projects_last_modifier_id" ON "projects" ("last_modifier_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_projects_project_code" ON "projects" ("project_code");
+CREATE INDEX IF NOT EXISTS "idx_projects_ruhsat_gecerlilik_date" ON "projects" ("ruhsat_gecerlilik_date");
+CREATE INDEX IF NOT EXISTS "idx_projects_tenant_id" ON "projects" ("tenant_id");
+
+CREATE TABLE IF NOT EXISTS "ocr_projects" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "creator_user_id" bigint,
+    "last_modifier_id" bigint,
+    "deleter_user_id" bigint,
+    "deletion_time" timestamptz,
+    "is_deleted" boolean DEFAULT false,
+    "tenant_id" bigint,
+    "project_name" varchar(255),
+    "project_code" varchar(100),
+    "project_comment" text,
+    "project_muellef" varchar(255),
+    "ada" bigint,
+    "parsel" bigint,
+    "talep_gucu" bigint,
+    "kurulu_guc" bigint,
+    "bagimsiz_bs" bigint,
+    "blok_s" bigint,
+    "yapi_yuksekligi" decimal,
+    "ruhsat_gecerlilik_date" date,
+    "yapi_sahibi" varchar(255),
+    "adress" text,
+    "type" bigint NOT NULL,
+    "project_id" bigint NOT NULL,
+    "pdf_path" varchar(500),
+    "status" bigint NOT NULL DEFAULT 0,
+    "reviewer_user_id" bigint,
+    "approved_by_user_id" bigint,
+    "approved_at" timestamptz,
+    "rejection_reason" text,
+    "is_optional" boolean DEFAULT false,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_projects_ocr_projects" FOREIGN KEY ("project_id") REFERENCES "projects"("id")
+);
+ALTER TABLE "ocr_projects" ADD COLUMN IF NOT EXISTS "status" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "ocr_projects" ADD COLUMN IF NOT EXISTS "reviewer_user_id" bigint;
+ALTER TABLE "ocr_projects" ADD COLUMN IF NOT EXISTS "approved_by_user_id" bigint;
+ALTER TABLE "ocr_projects" ADD COLUMN IF NOT EXISTS "approved_at" timestamptz;
+ALTER TABLE "ocr_projects" ADD COLUMN IF NOT EXISTS "rejection_reason" text;
+ALTER TABLE "ocr_projects" ADD COLUMN IF NOT EXISTS "is_optional" boolean DEFAULT false;
+CREATE INDEX IF NOT EXISTS "idx_ocr_projects_creator_user_id" ON "ocr_projects" ("creator_user_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_projects_deleter_user_id" ON "ocr_projects" ("deleter_user_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_projects_is_deleted" ON "ocr_projects" ("is_deleted");
+CREATE INDEX IF NOT EXISTS "idx_ocr_projects_last_modifier_id" ON "ocr_projects" ("last_modifier_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_projects_project_id" ON "ocr_projects" ("project_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_projects_reviewer_user_id" ON "ocr_projects" ("reviewer_user_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_projects_status" ON "ocr_projects" ("status");
+CREATE INDEX IF NOT EXISTS "idx_ocr_projects_tenant_id" ON "ocr_projects" ("tenant_id");
+
+CREATE TABLE IF NOT EXISTS "ocr_field_results" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "tenant_id" bigint,
+    "ocr_project_id" bigint NOT NULL,
+    "field_name" varchar(64) NOT NULL,
+    "value" text,
+    "confidence" decimal NOT NULL DEFAULT 0,
+    "needs_review" boolean DEFAULT false,
+    "is_approved" boolean DEFAULT false,
+    "approved_value" text,
+    "approved_by_user_id" bigint,
+    "approved_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_ocr_projects_field_results" FOREIGN KEY ("ocr_project_id") REFERENCES "ocr_projects"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_ocr_field_results_needs_review" ON "ocr_field_results" ("needs_review");
+CREATE INDEX IF NOT EXISTS "idx_ocr_field_results_ocr_project_id" ON "ocr_field_results" ("ocr_project_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_field_results_tenant_id" ON "ocr_field_results" ("tenant_id");
+
+CREATE TABLE IF NOT EXISTS "ocr_project_templates" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "creator_user_id" bigint,
+    "last_modifier_id" bigint,
+    "deleter_user_id" bigint,
+    "deletion_time" timestamptz,
+    "is_deleted" boolean DEFAULT false,
+    "tenant_id" bigint,
+    "name" varchar(128) NOT NULL,
+    "group_id" bigint,
+    "code_pattern" varchar(128) NOT NULL,
+    "is_active" boolean DEFAULT true,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_ocr_project_templates_creator_user_id" ON "ocr_project_templates" ("creator_user_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_project_templates_deleter_user_id" ON "ocr_project_templates" ("deleter_user_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_project_templates_group_id" ON "ocr_project_templates" ("group_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_project_templates_is_deleted" ON "ocr_project_templates" ("is_deleted");
+CREATE INDEX IF NOT EXISTS "idx_ocr_project_templates_last_modifier_id" ON "ocr_project_templates" ("last_modifier_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_project_templates_tenant_id" ON "ocr_project_templates" ("tenant_id");
+
+CREATE TABLE IF NOT EXISTS "ocr_project_template_items" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "template_id" bigint NOT NULL,
+    "type" bigint NOT NULL,
+    "is_optional" boolean DEFAULT false,
+    "sort_order" bigint DEFAULT 0,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_ocr_project_templates_items" FOREIGN KEY ("template_id") REFERENCES "ocr_project_templates"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_ocr_project_template_items_template_id" ON "ocr_project_template_items" ("template_id");
+
+CREATE TABLE IF NOT EXISTS "project_documents" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "creator_user_id" bigint,
+    "last_modifier_id" bigint,
+    "deleter_user_id" bigint,
+    "deletion_time" timestamptz,
+    "is_deleted" boolean DEFAULT false,
+    "tenant_id" bigint,
+    "project_id" bigint NOT NULL,
+    "file_name" varchar(255) NOT NULL,
+    "storage_key" varchar(500) NOT NULL,
+    "content_type" varchar(100),
+    "size_bytes" bigint,
+    "page_count" bigint,
+    "status" bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_project_documents_creator_user_id" ON "project_documents" ("creator_user_id");
+CREATE INDEX IF NOT EXISTS "idx_project_documents_deleter_user_id" ON "project_documents" ("deleter_user_id");
+CREATE INDEX IF NOT EXISTS "idx_project_documents_is_deleted" ON "project_documents" ("is_deleted");
+CREATE INDEX IF NOT EXISTS "idx_project_documents_last_modifier_id" ON "project_documents" ("last_modifier_id");
+CREATE INDEX IF NOT EXISTS "idx_project_documents_project_id" ON "project_documents" ("project_id");
+CREATE INDEX IF NOT EXISTS "idx_project_documents_tenant_id" ON "project_documents" ("tenant_id");
+
+CREATE TABLE IF NOT EXISTS "document_pages" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "document_id" bigint NOT NULL,
+    "page_number" bigint NOT NULL,
+    "detected_type" bigint,
+    "confidence" decimal,
+    "ocr_project_id" bigint,
+    "is_manual" boolean DEFAULT false,
+    "text" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_project_documents_pages" FOREIGN KEY ("document_id") REFERENCES "project_documents"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_document_pages_document_id" ON "document_pages" ("document_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_document_pages_document_page" ON "document_pages" ("document_id","page_number");
+CREATE INDEX IF NOT EXISTS "idx_document_pages_ocr_project_id" ON "document_pages" ("ocr_project_id");
+
+CREATE TABLE IF NOT EXISTS "ocr_runs" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "tenant_id" bigint,
+    "ocr_project_id" bigint NOT NULL,
+    "engine_name" varchar(100) NOT NULL,
+    "engine_version" varchar(50) NOT NULL,
+    "batch_id" varchar(36),
+    "status" bigint NOT NULL DEFAULT 0,
+    "error" text,
+    "apply" boolean DEFAULT false,
+    "is_applied" boolean DEFAULT false,
+    "triggered_by_user_id" bigint,
+    "started_at" timestamptz,
+    "completed_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_ocr_runs_batch_id" ON "ocr_runs" ("batch_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_runs_engine" ON "ocr_runs" ("engine_name","engine_version");
+CREATE INDEX IF NOT EXISTS "idx_ocr_runs_ocr_project_id" ON "ocr_runs" ("ocr_project_id");
+CREATE INDEX IF NOT EXISTS "idx_ocr_runs_tenant_id" ON "ocr_runs" ("tenant_id");
+
+CREATE TABLE IF NOT EXISTS "ocr_run_fields" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "run_id" bigint NOT NULL,
+    "field_name" varchar(64) NOT NULL,
+    "value" text,
+    "confidence" decimal NOT NULL DEFAULT 0,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_ocr_runs_fields" FOREIGN KEY ("run_id") REFERENCES "ocr_runs"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_ocr_run_fields_run_id" ON "ocr_run_fields" ("run_id");
+
+CREATE TABLE IF NOT EXISTS "project_imports" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "tenant_id" bigint,
+    "file_name" varchar(255) NOT NULL,
+    "format" varchar(8) NOT NULL,
+    "dry_run" boolean DEFAULT false,
+    "status" bigint NOT NULL DEFAULT 0,
+    "error" text,
+    "total_rows" bigint NOT NULL DEFAULT 0,
+    "processed_rows" bigint NOT NULL DEFAULT 0,
+    "created_count" bigint NOT NULL DEFAULT 0,
+    "updated_count" bigint NOT NULL DEFAULT 0,
+    "failed_count" bigint NOT NULL DEFAULT 0,
+    "triggered_by_user_id" bigint,
+    "started_at" timestamptz,
+    "completed_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_project_imports_tenant_id" ON "project_imports" ("tenant_id");
+
+CREATE TABLE IF NOT EXISTS "project_import_rows" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "import_id" bigint NOT NULL,
+    "row_number" bigint NOT NULL,
+    "project_code" varchar(100),
+    "action" bigint NOT NULL,
+    "project_id" bigint,
+    "errors" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_project_imports_rows" FOREIGN KEY ("import_id") REFERENCES "project_imports"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_project_import_rows_import_id" ON "project_import_rows" ("import_id");
+
+CREATE TABLE IF NOT EXISTS "data_migration_issues" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "migration" varchar(100) NOT NULL,
+    "source_table" varchar(100) NOT NULL,
+    "row_id" bigint NOT NULL,
+    "column" varchar(100) NOT NULL,
+    "value" text,
+    "reason" text,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_data_migration_issues_migration" ON "data_migration_issues" ("migration");
+
+CREATE TABLE IF NOT EXISTS "notifications" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "tenant_id" bigint,
+    "user_id" bigint NOT NULL,
+    "type" varchar(50) NOT NULL,
+    "title" varchar(255) NOT NULL,
+    "message" text,
+    "project_id" bigint,
+    "read_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_notifications_project_id" ON "notifications" ("project_id");
+CREATE INDEX IF NOT EXISTS "idx_notifications_tenant_id" ON "notifications" ("tenant_id");
+CREATE INDEX IF NOT EXISTS "idx_notifications_user_id" ON "notifications" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "permit_expiry_reminders" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "project_id" bigint NOT NULL,
+    "permit_date" date NOT NULL,
+    "window_days" bigint NOT NULL,
+    "recipients" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_permit_expiry_reminders_key" ON "permit_expiry_reminders" ("project_id","permit_date","window_days");
+-- Full-text search: a Turkish configuration with unaccent in front of the stemmer, and generated
+-- tsvector columns weighted for ranking (names and permit number, then people, then the address)
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'turkish_unaccent') THEN
+        CREATE TEXT SEARCH CONFIGURATION turkish_unaccent (COPY = turkish);
+        ALTER TEXT SEARCH CONFIGURATION turkish_unaccent
+            ALTER MAPPING FOR hword, hword_part, word WITH unaccent, turkish_stem;
+    END IF;
+END $$;
+
+ALTER TABLE "projects" ADD COLUMN IF NOT EXISTS "search_vector" tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('turkish_unaccent'::regconfig, coalesce(project_name, '') || ' ' || coalesce(bildirim_no, '')), 'A') ||
+    setweight(to_tsvector('turkish_unaccent'::regconfig, coalesce(project_muellef, '') || ' ' || coalesce(yapi_sahibi, '')), 'B') ||
+    setweight(to_tsvector('turkish_unaccent'::regconfig, coalesce(adress, '')), 'C')
+) STORED;
+CREATE INDEX IF NOT EXISTS "idx_projects_search_vector" ON "projects" USING GIN ("search_vector");
+
+ALTER TABLE "document_pages" ADD COLUMN IF NOT EXISTS "search_vector" tsvector GENERATED ALWAYS AS (
+    to_tsvector('turkish_unaccent'::regconfig, coalesce(text, ''))
+) STORED;
+CREATE INDEX IF NOT EXISTS "idx_document_pages_search_vector" ON "document_pages" USING GIN ("search_vector");
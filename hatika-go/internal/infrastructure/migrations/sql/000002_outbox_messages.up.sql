@@ -0,0 +1,22 @@
+-- Transactional outbox: domain events stored with the change that raised them, relayed to brokers
+
+CREATE TABLE "outbox_messages" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "tenant_id" bigint,
+    "event_id" varchar(32) NOT NULL,
+    "event_name" varchar(128) NOT NULL,
+    "payload" text NOT NULL,
+    "occurred_at" timestamptz NOT NULL,
+    "status" varchar(20) NOT NULL DEFAULT 'Pending',
+    "next_attempt_at" timestamptz NOT NULL,
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "last_error" text,
+    "published_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_outbox_messages_due" ON "outbox_messages" ("status","next_attempt_at");
+CREATE INDEX "idx_outbox_messages_event_name" ON "outbox_messages" ("event_name");
+CREATE UNIQUE INDEX "idx_outbox_messages_event_id" ON "outbox_messages" ("event_id");
+CREATE INDEX "idx_outbox_messages_tenant_id" ON "outbox_messages" ("tenant_id");
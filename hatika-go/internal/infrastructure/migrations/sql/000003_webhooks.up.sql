@@ -0,0 +1,51 @@
+-- Outgoing webhooks: tenant subscriptions and the log of deliveries made to them
+
+CREATE TABLE "webhook_subscriptions" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "creator_user_id" bigint,
+    "last_modifier_id" bigint,
+    "deleter_user_id" bigint,
+    "deletion_time" timestamptz,
+    "is_deleted" boolean DEFAULT false,
+    "tenant_id" bigint,
+    "url" varchar(2048) NOT NULL,
+    "secret" varchar(128) NOT NULL,
+    "event_types" text NOT NULL,
+    "description" varchar(500),
+    "is_active" boolean NOT NULL DEFAULT true,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_webhook_subscriptions_tenant_id" ON "webhook_subscriptions" ("tenant_id");
+CREATE INDEX "idx_webhook_subscriptions_is_deleted" ON "webhook_subscriptions" ("is_deleted");
+CREATE INDEX "idx_webhook_subscriptions_deleter_user_id" ON "webhook_subscriptions" ("deleter_user_id");
+CREATE INDEX "idx_webhook_subscriptions_last_modifier_id" ON "webhook_subscriptions" ("last_modifier_id");
+CREATE INDEX "idx_webhook_subscriptions_creator_user_id" ON "webhook_subscriptions" ("creator_user_id");
+
+CREATE TABLE "webhook_deliveries" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "tenant_id" bigint,
+    "subscription_id" bigint NOT NULL,
+    "event_id" varchar(32) NOT NULL,
+    "event_name" varchar(128) NOT NULL,
+    "body" text NOT NULL,
+    "status" varchar(20) NOT NULL DEFAULT 'Pending',
+    "next_attempt_at" timestamptz NOT NULL,
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "last_attempt_at" timestamptz,
+    "response_status_code" bigint,
+    "response_body" text,
+    "duration_ms" bigint,
+    "last_error" text,
+    "delivered_at" timestamptz,
+    "redelivered_by_user_id" bigint,
+    "redelivered_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_webhook_deliveries_subscription" FOREIGN KEY ("subscription_id") REFERENCES "webhook_subscriptions"("id")
+);
+CREATE INDEX "idx_webhook_deliveries_due" ON "webhook_deliveries" ("status","next_attempt_at");
+CREATE UNIQUE INDEX "idx_webhook_deliveries_subscription_event" ON "webhook_deliveries" ("subscription_id","event_id");
+CREATE INDEX "idx_webhook_deliveries_tenant_id" ON "webhook_deliveries" ("tenant_id");
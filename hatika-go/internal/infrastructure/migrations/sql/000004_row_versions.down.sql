@@ -0,0 +1,9 @@
+ALTER TABLE "users" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "roles" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "tenants" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "project_groups" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "projects" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "ocr_projects" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "ocr_project_templates" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "project_documents" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "webhook_subscriptions" DROP COLUMN IF EXISTS "version";
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "project_bulk_operation_items";
+DROP TABLE IF EXISTS "project_bulk_operations";
@@ -0,0 +1,35 @@
+-- Bulk project operations and their per-project results
+
+CREATE TABLE "project_bulk_operations" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "tenant_id" bigint,
+    "operation" varchar(32) NOT NULL,
+    "background" boolean DEFAULT false,
+    "status" bigint NOT NULL DEFAULT 0,
+    "error" text,
+    "total_count" bigint NOT NULL DEFAULT 0,
+    "processed_count" bigint NOT NULL DEFAULT 0,
+    "succeeded_count" bigint NOT NULL DEFAULT 0,
+    "skipped_count" bigint NOT NULL DEFAULT 0,
+    "failed_count" bigint NOT NULL DEFAULT 0,
+    "triggered_by_user_id" bigint,
+    "started_at" timestamptz,
+    "completed_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_project_bulk_operations_tenant_id" ON "project_bulk_operations" ("tenant_id");
+
+CREATE TABLE "project_bulk_operation_items" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "operation_id" bigint NOT NULL,
+    "project_id" bigint NOT NULL,
+    "outcome" bigint NOT NULL,
+    "error" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_project_bulk_operations_items" FOREIGN KEY ("operation_id") REFERENCES "project_bulk_operations"("id")
+);
+CREATE INDEX "idx_project_bulk_operation_items_operation_project" ON "project_bulk_operation_items" ("operation_id","project_id");
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_projects_deleted_deletion_time";
+DROP TABLE IF EXISTS "audit_logs";
@@ -0,0 +1,20 @@
+-- Audit log, written by the purge of soft-deleted projects
+
+CREATE TABLE "audit_logs" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "tenant_id" bigint,
+    "action" varchar(50) NOT NULL,
+    "entity_type" varchar(50) NOT NULL,
+    "entity_id" bigint NOT NULL,
+    "user_id" bigint,
+    "details" text,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_audit_logs_tenant_id" ON "audit_logs" ("tenant_id");
+CREATE INDEX "idx_audit_logs_action" ON "audit_logs" ("action");
+CREATE INDEX "idx_audit_logs_entity" ON "audit_logs" ("entity_type","entity_id");
+
+-- Finds the projects whose retention period is over
+CREATE INDEX "idx_projects_deleted_deletion_time" ON "projects" ("deletion_time") WHERE "is_deleted";
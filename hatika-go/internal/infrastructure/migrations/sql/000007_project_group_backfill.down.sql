@@ -0,0 +1,2 @@
+-- Nothing to revert: the placeholder groups may have been renamed and used since, and the foreign key
+-- is part of the baseline schema
@@ -0,0 +1,29 @@
+-- Placeholder groups for the group IDs projects and OCR project templates used before project groups
+-- existed, so such databases keep their grouping and get the foreign key the baseline gives new ones.
+-- Databases created by the baseline migration have no such IDs and are left as they are.
+
+INSERT INTO "project_groups" ("id", "created_at", "updated_at", "tenant_id", "name", "description")
+SELECT s."group_id", now(), now(), MIN(s."tenant_id"), 'Group ' || s."group_id",
+       'Created from existing project group IDs; rename as needed'
+FROM (
+    SELECT "group_id", "tenant_id" FROM "projects"
+    UNION ALL
+    SELECT "group_id", "tenant_id" FROM "ocr_project_templates"
+) s
+WHERE s."group_id" IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM "project_groups" g WHERE g."id" = s."group_id")
+GROUP BY s."group_id";
+
+-- Explicit IDs do not advance the serial sequence
+SELECT setval(pg_get_serial_sequence('project_groups', 'id'), MAX("id"))
+FROM "project_groups"
+HAVING MAX("id") IS NOT NULL;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conrelid = 'projects'::regclass AND conname = 'fk_projects_group') THEN
+        ALTER TABLE "projects" ADD CONSTRAINT "fk_projects_group"
+            FOREIGN KEY ("group_id") REFERENCES "project_groups"("id") ON DELETE RESTRICT;
+    END IF;
+END
+$$;
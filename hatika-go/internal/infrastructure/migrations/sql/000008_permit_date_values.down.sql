@@ -0,0 +1 @@
+-- Nothing to revert: the date column is part of the baseline schema and the original text is gone
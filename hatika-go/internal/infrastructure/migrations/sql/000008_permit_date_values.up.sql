@@ -0,0 +1,124 @@
+-- Converts the free-form text permit dates of databases from before the typed permit date into the date
+-- column the baseline gives new ones. Accepts what valueobjects.ParsePermitDate does: yyyy-MM-dd, the
+-- Turkish dd.MM.yyyy form with '.', '/', '-' or ' ' separators, Turkish month names such as
+-- "31 Aralık 2025" and RFC 3339 timestamps. Dates that cannot be converted, and block, parcel and power
+-- numbers the value objects reject, are written to data_migration_issues; those dates are left empty and
+-- the numbers unchanged. Tables whose column already is a date are left as they are.
+
+CREATE FUNCTION pg_temp.parse_permit_date(value text) RETURNS date AS $$
+DECLARE
+    s text := btrim(value);
+    parts text[];
+    month_number integer;
+    parsed date;
+BEGIN
+    parts := regexp_match(s, '^(\d{4})-(\d{2})-(\d{2})(T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2}))?$');
+    IF parts IS NOT NULL THEN
+        parts := ARRAY[parts[3], parts[2], parts[1]];
+    ELSE
+        parts := regexp_match(s, '^(\d{1,2})([./ -])(\d{1,2})\2(\d{4})$');
+        IF parts IS NOT NULL THEN
+            parts := ARRAY[parts[1], parts[3], parts[4]];
+        ELSE
+            parts := regexp_match(s, '^(\d{1,2})\s+(\S+)\s+(\d{4})$');
+            IF parts IS NOT NULL THEN
+                month_number := array_position(
+                    ARRAY['ocak', 'subat', 'mart', 'nisan', 'mayis', 'haziran',
+                          'temmuz', 'agustos', 'eylul', 'ekim', 'kasim', 'aralik'],
+                    lower(translate(parts[2], 'ŞşIıİĞğÜü', 'ssiiigguu')));
+                parts := CASE WHEN month_number IS NULL THEN NULL ELSE ARRAY[parts[1], month_number::text, parts[3]] END;
+            END IF;
+        END IF;
+    END IF;
+
+    BEGIN
+        parsed := make_date(parts[3]::integer, parts[2]::integer, parts[1]::integer);
+    EXCEPTION WHEN others THEN
+        parsed := NULL;
+    END;
+    IF parsed IS NULL THEN
+        RAISE EXCEPTION '"%" is not a valid date, expected yyyy-MM-dd or dd.MM.yyyy', s
+            USING ERRCODE = 'invalid_datetime_format';
+    END IF;
+    IF extract(year FROM parsed) NOT BETWEEN 1900 AND 2100 THEN
+        RAISE EXCEPTION 'permit date % is out of range, the year must be between 1900 and 2100', to_char(parsed, 'YYYY-MM-DD')
+            USING ERRCODE = 'invalid_datetime_format';
+    END IF;
+    RETURN parsed;
+END
+$$ LANGUAGE plpgsql;
+
+DO $$
+DECLARE
+    source text;
+    column_type text;
+    legacy record;
+    converted integer;
+    failed integer;
+    invalid integer;
+BEGIN
+    FOREACH source IN ARRAY ARRAY['projects', 'ocr_projects'] LOOP
+        SELECT data_type INTO column_type
+        FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = source AND column_name = 'ruhsat_gecerlilik_date';
+        IF column_type = 'date' THEN
+            CONTINUE;
+        END IF;
+        IF column_type IS NULL THEN
+            EXECUTE format('ALTER TABLE %I ADD COLUMN "ruhsat_gecerlilik_date" date', source);
+            CONTINUE;
+        END IF;
+
+        -- The baseline indexed the text column; the index goes with it and is created again below
+        EXECUTE format('ALTER TABLE %I RENAME COLUMN "ruhsat_gecerlilik_date" TO "ruhsat_gecerlilik_date_text"', source);
+        EXECUTE format('ALTER TABLE %I ADD COLUMN "ruhsat_gecerlilik_date" date', source);
+
+        converted := 0;
+        failed := 0;
+        FOR legacy IN EXECUTE format(
+            'SELECT "id", "ruhsat_gecerlilik_date_text" AS "raw_value" FROM %I
+             WHERE btrim(coalesce("ruhsat_gecerlilik_date_text", '''')) <> '''' ORDER BY "id"', source)
+        LOOP
+            BEGIN
+                EXECUTE format('UPDATE %I SET "ruhsat_gecerlilik_date" = $1 WHERE "id" = $2', source)
+                    USING pg_temp.parse_permit_date(legacy.raw_value), legacy.id;
+                converted := converted + 1;
+            EXCEPTION WHEN invalid_datetime_format THEN
+                INSERT INTO "data_migration_issues"
+                    ("created_at", "updated_at", "migration", "source_table", "row_id", "column", "value", "reason")
+                VALUES (now(), now(), 'permit-values', source, legacy.id, 'ruhsat_gecerlilik_date', legacy.raw_value, SQLERRM);
+                failed := failed + 1;
+            END;
+        END LOOP;
+
+        -- Numbers cannot be repaired by parsing them differently, so they are only reported
+        EXECUTE format(
+            'INSERT INTO "data_migration_issues"
+                ("created_at", "updated_at", "migration", "source_table", "row_id", "column", "value", "reason")
+             SELECT now(), now(), ''permit-values'', %L, t."id", c."name", c."value"::text, c."reason"
+             FROM %I t, LATERAL (VALUES
+                 (''ada'', t."ada", CASE WHEN t."ada" < 0 OR t."ada" > 99999
+                     THEN ''ada must be between 0 and 99999, got '' || t."ada" END),
+                 (''parsel'', t."parsel", CASE WHEN t."parsel" < 1 OR t."parsel" > 99999
+                     THEN ''parsel must be between 1 and 99999, got '' || t."parsel" END),
+                 (''talep_gucu'', t."talep_gucu", CASE WHEN t."talep_gucu" < 0
+                     THEN ''power must not be negative, got '' || t."talep_gucu" || '' kW'' END),
+                 (''kurulu_guc'', t."kurulu_guc", CASE WHEN t."kurulu_guc" < 0
+                     THEN ''power must not be negative, got '' || t."kurulu_guc" || '' kW'' END)
+             ) AS c("name", "value", "reason")
+             WHERE c."reason" IS NOT NULL
+             ORDER BY t."id"', source, source);
+        GET DIAGNOSTICS invalid = ROW_COUNT;
+
+        EXECUTE format('ALTER TABLE %I DROP COLUMN "ruhsat_gecerlilik_date_text"', source);
+        IF source = 'projects' THEN
+            CREATE INDEX IF NOT EXISTS "idx_projects_ruhsat_gecerlilik_date" ON "projects" ("ruhsat_gecerlilik_date");
+        END IF;
+
+        RAISE NOTICE 'Converted % permit dates in %, % values need attention (see data_migration_issues)',
+            converted, source, failed + invalid;
+    END LOOP;
+END
+$$;
+
+DROP FUNCTION pg_temp.parse_permit_date(text);
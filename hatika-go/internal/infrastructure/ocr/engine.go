@@ -0,0 +1,30 @@
+package ocr
+
+import (
+	"context"
+
+	"hatika-go/internal/domain/entities"
+)
+
+// ExtractionRequest is the input of an extraction run
+type ExtractionRequest struct {
+	OcrProjectID int                     `json:"ocrProjectId"`
+	Type         entities.OcrProjectType `json:"type"`
+	TypeName     string                  `json:"typeName"`
+	PdfPath      string                  `json:"pdfPath,omitempty"`
+	Pages        []string                `json:"pages"`
+}
+
+// ExtractedField is a single value returned by an engine
+type ExtractedField struct {
+	FieldName  string      `json:"fieldName"`
+	Value      interface{} `json:"value"`
+	Confidence float64     `json:"confidence"`
+}
+
+// Engine extracts field values from an OCR document
+type Engine interface {
+	Name() string
+	Version() string
+	Extract(ctx context.Context, request *ExtractionRequest) ([]ExtractedField, error)
+}
@@ -0,0 +1,77 @@
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultHTTPEngineTimeout = 2 * time.Minute
+
+// HTTPEngine calls an extraction service that accepts an ExtractionRequest as JSON
+// and answers with {"fields": [{"fieldName": ..., "value": ..., "confidence": ...}]}
+type HTTPEngine struct {
+	name    string
+	version string
+	url     string
+	client  *http.Client
+}
+
+// NewHTTPEngine creates an engine backed by an HTTP extraction service
+func NewHTTPEngine(name, version, url string, timeout time.Duration) *HTTPEngine {
+	if timeout <= 0 {
+		timeout = defaultHTTPEngineTimeout
+	}
+	return &HTTPEngine{
+		name:    name,
+		version: version,
+		url:     url,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (e *HTTPEngine) Name() string {
+	return e.name
+}
+
+func (e *HTTPEngine) Version() string {
+	return e.version
+}
+
+func (e *HTTPEngine) Extract(ctx context.Context, request *ExtractionRequest) ([]ExtractedField, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode extraction request: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create extraction request: %w", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("X-Engine-Version", e.version)
+
+	response, err := e.client.Do(httpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("extraction request failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return nil, fmt.Errorf("extraction service returned %d: %s", response.StatusCode, bytes.TrimSpace(message))
+	}
+
+	var result struct {
+		Fields []ExtractedField `json:"fields"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode extraction response: %w", err)
+	}
+
+	return result.Fields, nil
+}
@@ -0,0 +1,50 @@
+package ocr
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Registry holds the engines available for extraction runs
+type Registry struct {
+	engines       map[string]Engine
+	defaultEngine string
+}
+
+// NewRegistry creates an empty registry; defaultEngine is used when a run names no engine
+func NewRegistry(defaultEngine string) *Registry {
+	return &Registry{
+		engines:       make(map[string]Engine),
+		defaultEngine: defaultEngine,
+	}
+}
+
+// Register adds an engine, replacing any engine with the same name
+func (r *Registry) Register(engine Engine) {
+	r.engines[engine.Name()] = engine
+}
+
+// Get returns the named engine, or the default engine when name is empty
+func (r *Registry) Get(name string) (Engine, error) {
+	if name == "" {
+		name = r.defaultEngine
+	}
+
+	engine, ok := r.engines[name]
+	if !ok {
+		return nil, fmt.Errorf("OCR engine %q is not configured", name)
+	}
+	return engine, nil
+}
+
+// Engines lists the registered engines sorted by name
+func (r *Registry) Engines() []Engine {
+	engines := make([]Engine, 0, len(r.engines))
+	for _, engine := range r.engines {
+		engines = append(engines, engine)
+	}
+	sort.Slice(engines, func(i, j int) bool {
+		return engines[i].Name() < engines[j].Name()
+	})
+	return engines
+}
@@ -0,0 +1,20 @@
+package persistence
+
+import (
+	"hatika-go/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// AuditLogRepository implements audit log-specific repository operations. Entries are only ever
+// inserted.
+type AuditLogRepository struct {
+	*BaseRepository[entities.AuditLog, int]
+}
+
+// NewAuditLogRepository creates a new audit log repository
+func NewAuditLogRepository(db *gorm.DB) *AuditLogRepository {
+	return &AuditLogRepository{
+		BaseRepository: NewBaseRepository[entities.AuditLog, int](db),
+	}
+}
@@ -4,22 +4,26 @@ import (
 	"context"
 	"fmt"
 
+	"hatika-go/internal/domain/specifications"
+
 	"gorm.io/gorm"
 )
 
 type BaseRepository[T any, ID comparable] struct {
-	db *gorm.DB
+	db      *gorm.DB
+	columns FieldColumns
 }
 
 func NewBaseRepository[T any, ID comparable](db *gorm.DB) *BaseRepository[T, ID] {
 	return &BaseRepository[T, ID]{
-		db: db,
+		db:      db,
+		columns: defaultColumns,
 	}
 }
 
 func (r *BaseRepository[T, ID]) GetByID(ctx context.Context, id ID) (*T, error) {
 	var entity T
-	result := r.db.WithContext(ctx).First(&entity, id)
+	result := r.DB(ctx).First(&entity, id)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -28,23 +32,53 @@ func (r *BaseRepository[T, ID]) GetByID(ctx context.Context, id ID) (*T, error)
 
 func (r *BaseRepository[T, ID]) GetAll(ctx context.Context) ([]T, error) {
 	var entities []T
-	result := r.db.WithContext(ctx).Find(&entities)
+	result := r.DB(ctx).Find(&entities)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return entities, nil
 }
 
-func (r *BaseRepository[T, ID]) GetPaged(ctx context.Context, pageNumber, pageSize int) ([]T, int64, error) {
+// WithColumns sets the fields the repository's queries may sort and filter by
+func (r *BaseRepository[T, ID]) WithColumns(columns FieldColumns) *BaseRepository[T, ID] {
+	r.columns = columns
+	return r
+}
+
+// Sorting parses a sorting expression against the repository's whitelist into an order scope
+func (r *BaseRepository[T, ID]) Sorting(sorting string, fallback ...SortField) (func(db *gorm.DB) *gorm.DB, error) {
+	fields, err := ParseSorting(sorting, r.columns)
+	if err != nil {
+		return nil, err
+	}
+	return sortScope(fields, fallback...), nil
+}
+
+func (r *BaseRepository[T, ID]) GetPaged(
+	ctx context.Context,
+	pageNumber, pageSize int,
+	sorting string,
+	spec specifications.Specification,
+) ([]T, int64, error) {
 	var entities []T
 	var totalCount int64
 
-	if err := r.db.WithContext(ctx).Model(new(T)).Count(&totalCount).Error; err != nil {
+	orderScope, err := r.Sorting(sorting)
+	if err != nil {
+		return nil, 0, err
+	}
+	filterScope, err := r.Filter(spec)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.DB(ctx).Model(new(T)).Scopes(filterScope).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
 	offset := (pageNumber - 1) * pageSize
-	result := r.db.WithContext(ctx).
+	result := r.DB(ctx).
+		Scopes(filterScope, orderScope).
 		Offset(offset).
 		Limit(pageSize).
 		Find(&entities)
@@ -56,18 +90,28 @@ func (r *BaseRepository[T, ID]) GetPaged(ctx context.Context, pageNumber, pageSi
 	return entities, totalCount, nil
 }
 
-func (r *BaseRepository[T, ID]) Find(ctx context.Context, condition interface{}, args ...interface{}) ([]T, error) {
+func (r *BaseRepository[T, ID]) Find(ctx context.Context, spec specifications.Specification) ([]T, error) {
+	filterScope, err := r.Filter(spec)
+	if err != nil {
+		return nil, err
+	}
+
 	var entities []T
-	result := r.db.WithContext(ctx).Where(condition, args...).Find(&entities)
+	result := r.DB(ctx).Scopes(filterScope).Find(&entities)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return entities, nil
 }
 
-func (r *BaseRepository[T, ID]) FirstOrDefault(ctx context.Context, condition interface{}, args ...interface{}) (*T, error) {
+func (r *BaseRepository[T, ID]) FirstOrDefault(ctx context.Context, spec specifications.Specification) (*T, error) {
+	filterScope, err := r.Filter(spec)
+	if err != nil {
+		return nil, err
+	}
+
 	var entity T
-	result := r.db.WithContext(ctx).Where(condition, args...).First(&entity)
+	result := r.DB(ctx).Scopes(filterScope).First(&entity)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -79,7 +123,7 @@ func (r *BaseRepository[T, ID]) FirstOrDefault(ctx context.Context, condition in
 
 func (r *BaseRepository[T, ID]) Count(ctx context.Context) (int64, error) {
 	var count int64
-	result := r.db.WithContext(ctx).Model(new(T)).Count(&count)
+	result := r.DB(ctx).Model(new(T)).Count(&count)
 	if result.Error != nil {
 		return 0, result.Error
 	}
@@ -87,22 +131,28 @@ func (r *BaseRepository[T, ID]) Count(ctx context.Context) (int64, error) {
 }
 
 func (r *BaseRepository[T, ID]) Insert(ctx context.Context, entity *T) error {
-	result := r.db.WithContext(ctx).Create(entity)
+	result := r.DB(ctx).Create(entity)
 	return result.Error
 }
 
 func (r *BaseRepository[T, ID]) InsertMany(ctx context.Context, entities []T) error {
-	result := r.db.WithContext(ctx).Create(&entities)
+	result := r.DB(ctx).Create(&entities)
 	return result.Error
 }
 
 func (r *BaseRepository[T, ID]) Update(ctx context.Context, entity *T) error {
-	result := r.db.WithContext(ctx).Save(entity)
+	result := r.DB(ctx).Save(entity)
+	return result.Error
+}
+
+// UpdateColumns writes only the given columns of a loaded entity; zero values are written too
+func (r *BaseRepository[T, ID]) UpdateColumns(ctx context.Context, entity *T, columns ...string) error {
+	result := r.DB(ctx).Model(entity).Select(columns).Updates(entity)
 	return result.Error
 }
 
 func (r *BaseRepository[T, ID]) Delete(ctx context.Context, id ID) error {
-	result := r.db.WithContext(ctx).Delete(new(T), id)
+	result := r.DB(ctx).Delete(new(T), id)
 	return result.Error
 }
 
@@ -114,3 +164,9 @@ func (r *BaseRepository[T, ID]) SoftDelete(ctx context.Context, id ID, userID in
 func (r *BaseRepository[T, ID]) GetDB() *gorm.DB {
 	return r.db
 }
+
+// DB returns the session for a query bound to ctx, inside the transaction of the context's unit of
+// work when there is one
+func (r *BaseRepository[T, ID]) DB(ctx context.Context) *gorm.DB {
+	return dbFor(ctx, r.db)
+}
@@ -0,0 +1,33 @@
+package persistence
+
+import (
+	"sort"
+	"strings"
+)
+
+// FieldColumns whitelists the fields a client may sort and filter by, mapping DTO JSON names to columns
+type FieldColumns map[string]string
+
+// defaultColumns applies to repositories that do not declare their own whitelist
+var defaultColumns = FieldColumns{
+	"id": "id",
+}
+
+// lookup finds a field case-insensitively and returns its canonical name and column
+func (c FieldColumns) lookup(name string) (string, string, bool) {
+	for field, column := range c {
+		if strings.EqualFold(field, name) {
+			return field, column, true
+		}
+	}
+	return "", "", false
+}
+
+func (c FieldColumns) fields() []string {
+	fields := make([]string, 0, len(c))
+	for field := range c {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
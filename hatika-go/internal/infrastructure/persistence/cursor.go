@@ -0,0 +1,279 @@
+package persistence
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// CursorRequest asks for one page of a keyset-paginated query
+type CursorRequest struct {
+	Cursor       string
+	Limit        int
+	Sorting      string
+	IncludeTotal bool
+}
+
+// CursorPage is one page of a keyset-paginated query
+type CursorPage[T any] struct {
+	Items      []T
+	NextCursor string
+	PrevCursor string
+	TotalCount *int64
+}
+
+// cursor is the decoded form of the opaque cursor handed to clients. It holds the sort key of
+// the row it points at and the sorting it was built for, so it cannot be replayed with another order.
+type cursor struct {
+	Sorting string            `json:"s"`
+	Before  bool              `json:"b,omitempty"`
+	Values  []json.RawMessage `json:"v"`
+}
+
+func encodeCursor(c cursor) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(value string) (cursor, error) {
+	var c cursor
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || json.Unmarshal(data, &c) != nil {
+		return c, apperrors.Validation("invalid cursor")
+	}
+	return c, nil
+}
+
+// keysetFields returns the sort fields with id appended, since the key must be unique
+func keysetFields(fields []SortField) []SortField {
+	fields = append([]SortField(nil), fields...)
+	for _, field := range fields {
+		if field.Column == "id" {
+			return fields
+		}
+	}
+	return append(fields, SortField{Field: "id", Column: "id"})
+}
+
+func sortingKey(fields []SortField) string {
+	terms := make([]string, len(fields))
+	for i, field := range fields {
+		direction := "asc"
+		if field.Desc {
+			direction = "desc"
+		}
+		terms[i] = field.Field + " " + direction
+	}
+	return strings.Join(terms, ",")
+}
+
+// keysetOrder orders by the key, reversed when paging backwards. NULLs sort as the largest
+// value on every database so offset and cursor paging agree and the keyset condition below holds.
+func keysetOrder(fields []SortField, backward bool) clause.Expression {
+	terms := make([]string, len(fields))
+	vars := make([]interface{}, len(fields))
+	for i, field := range fields {
+		if field.Desc != backward {
+			terms[i] = "? DESC NULLS FIRST"
+		} else {
+			terms[i] = "? ASC NULLS LAST"
+		}
+		vars[i] = clause.Column{Name: field.Column}
+	}
+	return clause.OrderBy{Expression: clause.Expr{SQL: strings.Join(terms, ", "), Vars: vars}}
+}
+
+// keysetCondition matches the rows that come after values in the key order, or before them when
+// paging backwards: (c1 > v1) OR (c1 = v1 AND c2 > v2) OR ...
+func keysetCondition(fields []SortField, values []interface{}, backward bool) clause.Expression {
+	var terms []string
+	var vars []interface{}
+
+	var equalSQL []string
+	var equalVars []interface{}
+	for i, field := range fields {
+		column := clause.Column{Name: field.Column}
+		value := values[i]
+
+		var afterSQL string
+		var afterVars []interface{}
+		descending := field.Desc != backward
+		switch {
+		case value == nil && descending:
+			afterSQL, afterVars = "? IS NOT NULL", []interface{}{column}
+		case value == nil:
+			// Nothing sorts after NULL in ascending order
+		case descending:
+			afterSQL, afterVars = "? < ?", []interface{}{column, value}
+		default:
+			afterSQL, afterVars = "(? > ? OR ? IS NULL)", []interface{}{column, value, column}
+		}
+
+		if afterSQL != "" {
+			terms = append(terms, "("+strings.Join(append(append([]string{}, equalSQL...), afterSQL), " AND ")+")")
+			vars = append(append(vars, equalVars...), afterVars...)
+		}
+
+		if value == nil {
+			equalSQL = append(equalSQL, "? IS NULL")
+			equalVars = append(equalVars, column)
+		} else {
+			equalSQL = append(equalSQL, "? = ?")
+			equalVars = append(equalVars, column, value)
+		}
+	}
+
+	if len(terms) == 0 {
+		return clause.Expr{SQL: "1 = 0"}
+	}
+	return clause.Expr{SQL: strings.Join(terms, " OR "), Vars: vars}
+}
+
+// GetPageByCursor pages through the table with a keyset instead of an offset. Scopes narrow the
+// query, e.g. filters and preloads. The total count is only computed when requested.
+func (r *BaseRepository[T, ID]) GetPageByCursor(
+	ctx context.Context,
+	request CursorRequest,
+	scopes ...func(db *gorm.DB) *gorm.DB,
+) (*CursorPage[T], error) {
+	sortFields, err := ParseSorting(request.Sorting, r.columns)
+	if err != nil {
+		return nil, err
+	}
+	fields := keysetFields(sortFields)
+	key := sortingKey(fields)
+
+	model, err := r.modelSchema()
+	if err != nil {
+		return nil, err
+	}
+	schemaFields := make([]*schema.Field, len(fields))
+	for i, field := range fields {
+		if schemaFields[i] = model.LookUpField(field.Column); schemaFields[i] == nil {
+			return nil, fmt.Errorf("column %q is not part of %s", field.Column, model.Name)
+		}
+	}
+
+	query := r.DB(ctx).Model(new(T)).Scopes(scopes...)
+
+	page := &CursorPage[T]{}
+	if request.IncludeTotal {
+		var totalCount int64
+		if err := query.Session(&gorm.Session{}).Count(&totalCount).Error; err != nil {
+			return nil, fmt.Errorf("failed to count rows: %w", err)
+		}
+		page.TotalCount = &totalCount
+	}
+
+	backward := false
+	if request.Cursor != "" {
+		c, err := decodeCursor(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if c.Sorting != key || len(c.Values) != len(fields) {
+			return nil, apperrors.Validation("cursor was created for a different sorting")
+		}
+
+		values := make([]interface{}, len(fields))
+		for i, raw := range c.Values {
+			if values[i], err = decodeKeyValue(raw, schemaFields[i]); err != nil {
+				return nil, apperrors.Validation("invalid cursor")
+			}
+		}
+
+		backward = c.Before
+		query = query.Where(keysetCondition(fields, values, backward))
+	}
+
+	var items []T
+	if err := query.
+		Clauses(keysetOrder(fields, backward)).
+		Limit(request.Limit + 1).
+		Find(&items).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch rows: %w", err)
+	}
+
+	hasMore := len(items) > request.Limit
+	if hasMore {
+		items = items[:request.Limit]
+	}
+	if backward {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+	page.Items = items
+
+	if len(items) == 0 {
+		return page, nil
+	}
+
+	// Going forward there is a next page when we fetched more, and a previous one when we came from a cursor;
+	// going backward it is the other way round.
+	if (!backward && hasMore) || (backward && request.Cursor != "") {
+		if page.NextCursor, err = keysetCursor(ctx, key, schemaFields, &items[len(items)-1], false); err != nil {
+			return nil, err
+		}
+	}
+	if (backward && hasMore) || (!backward && request.Cursor != "") {
+		if page.PrevCursor, err = keysetCursor(ctx, key, schemaFields, &items[0], true); err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// modelSchema returns the parsed GORM schema of T, which knows the Go type of every column
+func (r *BaseRepository[T, ID]) modelSchema() (*schema.Schema, error) {
+	statement := &gorm.Statement{DB: r.db}
+	if err := statement.Parse(new(T)); err != nil {
+		return nil, fmt.Errorf("failed to parse model: %w", err)
+	}
+	return statement.Schema, nil
+}
+
+func keysetCursor(ctx context.Context, key string, fields []*schema.Field, item interface{}, before bool) (string, error) {
+	c := cursor{Sorting: key, Before: before, Values: make([]json.RawMessage, len(fields))}
+	row := reflect.ValueOf(item).Elem()
+	for i, field := range fields {
+		value, _ := field.ValueOf(ctx, row)
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode cursor: %w", err)
+		}
+		c.Values[i] = raw
+	}
+	return encodeCursor(c)
+}
+
+// decodeKeyValue decodes a cursor value into the Go type of its column so it binds correctly
+func decodeKeyValue(raw json.RawMessage, field *schema.Field) (interface{}, error) {
+	if string(raw) == "null" {
+		return nil, nil
+	}
+
+	fieldType := field.FieldType
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	value := reflect.New(fieldType)
+	if err := json.Unmarshal(raw, value.Interface()); err != nil {
+		return nil, err
+	}
+	return value.Elem().Interface(), nil
+}
@@ -45,6 +45,10 @@ func NewDatabase(config *DatabaseConfig) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	if err := RegisterVersioning(db); err != nil {
+		return nil, fmt.Errorf("failed to register optimistic concurrency: %w", err)
+	}
+
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database instance: %w", err)
@@ -60,95 +64,40 @@ func NewDatabase(config *DatabaseConfig) (*gorm.DB, error) {
 	return db, nil
 }
 
-// database migrations
+// AutoMigrate creates the tables straight from the entities. Servers get their schema from the
+// versioned migrations instead; this is for disposable databases, such as SQLite in tests, and does
+// not set up the Postgres-only full-text search.
 func AutoMigrate(db *gorm.DB) error {
-	log.Println("Running database migrations...")
-
 	err := db.AutoMigrate(
+		&entities.ProjectGroup{},
+		&entities.ProjectGroupPermission{},
 		&entities.User{},
 		&entities.Role{},
 		&entities.Permission{},
 		&entities.Tenant{},
 		&entities.Project{},
 		&entities.OcrProject{},
+		&entities.OcrFieldResult{},
+		&entities.OcrProjectTemplate{},
+		&entities.OcrProjectTemplateItem{},
+		&entities.ProjectDocument{},
+		&entities.DocumentPage{},
+		&entities.OcrRun{},
+		&entities.OcrRunField{},
+		&entities.ProjectImport{},
+		&entities.ProjectImportRow{},
+		&entities.DataMigrationIssue{},
+		&entities.Notification{},
+		&entities.PermitExpiryReminder{},
+		&entities.OutboxMessage{},
+		&entities.WebhookSubscription{},
+		&entities.WebhookDelivery{},
+		&entities.ProjectBulkOperation{},
+		&entities.ProjectBulkOperationItem{},
+		&entities.AuditLog{},
 	)
-
 	if err != nil {
-		return fmt.Errorf("failed to run migrations: %w", err)
-	}
-
-	log.Println("Database migrations completed successfully")
-	return nil
-}
-
-func SeedData(db *gorm.DB) error {
-	log.Println("Seeding initial data...")
-
-	permissions := []entities.Permission{
-		{Name: entities.PagesUsers, DisplayName: "Users", Description: "Access to users page"},
-		{Name: entities.PagesRoles, DisplayName: "Roles", Description: "Access to roles page"},
-		{Name: entities.PagesProjects, DisplayName: "Projects", Description: "Access to projects page"},
-		{Name: entities.PagesOcrProjects, DisplayName: "OCR Projects", Description: "Access to OCR projects page"},
-		{Name: entities.PagesTenants, DisplayName: "Tenants", Description: "Access to tenants page"},
-		{Name: entities.UsersCreate, DisplayName: "Create User", Description: "Can create users"},
-		{Name: entities.UsersEdit, DisplayName: "Edit User", Description: "Can edit users"},
-		{Name: entities.UsersDelete, DisplayName: "Delete User", Description: "Can delete users"},
-		{Name: entities.RolesCreate, DisplayName: "Create Role", Description: "Can create roles"},
-		{Name: entities.RolesEdit, DisplayName: "Edit Role", Description: "Can edit roles"},
-		{Name: entities.RolesDelete, DisplayName: "Delete Role", Description: "Can delete roles"},
-		{Name: entities.ProjectsCreate, DisplayName: "Create Project", Description: "Can create projects"},
-		{Name: entities.ProjectsEdit, DisplayName: "Edit Project", Description: "Can edit projects"},
-		{Name: entities.ProjectsDelete, DisplayName: "Delete Project", Description: "Can delete projects"},
-	}
-
-	for _, perm := range permissions {
-		var existingPerm entities.Permission
-		result := db.Where("name = ?", perm.Name).First(&existingPerm)
-		if result.Error == gorm.ErrRecordNotFound {
-			if err := db.Create(&perm).Error; err != nil {
-				log.Printf("Warning: Failed to create permission %s: %v", perm.Name, err)
-			}
-		}
-	}
-
-	adminRole := entities.Role{
-		Name:        entities.AdminRoleName,
-		DisplayName: "Administrator",
-		Description: "System administrator with full access",
-		IsStatic:    true,
-		IsDefault:   false,
-	}
-
-	var existingAdminRole entities.Role
-	result := db.Where("name = ?", adminRole.Name).First(&existingAdminRole)
-	if result.Error == gorm.ErrRecordNotFound {
-		if err := db.Create(&adminRole).Error; err != nil {
-			return fmt.Errorf("failed to create admin role: %w", err)
-		}
-
-		var allPermissions []entities.Permission
-		db.Find(&allPermissions)
-		if err := db.Model(&adminRole).Association("Permissions").Append(allPermissions); err != nil {
-			log.Printf("Warning: Failed to assign permissions to admin role: %v", err)
-		}
+		return fmt.Errorf("failed to create tables: %w", err)
 	}
-
-	userRole := entities.Role{
-		Name:        entities.UserRoleName,
-		DisplayName: "User",
-		Description: "Standard user with limited access",
-		IsStatic:    true,
-		IsDefault:   true,
-	}
-
-	var existingUserRole entities.Role
-	result = db.Where("name = ?", userRole.Name).First(&existingUserRole)
-	if result.Error == gorm.ErrRecordNotFound {
-		if err := db.Create(&userRole).Error; err != nil {
-			return fmt.Errorf("failed to create user role: %w", err)
-		}
-	}
-
-	log.Println("Initial data seeded successfully")
 	return nil
 }
@@ -0,0 +1,413 @@
+package persistence
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"hatika-go/internal/domain/specifications"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm/schema"
+)
+
+// filterTokenKind classifies the tokens of a filter expression
+type filterTokenKind int
+
+const (
+	filterWord filterTokenKind = iota
+	filterString
+	filterOpen
+	filterClose
+	filterComma
+	filterEnd
+)
+
+// filterToken is a token of a filter expression; Position is its 1-based offset in the expression
+type filterToken struct {
+	Kind     filterTokenKind
+	Text     string
+	Position int
+}
+
+func (t filterToken) describe() string {
+	if t.Kind == filterEnd {
+		return "end of filter"
+	}
+	return fmt.Sprintf("%q", t.Text)
+}
+
+// filterError reports a problem at a token so clients can point at it
+func filterError(token filterToken, format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...)
+	return apperrors.Validation("invalid filter at position %d: %s", token.Position, message).
+		WithDetails(map[string]interface{}{"position": token.Position, "token": token.Text})
+}
+
+func tokenizeFilter(expression string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, filterToken{Kind: filterOpen, Text: "(", Position: i + 1})
+			i++
+		case c == ')':
+			tokens = append(tokens, filterToken{Kind: filterClose, Text: ")", Position: i + 1})
+			i++
+		case c == ',':
+			tokens = append(tokens, filterToken{Kind: filterComma, Text: ",", Position: i + 1})
+			i++
+		case c == '\'':
+			// Quoted strings escape a quote by doubling it: 'O''Brien'
+			start := i
+			var text strings.Builder
+			closed := false
+			for i++; i < len(expression); i++ {
+				if expression[i] == '\'' {
+					if i+1 < len(expression) && expression[i+1] == '\'' {
+						text.WriteByte('\'')
+						i++
+						continue
+					}
+					closed = true
+					i++
+					break
+				}
+				text.WriteByte(expression[i])
+			}
+			if !closed {
+				return nil, filterError(filterToken{Text: expression[start:], Position: start + 1}, "unterminated string")
+			}
+			tokens = append(tokens, filterToken{Kind: filterString, Text: text.String(), Position: start + 1})
+		default:
+			start := i
+			for i < len(expression) && !strings.ContainsRune(" \t\n\r(),'", rune(expression[i])) {
+				i++
+			}
+			tokens = append(tokens, filterToken{Kind: filterWord, Text: expression[start:i], Position: start + 1})
+		}
+	}
+	return append(tokens, filterToken{Kind: filterEnd, Position: len(expression) + 1}), nil
+}
+
+// ParseFilter parses an OData-style expression such as
+// "kuruluGuc gt 500 and ruhsatGecerlilikDate lt 2026-01-01 and groupId in (1,2)" into a specification.
+// Fields are checked against the whitelist and literals are converted to the Go type of their column,
+// so the result compiles to parameterized SQL only. An empty expression yields a nil specification.
+//
+// Grammar (keywords are case-insensitive):
+//
+//	expr       = term { "or" term }
+//	term       = factor { "and" factor }
+//	factor     = "not" factor | "(" expr ")" | comparison
+//	comparison = field ("eq"|"ne"|"gt"|"ge"|"lt"|"le") value
+//	           | field "in" "(" value { "," value } ")"
+//	           | field "contains" value
+//	value      = 'quoted string' | word | number | true | false | date | null
+//
+// Text may be left unquoted when it has no spaces, parentheses or commas.
+func ParseFilter(expression string, columns FieldColumns, model *schema.Schema) (specifications.Specification, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+
+	tokens, err := tokenizeFilter(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterParser{tokens: tokens, columns: columns, model: model}
+	spec, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := p.peek(); token.Kind != filterEnd {
+		return nil, filterError(token, "unexpected %s, expected and, or or end of filter", token.describe())
+	}
+	return spec, nil
+}
+
+// ParseFilter parses a filter expression against the repository's whitelist and model
+func (r *BaseRepository[T, ID]) ParseFilter(expression string) (specifications.Specification, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+	model, err := r.modelSchema()
+	if err != nil {
+		return nil, err
+	}
+	return ParseFilter(expression, r.columns, model)
+}
+
+type filterParser struct {
+	tokens  []filterToken
+	pos     int
+	columns FieldColumns
+	model   *schema.Schema
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() filterToken {
+	token := p.tokens[p.pos]
+	if token.Kind != filterEnd {
+		p.pos++
+	}
+	return token
+}
+
+// acceptKeyword consumes the next token if it is the given keyword
+func (p *filterParser) acceptKeyword(keyword string) bool {
+	token := p.peek()
+	if token.Kind == filterWord && strings.EqualFold(token.Text, keyword) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) expect(kind filterTokenKind, expected string) (filterToken, error) {
+	token := p.next()
+	if token.Kind != kind {
+		return token, filterError(token, "unexpected %s, expected %s", token.describe(), expected)
+	}
+	return token, nil
+}
+
+func (p *filterParser) parseOr() (specifications.Specification, error) {
+	spec, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	specs := []specifications.Specification{spec}
+	for p.acceptKeyword("or") {
+		if spec, err = p.parseAnd(); err != nil {
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+	if len(specs) == 1 {
+		return specs[0], nil
+	}
+	return specifications.Or(specs...), nil
+}
+
+func (p *filterParser) parseAnd() (specifications.Specification, error) {
+	spec, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	specs := []specifications.Specification{spec}
+	for p.acceptKeyword("and") {
+		if spec, err = p.parseFactor(); err != nil {
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+	if len(specs) == 1 {
+		return specs[0], nil
+	}
+	return specifications.And(specs...), nil
+}
+
+func (p *filterParser) parseFactor() (specifications.Specification, error) {
+	if p.acceptKeyword("not") {
+		spec, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return specifications.Not(spec), nil
+	}
+
+	if p.peek().Kind == filterOpen {
+		p.next()
+		spec, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(filterClose, "')'"); err != nil {
+			return nil, err
+		}
+		return spec, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (specifications.Specification, error) {
+	fieldToken, err := p.expect(filterWord, "a field name")
+	if err != nil {
+		return nil, err
+	}
+	field, column, ok := p.columns.lookup(fieldToken.Text)
+	if !ok {
+		return nil, apperrors.Validation("invalid filter at position %d: cannot filter by %q", fieldToken.Position, fieldToken.Text).
+			WithDetails(map[string]interface{}{
+				"position":         fieldToken.Position,
+				"token":            fieldToken.Text,
+				"filterableFields": p.columns.fields(),
+			})
+	}
+	schemaField := p.model.LookUpField(column)
+	if schemaField == nil {
+		return nil, fmt.Errorf("column %q is not part of %s", column, p.model.Name)
+	}
+	fieldType := schemaField.FieldType
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	operatorToken, err := p.expect(filterWord, "an operator")
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(operatorToken.Text) {
+	case "eq", "ne", "gt", "ge", "lt", "le":
+		valueToken := p.next()
+		value, err := filterValue(valueToken, fieldType)
+		if err != nil {
+			return nil, err
+		}
+		operator := strings.ToLower(operatorToken.Text)
+		if value == nil && operator != "eq" && operator != "ne" {
+			return nil, filterError(valueToken, "null can only be compared with eq or ne")
+		}
+		return comparisonSpec(operator, field, value), nil
+
+	case "in":
+		if _, err := p.expect(filterOpen, "'(' after in"); err != nil {
+			return nil, err
+		}
+		var values []interface{}
+		for {
+			valueToken := p.next()
+			value, err := filterValue(valueToken, fieldType)
+			if err != nil {
+				return nil, err
+			}
+			if value == nil {
+				return nil, filterError(valueToken, "null is not allowed in an in list, use eq null")
+			}
+			values = append(values, value)
+
+			if p.peek().Kind != filterComma {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect(filterClose, "',' or ')'"); err != nil {
+			return nil, err
+		}
+		return specifications.In(field, values...), nil
+
+	case "contains":
+		valueToken := p.next()
+		if fieldType.Kind() != reflect.String {
+			return nil, filterError(operatorToken, "contains requires a text field, %q is not one", field)
+		}
+		if valueToken.Kind != filterString {
+			return nil, filterError(valueToken, "unexpected %s, expected a quoted string", valueToken.describe())
+		}
+		return specifications.Contains(field, valueToken.Text), nil
+	}
+
+	return nil, filterError(operatorToken, "unknown operator %q, expected eq, ne, gt, ge, lt, le, in or contains", operatorToken.Text)
+}
+
+func comparisonSpec(operator, field string, value interface{}) specifications.Specification {
+	switch operator {
+	case "ne":
+		return specifications.NotEquals(field, value)
+	case "gt":
+		return specifications.GreaterThan(field, value)
+	case "ge":
+		return specifications.GreaterOrEqual(field, value)
+	case "lt":
+		return specifications.LessThan(field, value)
+	case "le":
+		return specifications.LessOrEqual(field, value)
+	}
+	return specifications.Equals(field, value)
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// filterDateLayouts are accepted for time columns, most specific first
+var filterDateLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"}
+
+// filterValue converts a literal to the Go type of its column; a bare null yields nil
+func filterValue(token filterToken, fieldType reflect.Type) (interface{}, error) {
+	switch token.Kind {
+	case filterString:
+	case filterWord:
+		if strings.EqualFold(token.Text, "null") {
+			return nil, nil
+		}
+	default:
+		return nil, filterError(token, "unexpected %s, expected a value", token.describe())
+	}
+
+	invalid := func(expected string) error {
+		return filterError(token, "%s is not a valid %s", token.describe(), expected)
+	}
+
+	if fieldType == reflect.TypeOf(time.Time{}) {
+		for _, layout := range filterDateLayouts {
+			if t, err := time.Parse(layout, token.Text); err == nil {
+				return t, nil
+			}
+		}
+		return nil, invalid("date, expected yyyy-MM-dd or RFC 3339")
+	}
+
+	// Value objects such as PermitDate parse and validate their own literals
+	if reflect.PointerTo(fieldType).Implements(textUnmarshalerType) {
+		value := reflect.New(fieldType)
+		if err := value.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(token.Text)); err != nil {
+			return nil, filterError(token, "%v", err)
+		}
+		return value.Elem().Interface(), nil
+	}
+
+	value := reflect.New(fieldType).Elem()
+	switch fieldType.Kind() {
+	case reflect.String:
+		value.SetString(token.Text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.ToLower(token.Text))
+		if err != nil || token.Kind == filterString {
+			return nil, invalid("boolean")
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(token.Text, 10, fieldType.Bits())
+		if err != nil {
+			return nil, invalid("integer")
+		}
+		value.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(token.Text, 10, fieldType.Bits())
+		if err != nil {
+			return nil, invalid("integer")
+		}
+		value.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(token.Text, fieldType.Bits())
+		if err != nil {
+			return nil, invalid("number")
+		}
+		value.SetFloat(f)
+	default:
+		return nil, fmt.Errorf("unsupported filter field type %s", fieldType)
+	}
+	return value.Interface(), nil
+}
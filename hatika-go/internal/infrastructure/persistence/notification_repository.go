@@ -0,0 +1,71 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// NotificationRepository implements notification-specific repository operations
+type NotificationRepository struct {
+	*BaseRepository[entities.Notification, int]
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(db *gorm.DB) *NotificationRepository {
+	return &NotificationRepository{
+		BaseRepository: NewBaseRepository[entities.Notification, int](db),
+	}
+}
+
+// GetPageForUser pages through a user's notifications, newest first
+func (r *NotificationRepository) GetPageForUser(
+	ctx context.Context,
+	userID int,
+	unreadOnly bool,
+	pageNumber, pageSize int,
+) ([]entities.Notification, int64, error) {
+	query := r.DB(ctx).
+		Model(&entities.Notification{}).
+		Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	var notifications []entities.Notification
+	if err := query.
+		Order("created_at DESC, id DESC").
+		Offset((pageNumber - 1) * pageSize).
+		Limit(pageSize).
+		Find(&notifications).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch notifications: %w", err)
+	}
+
+	return notifications, totalCount, nil
+}
+
+// GetByIDForUser returns a notification addressed to the user
+func (r *NotificationRepository) GetByIDForUser(ctx context.Context, id int, userID int) (*entities.Notification, error) {
+	var notification entities.Notification
+	result := r.DB(ctx).
+		Where("user_id = ?", userID).
+		First(&notification, id)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("notification with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch notification: %w", result.Error)
+	}
+
+	return &notification, nil
+}
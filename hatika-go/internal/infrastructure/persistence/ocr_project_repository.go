@@ -0,0 +1,258 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/specifications"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// OcrProjectRepository implements OCR project-specific repository operations
+type OcrProjectRepository struct {
+	*BaseRepository[entities.OcrProject, int]
+}
+
+// ocrProjectColumns are the OcrProjectDto fields OCR project lists can be sorted and filtered by
+var ocrProjectColumns = FieldColumns{
+	"id":               "id",
+	"createdAt":        "created_at",
+	"updatedAt":        "updated_at",
+	"projectId":        "project_id",
+	"projectCode":      "project_code",
+	"type":             "type",
+	"status":           "status",
+	"reviewerUserId":   "reviewer_user_id",
+	"approvedByUserId": "approved_by_user_id",
+	"approvedAt":       "approved_at",
+}
+
+// NewOcrProjectRepository creates a new OCR project repository
+func NewOcrProjectRepository(db *gorm.DB) *OcrProjectRepository {
+	return &OcrProjectRepository{
+		BaseRepository: NewBaseRepository[entities.OcrProject, int](db).WithColumns(ocrProjectColumns),
+	}
+}
+
+// GetByIDIncludingFieldResults retrieves an OCR project of the current tenant with its field results
+func (r *OcrProjectRepository) GetByIDIncludingFieldResults(ctx context.Context, id int) (*entities.OcrProject, error) {
+	var ocrProject entities.OcrProject
+	result := r.DB(ctx).
+		Preload("FieldResults", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		Scopes(currentTenantScope(ctx)).
+		First(&ocrProject, id)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("OCR project with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch OCR project: %w", result.Error)
+	}
+
+	return &ocrProject, nil
+}
+
+// CountByProjectID counts the OCR projects of a project, including removed ones so slot numbers stay unique
+func (r *OcrProjectRepository) CountByProjectID(ctx context.Context, projectID int) (int64, error) {
+	var count int64
+	if err := r.DB(ctx).
+		Model(&entities.OcrProject{}).
+		Where("project_id = ?", projectID).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count OCR projects: %w", err)
+	}
+	return count, nil
+}
+
+// ExistsActiveOfType reports whether a project already has a live OCR project of the given type
+func (r *OcrProjectRepository) ExistsActiveOfType(ctx context.Context, projectID int, ocrProjectType entities.OcrProjectType) (bool, error) {
+	var count int64
+	if err := r.DB(ctx).
+		Model(&entities.OcrProject{}).
+		Scopes(notDeletedScope).
+		Where("project_id = ? AND type = ?", projectID, ocrProjectType).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check OCR projects: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *OcrProjectRepository) SoftDelete(ctx context.Context, id int, userID int) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var ocrProject entities.OcrProject
+		if err := tx.First(&ocrProject, id).Error; err != nil {
+			return fmt.Errorf("OCR project not found: %w", err)
+		}
+
+		ocrProject.SoftDelete(userID)
+
+		if err := tx.Omit("FieldResults", "Project").Save(&ocrProject).Error; err != nil {
+			return fmt.Errorf("failed to soft delete OCR project: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetReviewQueue returns OCR projects of the current tenant waiting for review, oldest first, with only
+// the fields that need review. A non-nil groupIDs limits them to ungrouped projects and projects of
+// those groups.
+func (r *OcrProjectRepository) GetReviewQueue(
+	ctx context.Context,
+	groupIDs []int,
+	pageNumber, pageSize int,
+	sorting string,
+	spec specifications.Specification,
+) ([]entities.OcrProject, int64, error) {
+	// The queue is oldest first unless the client asks otherwise
+	orderScope, err := r.Sorting(sorting, SortField{Field: "updatedAt", Column: "updated_at"})
+	if err != nil {
+		return nil, 0, err
+	}
+	filterScope, err := r.Filter(spec)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := r.DB(ctx).
+		Model(&entities.OcrProject{}).
+		Where("status = ? AND is_deleted = ?", entities.OcrProjectStatusNeedsReview, false).
+		Scopes(currentTenantScope(ctx), filterScope)
+	if groupIDs != nil {
+		query = query.Where("project_id IN (SELECT id FROM projects WHERE group_id IS NULL OR group_id IN ?)", groupIDs)
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count review queue: %w", err)
+	}
+
+	var ocrProjects []entities.OcrProject
+	offset := (pageNumber - 1) * pageSize
+	if err := query.
+		Preload("FieldResults", "needs_review = ? AND is_approved = ?", true, false).
+		Scopes(orderScope).
+		Offset(offset).
+		Limit(pageSize).
+		Find(&ocrProjects).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch review queue: %w", err)
+	}
+
+	return ocrProjects, totalCount, nil
+}
+
+// ReplaceFieldResults swaps the stored field results for ocrProject.FieldResults and saves the
+// OCR project and, when given, its parent project in one transaction
+func (r *OcrProjectRepository) ReplaceFieldResults(
+	ctx context.Context,
+	ocrProject *entities.OcrProject,
+	project *entities.Project,
+) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("ocr_project_id = ?", ocrProject.ID).Delete(&entities.OcrFieldResult{}).Error; err != nil {
+			return fmt.Errorf("failed to clear field results: %w", err)
+		}
+
+		for i := range ocrProject.FieldResults {
+			ocrProject.FieldResults[i].OcrProjectID = ocrProject.ID
+			ocrProject.FieldResults[i].TenantID = ocrProject.TenantID
+		}
+
+		if len(ocrProject.FieldResults) > 0 {
+			if err := tx.Create(&ocrProject.FieldResults).Error; err != nil {
+				return fmt.Errorf("failed to create field results: %w", err)
+			}
+		}
+
+		if err := tx.Omit("FieldResults", "Project").Save(ocrProject).Error; err != nil {
+			return fmt.Errorf("failed to save OCR project: %w", err)
+		}
+
+		if project != nil {
+			if err := tx.Omit("OcrProjects").Save(project).Error; err != nil {
+				return fmt.Errorf("failed to update project: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// SaveReview persists the reviewed field results, the OCR project and, when given, its parent project together
+func (r *OcrProjectRepository) SaveReview(
+	ctx context.Context,
+	ocrProject *entities.OcrProject,
+	project *entities.Project,
+) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range ocrProject.FieldResults {
+			if err := tx.Save(&ocrProject.FieldResults[i]).Error; err != nil {
+				return fmt.Errorf("failed to save field result: %w", err)
+			}
+		}
+
+		if err := tx.Omit("FieldResults", "Project").Save(ocrProject).Error; err != nil {
+			return fmt.Errorf("failed to save OCR project: %w", err)
+		}
+
+		if project != nil {
+			if err := tx.Omit("OcrProjects").Save(project).Error; err != nil {
+				return fmt.Errorf("failed to update project: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// FindForReprocess returns up to limit live OCR projects of a tenant matching spec, oldest first;
+// a nil tenant means host-owned OCR projects. With groupIDs, only OCR projects whose project is
+// ungrouped or in one of the groups are returned.
+func (r *OcrProjectRepository) FindForReprocess(
+	ctx context.Context,
+	tenantID *int,
+	groupIDs []int,
+	spec specifications.Specification,
+	limit int,
+) ([]entities.OcrProject, error) {
+	filterScope, err := r.Filter(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.DB(ctx).Scopes(tenantScope(tenantID), notDeletedScope, filterScope)
+	if groupIDs != nil {
+		query = query.Where("project_id IN (SELECT id FROM projects WHERE group_id IS NULL OR group_id IN ?)", groupIDs)
+	}
+
+	var ocrProjects []entities.OcrProject
+	if err := query.
+		Order("id ASC").
+		Limit(limit).
+		Find(&ocrProjects).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch OCR projects: %w", err)
+	}
+	return ocrProjects, nil
+}
+
+// GetHumanApprovedByIDs returns the OCR projects among ids that a reviewer approved, with their field results
+func (r *OcrProjectRepository) GetHumanApprovedByIDs(ctx context.Context, ids []int) ([]entities.OcrProject, error) {
+	var ocrProjects []entities.OcrProject
+	if len(ids) == 0 {
+		return ocrProjects, nil
+	}
+
+	if err := r.DB(ctx).
+		Scopes(currentTenantScope(ctx)).
+		Where("id IN ? AND status = ? AND approved_by_user_id IS NOT NULL", ids, entities.OcrProjectStatusApproved).
+		Preload("FieldResults").
+		Find(&ocrProjects).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch approved OCR projects: %w", err)
+	}
+	return ocrProjects, nil
+}
@@ -0,0 +1,158 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// OcrProjectTemplateRepository implements OCR project template-specific repository operations
+type OcrProjectTemplateRepository struct {
+	*BaseRepository[entities.OcrProjectTemplate, int]
+}
+
+// NewOcrProjectTemplateRepository creates a new OCR project template repository
+func NewOcrProjectTemplateRepository(db *gorm.DB) *OcrProjectTemplateRepository {
+	return &OcrProjectTemplateRepository{
+		BaseRepository: NewBaseRepository[entities.OcrProjectTemplate, int](db),
+	}
+}
+
+func preloadTemplateItems(db *gorm.DB) *gorm.DB {
+	return db.Order("sort_order ASC, id ASC")
+}
+
+func (r *OcrProjectTemplateRepository) GetByIDIncludingItems(ctx context.Context, id int, tenantID *int) (*entities.OcrProjectTemplate, error) {
+	var template entities.OcrProjectTemplate
+	result := r.DB(ctx).
+		Scopes(tenantScope(tenantID), notDeletedScope).
+		Preload("Items", preloadTemplateItems).
+		First(&template, id)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("OCR project template with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch OCR project template: %w", result.Error)
+	}
+
+	return &template, nil
+}
+
+// GetAllIncludingItems lists the templates of a tenant
+func (r *OcrProjectTemplateRepository) GetAllIncludingItems(ctx context.Context, tenantID *int) ([]entities.OcrProjectTemplate, error) {
+	var templates []entities.OcrProjectTemplate
+	if err := r.DB(ctx).
+		Scopes(tenantScope(tenantID), notDeletedScope).
+		Preload("Items", preloadTemplateItems).
+		Order("group_id ASC NULLS FIRST, id ASC").
+		Find(&templates).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch OCR project templates: %w", err)
+	}
+	return templates, nil
+}
+
+// FindForProject picks the active template for a tenant and group: the group's template first,
+// then the tenant default, then the host default. It returns nil when none matches.
+func (r *OcrProjectTemplateRepository) FindForProject(ctx context.Context, tenantID *int, groupID *int) (*entities.OcrProjectTemplate, error) {
+	type templateScope struct {
+		tenantID *int
+		groupID  *int
+	}
+
+	var scopes []templateScope
+	if groupID != nil {
+		scopes = append(scopes, templateScope{tenantID, groupID})
+	}
+	scopes = append(scopes, templateScope{tenantID, nil})
+	if tenantID != nil {
+		scopes = append(scopes, templateScope{nil, nil})
+	}
+
+	for _, scope := range scopes {
+		query := r.DB(ctx).
+			Scopes(tenantScope(scope.tenantID), notDeletedScope).
+			Where("is_active = ?", true).
+			Preload("Items", preloadTemplateItems)
+		if scope.groupID == nil {
+			query = query.Where("group_id IS NULL")
+		} else {
+			query = query.Where("group_id = ?", *scope.groupID)
+		}
+
+		var template entities.OcrProjectTemplate
+		err := query.Order("id ASC").First(&template).Error
+		if err == nil {
+			return &template, nil
+		}
+		if err != gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("failed to resolve OCR project template: %w", err)
+		}
+	}
+
+	return nil, nil
+}
+
+// ExistsForScope reports whether another template already covers the tenant and group
+func (r *OcrProjectTemplateRepository) ExistsForScope(ctx context.Context, tenantID *int, groupID *int, excludeID int) (bool, error) {
+	query := r.DB(ctx).
+		Model(&entities.OcrProjectTemplate{}).
+		Scopes(tenantScope(tenantID), notDeletedScope).
+		Where("id <> ?", excludeID)
+	if groupID == nil {
+		query = query.Where("group_id IS NULL")
+	} else {
+		query = query.Where("group_id = ?", *groupID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check OCR project templates: %w", err)
+	}
+	return count > 0, nil
+}
+
+// UpdateWithItems saves the template and replaces its items in one transaction
+func (r *OcrProjectTemplateRepository) UpdateWithItems(ctx context.Context, template *entities.OcrProjectTemplate) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("template_id = ?", template.ID).Delete(&entities.OcrProjectTemplateItem{}).Error; err != nil {
+			return fmt.Errorf("failed to clear template items: %w", err)
+		}
+
+		for i := range template.Items {
+			template.Items[i].ID = 0
+			template.Items[i].TemplateID = template.ID
+		}
+		if len(template.Items) > 0 {
+			if err := tx.Create(&template.Items).Error; err != nil {
+				return fmt.Errorf("failed to create template items: %w", err)
+			}
+		}
+
+		if err := tx.Omit("Items").Save(template).Error; err != nil {
+			return fmt.Errorf("failed to update OCR project template: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *OcrProjectTemplateRepository) SoftDelete(ctx context.Context, id int, userID int) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var template entities.OcrProjectTemplate
+		if err := tx.First(&template, id).Error; err != nil {
+			return fmt.Errorf("OCR project template not found: %w", err)
+		}
+
+		template.SoftDelete(userID)
+
+		if err := tx.Save(&template).Error; err != nil {
+			return fmt.Errorf("failed to soft delete OCR project template: %w", err)
+		}
+
+		return nil
+	})
+}
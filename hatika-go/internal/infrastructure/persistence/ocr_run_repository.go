@@ -0,0 +1,132 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// OcrRunRepository implements OCR run-specific repository operations
+type OcrRunRepository struct {
+	*BaseRepository[entities.OcrRun, int]
+}
+
+// NewOcrRunRepository creates a new OCR run repository
+func NewOcrRunRepository(db *gorm.DB) *OcrRunRepository {
+	return &OcrRunRepository{
+		BaseRepository: NewBaseRepository[entities.OcrRun, int](db),
+	}
+}
+
+func preloadRunFields(db *gorm.DB) *gorm.DB {
+	return db.Order("id ASC")
+}
+
+// GetByIDIncludingFields loads a run of the current tenant with its fields
+func (r *OcrRunRepository) GetByIDIncludingFields(ctx context.Context, id int) (*entities.OcrRun, error) {
+	var run entities.OcrRun
+	result := r.DB(ctx).
+		Scopes(currentTenantScope(ctx)).
+		Preload("Fields", preloadRunFields).
+		First(&run, id)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("OCR run with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch OCR run: %w", result.Error)
+	}
+
+	return &run, nil
+}
+
+// GetByOcrProjectID lists the runs of an OCR project of the current tenant, newest first
+func (r *OcrRunRepository) GetByOcrProjectID(ctx context.Context, ocrProjectID int) ([]entities.OcrRun, error) {
+	var runs []entities.OcrRun
+	if err := r.DB(ctx).
+		Scopes(currentTenantScope(ctx)).
+		Where("ocr_project_id = ?", ocrProjectID).
+		Preload("Fields", preloadRunFields).
+		Order("id DESC").
+		Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch OCR runs: %w", err)
+	}
+	return runs, nil
+}
+
+// GetByBatchID lists the runs of the current tenant queued together by a bulk re-processing request.
+// With groupIDs, only runs of OCR projects whose project is ungrouped or in one of the groups are listed.
+func (r *OcrRunRepository) GetByBatchID(ctx context.Context, batchID string, groupIDs []int) ([]entities.OcrRun, error) {
+	query := r.DB(ctx).
+		Scopes(currentTenantScope(ctx)).
+		Where("batch_id = ?", batchID)
+	if groupIDs != nil {
+		query = query.Where("ocr_project_id IN (SELECT ocr_projects.id FROM ocr_projects JOIN projects ON projects.id = ocr_projects.project_id WHERE projects.group_id IS NULL OR projects.group_id IN ?)", groupIDs)
+	}
+
+	var runs []entities.OcrRun
+	if err := query.
+		Order("id ASC").
+		Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch OCR runs: %w", err)
+	}
+	return runs, nil
+}
+
+// SaveResult stores the outcome of a run together with the fields it produced
+func (r *OcrRunRepository) SaveResult(ctx context.Context, run *entities.OcrRun) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range run.Fields {
+			run.Fields[i].RunID = run.ID
+		}
+
+		if len(run.Fields) > 0 {
+			if err := tx.Create(&run.Fields).Error; err != nil {
+				return fmt.Errorf("failed to create run fields: %w", err)
+			}
+		}
+
+		if err := tx.Omit("Fields").Save(run).Error; err != nil {
+			return fmt.Errorf("failed to save OCR run: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// SaveState updates the status fields of a run without touching its stored fields
+func (r *OcrRunRepository) SaveState(ctx context.Context, run *entities.OcrRun) error {
+	if err := r.DB(ctx).Omit("Fields").Save(run).Error; err != nil {
+		return fmt.Errorf("failed to save OCR run: %w", err)
+	}
+	return nil
+}
+
+// GetLatestCompletedByEngine returns the newest completed run of each OCR project of a tenant for an
+// engine version; a nil tenant means host-owned runs.
+func (r *OcrRunRepository) GetLatestCompletedByEngine(
+	ctx context.Context,
+	tenantID *int,
+	engineName, engineVersion string,
+) ([]entities.OcrRun, error) {
+	latest := r.GetDB().
+		Model(&entities.OcrRun{}).
+		Select("MAX(id)").
+		Where("engine_name = ? AND engine_version = ? AND status = ?", engineName, engineVersion, entities.OcrRunStatusCompleted).
+		Group("ocr_project_id")
+
+	var runs []entities.OcrRun
+	if err := r.DB(ctx).
+		Scopes(tenantScope(tenantID)).
+		Where("id IN (?)", latest).
+		Preload("Fields", preloadRunFields).
+		Order("ocr_project_id ASC").
+		Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch OCR runs: %w", err)
+	}
+	return runs, nil
+}
@@ -0,0 +1,88 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/specifications"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/testutil"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
+)
+
+func TestOcrRunRepositoryReadsAreScopedToTenant(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	repo := persistence.NewOcrRunRepository(db)
+	ocrProjectRepo := persistence.NewOcrProjectRepository(db)
+	tenant := f.Tenant().Create()
+	other := f.Tenant().Create()
+
+	createRun := func(tenantID int) (*entities.OcrProject, *entities.OcrRun) {
+		t.Helper()
+		ocrProject := f.OcrProject(f.Project().ForTenant(tenantID).Create()).Create()
+		run := &entities.OcrRun{
+			MultiTenantEntity: entities.MultiTenantEntity{TenantID: ocrProject.TenantID},
+			OcrProjectID:      ocrProject.ID,
+			EngineName:        "engine",
+			EngineVersion:     "1",
+			BatchID:           "batch",
+			Status:            entities.OcrRunStatusCompleted,
+		}
+		if err := repo.Insert(context.Background(), run); err != nil {
+			t.Fatalf("failed to create OCR run: %v", err)
+		}
+		return ocrProject, run
+	}
+	ownOcrProject, own := createRun(tenant.ID)
+	foreignOcrProject, foreign := createRun(other.ID)
+	ctx := multitenancy.WithTenantID(context.Background(), &tenant.ID)
+
+	if _, err := repo.GetByIDIncludingFields(ctx, own.ID); err != nil {
+		t.Errorf("GetByIDIncludingFields(own) = %v", err)
+	}
+	if _, err := repo.GetByIDIncludingFields(ctx, foreign.ID); !apperrors.IsKind(err, apperrors.KindNotFound) {
+		t.Errorf("GetByIDIncludingFields(foreign) = %v, want not found", err)
+	}
+	if runs, err := repo.GetByOcrProjectID(ctx, foreignOcrProject.ID); err != nil || len(runs) != 0 {
+		t.Errorf("GetByOcrProjectID(foreign) = %d runs, %v", len(runs), err)
+	}
+	if runs, err := repo.GetByBatchID(ctx, "batch", nil); err != nil || len(runs) != 1 || runs[0].ID != own.ID {
+		t.Errorf("GetByBatchID = %v, %v, want only run %d", runs, err, own.ID)
+	}
+
+	for _, tt := range []struct {
+		name     string
+		tenantID *int
+		want     int
+	}{
+		{"tenant", &tenant.ID, ownOcrProject.ID},
+		{"host", nil, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			runs, err := repo.GetLatestCompletedByEngine(ctx, tt.tenantID, "engine", "1")
+			if err != nil {
+				t.Fatalf("GetLatestCompletedByEngine: %v", err)
+			}
+			ocrProjects, err := ocrProjectRepo.FindForReprocess(ctx, tt.tenantID, nil, specifications.And(), 10)
+			if err != nil {
+				t.Fatalf("FindForReprocess: %v", err)
+			}
+
+			if tt.want == 0 {
+				if len(runs) != 0 || len(ocrProjects) != 0 {
+					t.Errorf("host sees %d runs and %d OCR projects of tenants", len(runs), len(ocrProjects))
+				}
+				return
+			}
+			if len(runs) != 1 || runs[0].OcrProjectID != tt.want {
+				t.Errorf("latest runs = %v, want only the run of OCR project %d", runs, tt.want)
+			}
+			if len(ocrProjects) != 1 || ocrProjects[0].ID != tt.want {
+				t.Errorf("OCR projects to reprocess = %v, want only %d", ocrProjects, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxRepository implements outbox-specific repository operations
+type OutboxRepository struct {
+	*BaseRepository[entities.OutboxMessage, int]
+}
+
+// NewOutboxRepository creates a new outbox repository
+func NewOutboxRepository(db *gorm.DB) *OutboxRepository {
+	return &OutboxRepository{
+		BaseRepository: NewBaseRepository[entities.OutboxMessage, int](db),
+	}
+}
+
+// ProcessDue hands the pending messages due by now to process, oldest first, and saves the changes
+// process makes to them. On Postgres the messages stay row-locked until then and concurrent callers
+// skip them, so each message is processed by one relay at a time.
+func (r *OutboxRepository) ProcessDue(ctx context.Context, now time.Time, limit int, process func(messages []entities.OutboxMessage)) (int, error) {
+	count := 0
+	err := r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Where("status = ? AND next_attempt_at <= ?", entities.OutboxMessageStatusPending, now).
+			Order("id ASC").
+			Limit(limit)
+		if tx.Dialector.Name() == "postgres" {
+			query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		}
+
+		var messages []entities.OutboxMessage
+		if err := query.Find(&messages).Error; err != nil {
+			return fmt.Errorf("failed to fetch outbox messages: %w", err)
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+
+		process(messages)
+
+		for i := range messages {
+			if err := tx.Save(&messages[i]).Error; err != nil {
+				return fmt.Errorf("failed to update outbox message %d: %w", messages[i].ID, err)
+			}
+		}
+		count = len(messages)
+		return nil
+	})
+	return count, err
+}
+
+// DeletePublishedBefore removes messages published before the cutoff and returns how many went
+func (r *OutboxRepository) DeletePublishedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.DB(ctx).
+		Where("status = ? AND published_at < ?", entities.OutboxMessageStatusPublished, cutoff).
+		Delete(&entities.OutboxMessage{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete published outbox messages: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,38 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// PermissionRepository implements permission-specific repository operations
+type PermissionRepository struct {
+	*BaseRepository[entities.Permission, int]
+}
+
+// NewPermissionRepository creates a new permission repository
+func NewPermissionRepository(db *gorm.DB) *PermissionRepository {
+	return &PermissionRepository{
+		BaseRepository: NewBaseRepository[entities.Permission, int](db),
+	}
+}
+
+// DeleteWithGrants deletes permissions together with their grants to roles
+func (r *PermissionRepository) DeleteWithGrants(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM role_permissions WHERE permission_id IN ?", ids).Error; err != nil {
+			return fmt.Errorf("failed to delete permission grants: %w", err)
+		}
+		if err := tx.Delete(&entities.Permission{}, ids).Error; err != nil {
+			return fmt.Errorf("failed to delete permissions: %w", err)
+		}
+		return nil
+	})
+}
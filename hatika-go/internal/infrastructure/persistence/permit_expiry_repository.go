@@ -0,0 +1,51 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PermitExpiryReminderRepository implements permit expiry reminder-specific repository operations
+type PermitExpiryReminderRepository struct {
+	*BaseRepository[entities.PermitExpiryReminder, int]
+}
+
+// NewPermitExpiryReminderRepository creates a new permit expiry reminder repository
+func NewPermitExpiryReminderRepository(db *gorm.DB) *PermitExpiryReminderRepository {
+	return &PermitExpiryReminderRepository{
+		BaseRepository: NewBaseRepository[entities.PermitExpiryReminder, int](db),
+	}
+}
+
+// Record stores the reminder and its notifications in one transaction. It returns false without
+// writing anything when the reminder was already sent, e.g. by another replica.
+func (r *PermitExpiryReminderRepository) Record(
+	ctx context.Context,
+	reminder *entities.PermitExpiryReminder,
+	notifications []entities.Notification,
+) (bool, error) {
+	recorded := false
+	err := r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(reminder)
+		if result.Error != nil {
+			return fmt.Errorf("failed to record permit expiry reminder: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		recorded = true
+
+		if len(notifications) > 0 {
+			if err := tx.Create(&notifications).Error; err != nil {
+				return fmt.Errorf("failed to create notifications: %w", err)
+			}
+		}
+		return nil
+	})
+	return recorded, err
+}
@@ -0,0 +1,114 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// ProjectBulkOperationRepository implements project bulk operation-specific repository operations
+type ProjectBulkOperationRepository struct {
+	*BaseRepository[entities.ProjectBulkOperation, int]
+}
+
+// NewProjectBulkOperationRepository creates a new project bulk operation repository
+func NewProjectBulkOperationRepository(db *gorm.DB) *ProjectBulkOperationRepository {
+	return &ProjectBulkOperationRepository{
+		BaseRepository: NewBaseRepository[entities.ProjectBulkOperation, int](db),
+	}
+}
+
+// GetByIDForTenant returns a bulk operation of the tenant without its items
+func (r *ProjectBulkOperationRepository) GetByIDForTenant(ctx context.Context, id int, tenantID *int) (*entities.ProjectBulkOperation, error) {
+	var operation entities.ProjectBulkOperation
+	result := r.DB(ctx).
+		Scopes(tenantScope(tenantID)).
+		First(&operation, id)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("project bulk operation with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch project bulk operation: %w", result.Error)
+	}
+
+	return &operation, nil
+}
+
+// GetItems pages through the per-project results of a bulk operation in processing order, optionally
+// narrowed to one outcome
+func (r *ProjectBulkOperationRepository) GetItems(
+	ctx context.Context,
+	operationID int,
+	outcome *entities.ProjectBulkItemOutcome,
+	pageNumber, pageSize int,
+) ([]entities.ProjectBulkOperationItem, int64, error) {
+	query := r.DB(ctx).
+		Model(&entities.ProjectBulkOperationItem{}).
+		Where("operation_id = ?", operationID)
+	if outcome != nil {
+		query = query.Where("outcome = ?", *outcome)
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count project bulk operation items: %w", err)
+	}
+
+	var items []entities.ProjectBulkOperationItem
+	if err := query.
+		Order("id ASC").
+		Offset((pageNumber - 1) * pageSize).
+		Limit(pageSize).
+		Find(&items).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch project bulk operation items: %w", err)
+	}
+
+	return items, totalCount, nil
+}
+
+// SaveProgress stores processed items together with the updated counters of the operation
+func (r *ProjectBulkOperationRepository) SaveProgress(ctx context.Context, operation *entities.ProjectBulkOperation, items []entities.ProjectBulkOperationItem) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range items {
+			items[i].OperationID = operation.ID
+		}
+
+		if len(items) > 0 {
+			if err := tx.Create(&items).Error; err != nil {
+				return fmt.Errorf("failed to create project bulk operation items: %w", err)
+			}
+		}
+
+		if err := tx.Omit("Items").Save(operation).Error; err != nil {
+			return fmt.Errorf("failed to save project bulk operation: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// FailStale marks the operations still queued or running whose progress was last saved before the
+// cutoff as failed with the reason, and returns how many it failed
+func (r *ProjectBulkOperationRepository) FailStale(ctx context.Context, cutoff time.Time, reason string) (int64, error) {
+	result := r.DB(ctx).
+		Model(&entities.ProjectBulkOperation{}).
+		Where("status IN ? AND updated_at < ?", []entities.ProjectBulkOperationStatus{
+			entities.ProjectBulkOperationStatusQueued,
+			entities.ProjectBulkOperationStatusRunning,
+		}, cutoff).
+		Updates(map[string]interface{}{
+			"status":       entities.ProjectBulkOperationStatusFailed,
+			"error":        reason,
+			"completed_at": time.Now(),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to fail stale project bulk operations: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
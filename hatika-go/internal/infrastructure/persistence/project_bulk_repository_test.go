@@ -0,0 +1,62 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/testutil"
+)
+
+func TestProjectBulkOperationRepositoryFailStale(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	repo := persistence.NewProjectBulkOperationRepository(db)
+	cutoff := time.Now().Add(-10 * time.Minute)
+
+	create := func(status entities.ProjectBulkOperationStatus, updatedAt time.Time) *entities.ProjectBulkOperation {
+		t.Helper()
+		operation := &entities.ProjectBulkOperation{
+			BaseEntity: entities.BaseEntity{UpdatedAt: updatedAt},
+			Operation:  entities.ProjectBulkDelete,
+			Background: true,
+			Status:     status,
+		}
+		if err := db.Create(operation).Error; err != nil {
+			t.Fatalf("failed to create bulk operation: %v", err)
+		}
+		return operation
+	}
+	staleQueued := create(entities.ProjectBulkOperationStatusQueued, cutoff.Add(-time.Minute))
+	staleRunning := create(entities.ProjectBulkOperationStatusRunning, cutoff.Add(-time.Hour))
+	running := create(entities.ProjectBulkOperationStatusRunning, time.Now())
+	completed := create(entities.ProjectBulkOperationStatusCompleted, cutoff.Add(-time.Hour))
+
+	failed, err := repo.FailStale(context.Background(), cutoff, "interrupted")
+	if err != nil {
+		t.Fatalf("FailStale: %v", err)
+	}
+	if failed != 2 {
+		t.Errorf("failed %d operations, want 2", failed)
+	}
+
+	want := map[int]entities.ProjectBulkOperationStatus{
+		staleQueued.ID:  entities.ProjectBulkOperationStatusFailed,
+		staleRunning.ID: entities.ProjectBulkOperationStatusFailed,
+		running.ID:      entities.ProjectBulkOperationStatusRunning,
+		completed.ID:    entities.ProjectBulkOperationStatusCompleted,
+	}
+	for id, status := range want {
+		var operation entities.ProjectBulkOperation
+		if err := db.First(&operation, id).Error; err != nil {
+			t.Fatalf("failed to reload bulk operation %d: %v", id, err)
+		}
+		if operation.Status != status {
+			t.Errorf("operation %d is %s, want %s", id, operation.Status, status)
+		}
+		if status == entities.ProjectBulkOperationStatusFailed && (operation.Error != "interrupted" || operation.CompletedAt == nil) {
+			t.Errorf("operation %d failed with %q at %v", id, operation.Error, operation.CompletedAt)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// ProjectDocumentRepository implements uploaded document-specific repository operations
+type ProjectDocumentRepository struct {
+	*BaseRepository[entities.ProjectDocument, int]
+}
+
+// NewProjectDocumentRepository creates a new project document repository
+func NewProjectDocumentRepository(db *gorm.DB) *ProjectDocumentRepository {
+	return &ProjectDocumentRepository{
+		BaseRepository: NewBaseRepository[entities.ProjectDocument, int](db),
+	}
+}
+
+func preloadDocumentPages(db *gorm.DB) *gorm.DB {
+	return db.Order("page_number ASC")
+}
+
+// GetByIDIncludingPages loads a live document of a project of the current tenant with its pages
+func (r *ProjectDocumentRepository) GetByIDIncludingPages(ctx context.Context, projectID, id int) (*entities.ProjectDocument, error) {
+	var document entities.ProjectDocument
+	result := r.DB(ctx).
+		Scopes(currentTenantScope(ctx), notDeletedScope).
+		Where("project_id = ?", projectID).
+		Preload("Pages", preloadDocumentPages).
+		First(&document, id)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("document with ID %d not found in project %d", id, projectID)
+		}
+		return nil, fmt.Errorf("failed to fetch document: %w", result.Error)
+	}
+
+	return &document, nil
+}
+
+// GetByProjectIDIncludingPages lists the uploaded documents of a project of the current tenant
+func (r *ProjectDocumentRepository) GetByProjectIDIncludingPages(ctx context.Context, projectID int) ([]entities.ProjectDocument, error) {
+	var documents []entities.ProjectDocument
+	if err := r.DB(ctx).
+		Scopes(currentTenantScope(ctx), notDeletedScope).
+		Where("project_id = ?", projectID).
+		Preload("Pages", preloadDocumentPages).
+		Order("id ASC").
+		Find(&documents).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch documents: %w", err)
+	}
+	return documents, nil
+}
+
+// AssignPages moves a page range of a document to an OCR project, or unassigns it when ocrProjectID is nil
+func (r *ProjectDocumentRepository) AssignPages(ctx context.Context, documentID, startPage, endPage int, ocrProjectID *int) error {
+	result := r.DB(ctx).
+		Model(&entities.DocumentPage{}).
+		Where("document_id = ? AND page_number BETWEEN ? AND ?", documentID, startPage, endPage).
+		Updates(map[string]interface{}{
+			"ocr_project_id": ocrProjectID,
+			"is_manual":      true,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to assign pages: %w", result.Error)
+	}
+	return nil
+}
+
+// GetPagesByOcrProjectID returns the uploaded pages attached to an OCR project in document order
+func (r *ProjectDocumentRepository) GetPagesByOcrProjectID(ctx context.Context, ocrProjectID int) ([]entities.DocumentPage, error) {
+	var pages []entities.DocumentPage
+	if err := r.DB(ctx).
+		Joins("JOIN project_documents ON project_documents.id = document_pages.document_id").
+		Where("document_pages.ocr_project_id = ? AND project_documents.is_deleted = ?", ocrProjectID, false).
+		Order("document_pages.document_id ASC, document_pages.page_number ASC").
+		Find(&pages).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch document pages: %w", err)
+	}
+	return pages, nil
+}
@@ -0,0 +1,207 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// ProjectGroupRepository implements project group-specific repository operations
+type ProjectGroupRepository struct {
+	*BaseRepository[entities.ProjectGroup, int]
+}
+
+// projectGroupColumns are the ProjectGroupDto fields group lists can be sorted and filtered by
+var projectGroupColumns = FieldColumns{
+	"id":        "id",
+	"createdAt": "created_at",
+	"updatedAt": "updated_at",
+	"name":      "name",
+	"parentId":  "parent_id",
+}
+
+// NewProjectGroupRepository creates a new project group repository
+func NewProjectGroupRepository(db *gorm.DB) *ProjectGroupRepository {
+	return &ProjectGroupRepository{
+		BaseRepository: NewBaseRepository[entities.ProjectGroup, int](db).WithColumns(projectGroupColumns),
+	}
+}
+
+// ProjectGroupCounts are the project and OCR document counts of the projects directly in one group
+type ProjectGroupCounts struct {
+	GroupID                 int
+	ProjectCount            int64
+	OcrProjectCount         int64
+	ApprovedOcrProjectCount int64
+}
+
+// GetTree loads every live group of a tenant with the grants on them
+func (r *ProjectGroupRepository) GetTree(ctx context.Context, tenantID *int) (*entities.ProjectGroupTree, error) {
+	var groups []entities.ProjectGroup
+	if err := r.DB(ctx).
+		Scopes(tenantScope(tenantID), notDeletedScope).
+		Order("name ASC, id ASC").
+		Find(&groups).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch project groups: %w", err)
+	}
+
+	var grants []entities.ProjectGroupPermission
+	if len(groups) > 0 {
+		ids := make([]int, len(groups))
+		for i := range groups {
+			ids[i] = groups[i].ID
+		}
+		if err := r.DB(ctx).
+			Where("group_id IN ?", ids).
+			Find(&grants).Error; err != nil {
+			return nil, fmt.Errorf("failed to fetch project group permissions: %w", err)
+		}
+	}
+
+	return entities.NewProjectGroupTree(groups, grants), nil
+}
+
+// GetByIDForTenant returns a live group of the tenant
+func (r *ProjectGroupRepository) GetByIDForTenant(ctx context.Context, id int, tenantID *int) (*entities.ProjectGroup, error) {
+	var group entities.ProjectGroup
+	result := r.DB(ctx).
+		Scopes(tenantScope(tenantID), notDeletedScope).
+		First(&group, id)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("project group with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch project group: %w", result.Error)
+	}
+
+	return &group, nil
+}
+
+// ExistsWithName reports whether a sibling group of the tenant already has the name
+func (r *ProjectGroupRepository) ExistsWithName(ctx context.Context, tenantID *int, parentID *int, name string, excludeID int) (bool, error) {
+	query := r.DB(ctx).
+		Model(&entities.ProjectGroup{}).
+		Scopes(tenantScope(tenantID), notDeletedScope).
+		Where("LOWER(name) = LOWER(?) AND id <> ?", name, excludeID)
+	if parentID == nil {
+		query = query.Where("parent_id IS NULL")
+	} else {
+		query = query.Where("parent_id = ?", *parentID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check project groups: %w", err)
+	}
+	return count > 0, nil
+}
+
+// CountChildren counts the live subgroups of a group
+func (r *ProjectGroupRepository) CountChildren(ctx context.Context, id int) (int64, error) {
+	var count int64
+	if err := r.DB(ctx).
+		Model(&entities.ProjectGroup{}).
+		Scopes(notDeletedScope).
+		Where("parent_id = ?", id).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count subgroups: %w", err)
+	}
+	return count, nil
+}
+
+// CountProjects counts the live projects of a group
+func (r *ProjectGroupRepository) CountProjects(ctx context.Context, id int) (int64, error) {
+	var count int64
+	if err := r.DB(ctx).
+		Model(&entities.Project{}).
+		Scopes(notDeletedScope).
+		Where("group_id = ?", id).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count projects of group: %w", err)
+	}
+	return count, nil
+}
+
+// GetCounts returns the direct project and OCR document counts of the given groups
+func (r *ProjectGroupRepository) GetCounts(ctx context.Context, groupIDs []int) ([]ProjectGroupCounts, error) {
+	if len(groupIDs) == 0 {
+		return []ProjectGroupCounts{}, nil
+	}
+
+	var counts []ProjectGroupCounts
+	if err := r.DB(ctx).
+		Table("projects p").
+		Select(`p.group_id AS group_id,
+			COUNT(DISTINCT p.id) AS project_count,
+			COUNT(o.id) AS ocr_project_count,
+			SUM(CASE WHEN o.status = ? THEN 1 ELSE 0 END) AS approved_ocr_project_count`,
+			entities.OcrProjectStatusApproved).
+		Joins("LEFT JOIN ocr_projects o ON o.project_id = p.id AND o.is_deleted = ?", false).
+		Where("p.is_deleted = ? AND p.group_id IN ?", false, groupIDs).
+		Group("p.group_id").
+		Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count projects by group: %w", err)
+	}
+	return counts, nil
+}
+
+// GetPermissionUserIDs lists the users granted access to a group
+func (r *ProjectGroupRepository) GetPermissionUserIDs(ctx context.Context, groupID int) ([]int, error) {
+	userIDs := []int{}
+	if err := r.DB(ctx).
+		Model(&entities.ProjectGroupPermission{}).
+		Where("group_id = ?", groupID).
+		Order("user_id ASC").
+		Pluck("user_id", &userIDs).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch project group permissions: %w", err)
+	}
+	return userIDs, nil
+}
+
+// ReplacePermissions sets the users granted access to a group in one transaction
+func (r *ProjectGroupRepository) ReplacePermissions(ctx context.Context, groupID int, userIDs []int) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("group_id = ?", groupID).Delete(&entities.ProjectGroupPermission{}).Error; err != nil {
+			return fmt.Errorf("failed to clear project group permissions: %w", err)
+		}
+
+		if len(userIDs) == 0 {
+			return nil
+		}
+		grants := make([]entities.ProjectGroupPermission, len(userIDs))
+		for i, userID := range userIDs {
+			grants[i] = entities.ProjectGroupPermission{GroupID: groupID, UserID: userID}
+		}
+		if err := tx.Create(&grants).Error; err != nil {
+			return fmt.Errorf("failed to create project group permissions: %w", err)
+		}
+		return nil
+	})
+}
+
+// SoftDelete marks a group as deleted and drops its grants
+func (r *ProjectGroupRepository) SoftDelete(ctx context.Context, id int, userID int) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var group entities.ProjectGroup
+		if err := tx.First(&group, id).Error; err != nil {
+			return fmt.Errorf("project group not found: %w", err)
+		}
+
+		group.SoftDelete(userID)
+
+		if err := tx.Save(&group).Error; err != nil {
+			return fmt.Errorf("failed to soft delete project group: %w", err)
+		}
+
+		if err := tx.Where("group_id = ?", id).Delete(&entities.ProjectGroupPermission{}).Error; err != nil {
+			return fmt.Errorf("failed to delete project group permissions: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// ProjectImportRepository implements project import-specific repository operations
+type ProjectImportRepository struct {
+	*BaseRepository[entities.ProjectImport, int]
+}
+
+// NewProjectImportRepository creates a new project import repository
+func NewProjectImportRepository(db *gorm.DB) *ProjectImportRepository {
+	return &ProjectImportRepository{
+		BaseRepository: NewBaseRepository[entities.ProjectImport, int](db),
+	}
+}
+
+// GetByIDForTenant returns an import of the tenant without its rows
+func (r *ProjectImportRepository) GetByIDForTenant(ctx context.Context, id int, tenantID *int) (*entities.ProjectImport, error) {
+	var projectImport entities.ProjectImport
+	result := r.DB(ctx).
+		Scopes(tenantScope(tenantID)).
+		First(&projectImport, id)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("project import with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch project import: %w", result.Error)
+	}
+
+	return &projectImport, nil
+}
+
+// GetRows pages through the row results of an import in file order, optionally narrowed to one action
+func (r *ProjectImportRepository) GetRows(
+	ctx context.Context,
+	importID int,
+	action *entities.ProjectImportRowAction,
+	pageNumber, pageSize int,
+) ([]entities.ProjectImportRow, int64, error) {
+	query := r.DB(ctx).
+		Model(&entities.ProjectImportRow{}).
+		Where("import_id = ?", importID)
+	if action != nil {
+		query = query.Where("action = ?", *action)
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count project import rows: %w", err)
+	}
+
+	var rows []entities.ProjectImportRow
+	if err := query.
+		Order("row_number ASC").
+		Offset((pageNumber - 1) * pageSize).
+		Limit(pageSize).
+		Find(&rows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch project import rows: %w", err)
+	}
+
+	return rows, totalCount, nil
+}
+
+// SaveProgress stores processed rows together with the updated counters of the import
+func (r *ProjectImportRepository) SaveProgress(ctx context.Context, projectImport *entities.ProjectImport, rows []entities.ProjectImportRow) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range rows {
+			rows[i].ImportID = projectImport.ID
+		}
+
+		if len(rows) > 0 {
+			if err := tx.Create(&rows).Error; err != nil {
+				return fmt.Errorf("failed to create project import rows: %w", err)
+			}
+		}
+
+		if err := tx.Omit("Rows").Save(projectImport).Error; err != nil {
+			return fmt.Errorf("failed to save project import: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -3,10 +3,16 @@ package persistence
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/specifications"
+	"hatika-go/internal/domain/valueobjects"
+	apperrors "hatika-go/pkg/errors"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ProjectRepository implements project-specific repository operations
@@ -14,57 +20,122 @@ type ProjectRepository struct {
 	*BaseRepository[entities.Project, int]
 }
 
+// projectColumns are the ProjectDto fields the project list can be sorted and filtered by
+var projectColumns = FieldColumns{
+	"id":                   "id",
+	"createdAt":            "created_at",
+	"updatedAt":            "updated_at",
+	"projectName":          "project_name",
+	"projectCode":          "project_code",
+	"projectMuellef":       "project_muellef",
+	"bildirimNo":           "bildirim_no",
+	"groupId":              "group_id",
+	"ada":                  "ada",
+	"parsel":               "parsel",
+	"talepGucu":            "talep_gucu",
+	"kuruluGuc":            "kurulu_guc",
+	"ruhsatGecerlilikDate": "ruhsat_gecerlilik_date",
+	"yapiSahibi":           "yapi_sahibi",
+}
+
 // NewProjectRepository creates a new project repository
 func NewProjectRepository(db *gorm.DB) *ProjectRepository {
 	return &ProjectRepository{
-		BaseRepository: NewBaseRepository[entities.Project, int](db),
+		BaseRepository: NewBaseRepository[entities.Project, int](db).WithColumns(projectColumns),
 	}
 }
 
-// GetAllIncludingOcrProjects retrieves projects with OCR projects and pagination
+// GetAllIncludingOcrProjects retrieves projects of the current tenant with OCR projects and pagination
 func (r *ProjectRepository) GetAllIncludingOcrProjects(
 	ctx context.Context,
 	pageNumber, pageSize int,
-	filters map[string]interface{},
+	sorting string,
+	spec specifications.Specification,
 ) ([]entities.Project, int64, error) {
-	query := r.GetDB().WithContext(ctx).Preload("OcrProjects")
-
-	// Apply filters
-	if groupID, ok := filters["groupId"].(int); ok && groupID != 0 {
-		query = query.Where("group_id = ?", groupID)
+	orderScope, err := r.Sorting(sorting)
+	if err != nil {
+		return nil, 0, err
 	}
-
-	if bildirimNo, ok := filters["bildirimNo"].(string); ok && bildirimNo != "" {
-		query = query.Where("bildirim_no LIKE ?", "%"+bildirimNo+"%")
+	listScope, err := r.listScope(ctx, spec)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	if projectCode, ok := filters["projectCode"].(string); ok && projectCode != "" {
-		query = query.Where("project_code LIKE ?", "%"+projectCode+"%")
+	query := r.DB(ctx).
+		Preload("OcrProjects", "is_deleted = ?", false).
+		Scopes(listScope)
+
+	var totalCount int64
+	if err := query.Model(&entities.Project{}).Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count projects: %w", err)
+	}
+	//paged
+	var projects []entities.Project
+	offset := (pageNumber - 1) * pageSize
+	if err := query.
+		Scopes(orderScope).
+		Offset(offset).
+		Limit(pageSize).
+		Find(&projects).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch projects: %w", err)
 	}
 
-	if projectName, ok := filters["projectName"].(string); ok && projectName != "" {
-		query = query.Where("project_name LIKE ?", "%"+projectName+"%")
+	return projects, totalCount, nil
+}
+
+// GetByCursorIncludingOcrProjects retrieves a keyset-paginated page of projects of the current tenant
+// with their OCR projects
+func (r *ProjectRepository) GetByCursorIncludingOcrProjects(
+	ctx context.Context,
+	request CursorRequest,
+	spec specifications.Specification,
+) (*CursorPage[entities.Project], error) {
+	listScope, err := r.listScope(ctx, spec)
+	if err != nil {
+		return nil, err
 	}
 
-	if projectMuellef, ok := filters["projectMuellef"].(string); ok && projectMuellef != "" {
-		query = query.Where("project_muellef LIKE ?", "%"+projectMuellef+"%")
+	page, err := r.GetPageByCursor(ctx, request, listScope, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("OcrProjects", "is_deleted = ?", false)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch projects: %w", err)
 	}
+	return page, nil
+}
 
-	if idList, ok := filters["idList"].([]int); ok && len(idList) > 0 {
-		query = query.Where("id IN ?", idList)
+// GetPermitsExpiring pages through the live projects of the current tenant matching the specification
+// with their OCR projects; the caller narrows the specification to a permit date range
+func (r *ProjectRepository) GetPermitsExpiring(
+	ctx context.Context,
+	pageNumber, pageSize int,
+	sorting string,
+	spec specifications.Specification,
+) ([]entities.Project, int64, error) {
+	orderScope, err := r.Sorting(sorting, SortField{Column: "ruhsat_gecerlilik_date"})
+	if err != nil {
+		return nil, 0, err
 	}
+	listScope, err := r.listScope(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := r.DB(ctx).
+		Model(&entities.Project{}).
+		Scopes(listScope, notDeletedScope)
 
 	var totalCount int64
-	if err := query.Model(&entities.Project{}).Count(&totalCount).Error; err != nil {
+	if err := query.Count(&totalCount).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count projects: %w", err)
 	}
-	//paged
+
 	var projects []entities.Project
-	offset := (pageNumber - 1) * pageSize
 	if err := query.
-		Offset(offset).
+		Preload("OcrProjects", "is_deleted = ?", false).
+		Scopes(orderScope).
+		Offset((pageNumber - 1) * pageSize).
 		Limit(pageSize).
-		Order("id ASC").
 		Find(&projects).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to fetch projects: %w", err)
 	}
@@ -72,15 +143,130 @@ func (r *ProjectRepository) GetAllIncludingOcrProjects(
 	return projects, totalCount, nil
 }
 
+// FindPermitsExpiringBetween returns the live projects of every tenant whose permit expires within
+// the dates, both included
+func (r *ProjectRepository) FindPermitsExpiringBetween(ctx context.Context, from, until valueobjects.PermitDate) ([]entities.Project, error) {
+	var projects []entities.Project
+	if err := r.DB(ctx).
+		Scopes(notDeletedScope).
+		Where("ruhsat_gecerlilik_date BETWEEN ? AND ?", from, until).
+		Order("ruhsat_gecerlilik_date ASC, id ASC").
+		Find(&projects).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch projects with expiring permits: %w", err)
+	}
+	return projects, nil
+}
+
+// StreamAll calls fn for every project of the current tenant matching the specification, in sort order,
+// reading rows from a database cursor instead of loading the result into memory
+func (r *ProjectRepository) StreamAll(
+	ctx context.Context,
+	sorting string,
+	spec specifications.Specification,
+	fn func(project *entities.Project) error,
+) error {
+	orderScope, err := r.Sorting(sorting)
+	if err != nil {
+		return err
+	}
+	listScope, err := r.listScope(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.DB(ctx).
+		Model(&entities.Project{}).
+		Scopes(listScope, orderScope).
+		Rows()
+	if err != nil {
+		return fmt.Errorf("failed to query projects: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var project entities.Project
+		if err := r.GetDB().ScanRows(rows, &project); err != nil {
+			return fmt.Errorf("failed to read project: %w", err)
+		}
+		if err := fn(&project); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// ProjectBulkCandidate is a project selected by a bulk operation, with whether it is soft-deleted
+type ProjectBulkCandidate struct {
+	ID        int
+	IsDeleted bool
+}
+
+// FindBulkCandidates returns up to limit projects of the current tenant matching the specification in ID
+// order; deleted narrows them to soft-deleted or live projects, nil returns both
+func (r *ProjectRepository) FindBulkCandidates(
+	ctx context.Context,
+	spec specifications.Specification,
+	deleted *bool,
+	limit int,
+) ([]ProjectBulkCandidate, error) {
+	listScope, err := r.listScope(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.DB(ctx).
+		Model(&entities.Project{}).
+		Select("id", "is_deleted").
+		Scopes(listScope)
+	if deleted != nil {
+		query = query.Where("is_deleted = ?", *deleted)
+	}
+
+	var candidates []ProjectBulkCandidate
+	if err := query.
+		Order("id ASC").
+		Limit(limit).
+		Find(&candidates).Error; err != nil {
+		return nil, fmt.Errorf("failed to select projects: %w", err)
+	}
+	return candidates, nil
+}
+
+// listScope selects the projects of the current tenant matching the specification. The list, cursor
+// list, export and bulk selection all go through it so they cannot disagree on which projects exist.
+func (r *ProjectRepository) listScope(ctx context.Context, spec specifications.Specification) (func(db *gorm.DB) *gorm.DB, error) {
+	filterScope, err := r.Filter(spec)
+	if err != nil {
+		return nil, err
+	}
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Scopes(currentTenantScope(ctx), filterScope)
+	}, nil
+}
+
+// GetByID retrieves a project of the current tenant, deleted or not
+func (r *ProjectRepository) GetByID(ctx context.Context, id int) (*entities.Project, error) {
+	var project entities.Project
+	if err := r.DB(ctx).Scopes(currentTenantScope(ctx)).First(&project, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("project with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch project: %w", err)
+	}
+	return &project, nil
+}
+
+// GetByIDIncludingOcrProjects retrieves a project of the current tenant with its live OCR projects
 func (r *ProjectRepository) GetByIDIncludingOcrProjects(ctx context.Context, id int) (*entities.Project, error) {
 	var project entities.Project
-	result := r.GetDB().WithContext(ctx).
-		Preload("OcrProjects").
+	result := r.DB(ctx).
+		Preload("OcrProjects", "is_deleted = ?", false).
+		Scopes(currentTenantScope(ctx)).
 		First(&project, id)
 
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("project with ID %d not found", id)
+			return nil, apperrors.NotFound("project with ID %d not found", id)
 		}
 		return nil, fmt.Errorf("failed to fetch project: %w", result.Error)
 	}
@@ -88,21 +274,37 @@ func (r *ProjectRepository) GetByIDIncludingOcrProjects(ctx context.Context, id
 	return &project, nil
 }
 
-func (r *ProjectRepository) CreateWithOcrProjects(ctx context.Context, project *entities.Project) error {
-	return r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+// GetByProjectCodes returns the projects with the given codes across tenants, deleted ones included,
+// since project codes are unique over the whole table
+func (r *ProjectRepository) GetByProjectCodes(ctx context.Context, codes []string) ([]entities.Project, error) {
+	var projects []entities.Project
+	if len(codes) == 0 {
+		return projects, nil
+	}
+
+	if err := r.DB(ctx).
+		Where("project_code IN ?", codes).
+		Find(&projects).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch projects by code: %w", err)
+	}
+	return projects, nil
+}
+
+// CreateWithOcrProjects creates the project and one OCR project per template item
+func (r *ProjectRepository) CreateWithOcrProjects(ctx context.Context, project *entities.Project, template *entities.OcrProjectTemplate) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
 
 		if err := tx.Create(project).Error; err != nil {
 			return fmt.Errorf("failed to create project: %w", err)
 		}
 
-		ocrProjects := make([]entities.OcrProject, 4)
-		for i := 0; i < 4; i++ {
-			ocrProjects[i] = entities.OcrProject{
-				ProjectID:   project.ID,
-				ProjectName: project.ProjectName,
-				ProjectCode: fmt.Sprintf("%s-OCR-%d", project.ProjectCode, i+1),
-				Type:        entities.OcrProjectType(i),
-			}
+		if len(template.Items) == 0 {
+			return nil
+		}
+
+		ocrProjects := make([]entities.OcrProject, len(template.Items))
+		for i, item := range template.Items {
+			ocrProjects[i] = template.NewOcrProject(project, item, i+1)
 		}
 
 		if err := tx.Create(&ocrProjects).Error; err != nil {
@@ -113,8 +315,13 @@ func (r *ProjectRepository) CreateWithOcrProjects(ctx context.Context, project *
 	})
 }
 
+// projectChildren are the soft-deletable rows that belong to a project and share its deletion
+var projectChildren = []interface{}{&entities.OcrProject{}, &entities.ProjectDocument{}}
+
+// SoftDelete marks the project and its live OCR projects and documents as deleted. The children get
+// the deletion time of the project, which is how Restore tells them from ones deleted on their own.
 func (r *ProjectRepository) SoftDelete(ctx context.Context, id int, userID int) error {
-	return r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
 		var project entities.Project
 		if err := tx.First(&project, id).Error; err != nil {
 			return fmt.Errorf("project not found: %w", err)
@@ -126,6 +333,360 @@ func (r *ProjectRepository) SoftDelete(ctx context.Context, id int, userID int)
 			return fmt.Errorf("failed to soft delete project: %w", err)
 		}
 
+		for _, child := range projectChildren {
+			if err := tx.Model(child).
+				Where("project_id = ? AND is_deleted = ?", id, false).
+				Updates(map[string]interface{}{
+					"is_deleted":      true,
+					"deletion_time":   project.DeletionTime,
+					"deleter_user_id": userID,
+				}).Error; err != nil {
+				return fmt.Errorf("failed to soft delete project children: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// Restore takes a soft-deleted project and the OCR projects and documents deleted with it back out of
+// the recycle bin; children deleted before the project stay deleted
+func (r *ProjectRepository) Restore(ctx context.Context, project *entities.Project) error {
+	deletionTime := project.DeletionTime
+	project.Restore()
+
+	return r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(project).Error; err != nil {
+			return fmt.Errorf("failed to restore project: %w", err)
+		}
+		if deletionTime == nil {
+			return nil
+		}
+
+		for _, child := range projectChildren {
+			if err := tx.Model(child).
+				Where("project_id = ? AND is_deleted = ? AND deletion_time = ?", project.ID, true, *deletionTime).
+				Updates(map[string]interface{}{
+					"is_deleted":      false,
+					"deletion_time":   nil,
+					"deleter_user_id": nil,
+				}).Error; err != nil {
+				return fmt.Errorf("failed to restore project children: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// FindDeletedBefore returns up to limit projects of every tenant that were soft-deleted before the
+// cutoff, in ID order after afterID
+func (r *ProjectRepository) FindDeletedBefore(ctx context.Context, cutoff time.Time, afterID, limit int) ([]entities.Project, error) {
+	var projects []entities.Project
+	if err := r.DB(ctx).
+		Where("is_deleted = ? AND deletion_time < ? AND id > ?", true, cutoff, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&projects).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch deleted projects: %w", err)
+	}
+	return projects, nil
+}
+
+// ProjectPurge counts what a hard delete removed; StorageKeys are the stored files of its documents,
+// which are left for the caller to delete once the transaction is committed
+type ProjectPurge struct {
+	OcrProjects int64
+	Documents   int64
+	OcrRuns     int64
+	StorageKeys []string
+}
+
+// HardDelete permanently removes a project with its OCR projects, documents, OCR runs, reminders and
+// notifications
+func (r *ProjectRepository) HardDelete(ctx context.Context, id int) (*ProjectPurge, error) {
+	purge := &ProjectPurge{}
+	err := r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var ocrProjectIDs, documentIDs, runIDs []int
+		if err := tx.Model(&entities.OcrProject{}).Where("project_id = ?", id).Pluck("id", &ocrProjectIDs).Error; err != nil {
+			return fmt.Errorf("failed to fetch OCR projects: %w", err)
+		}
+		if err := tx.Model(&entities.ProjectDocument{}).Where("project_id = ?", id).Pluck("id", &documentIDs).Error; err != nil {
+			return fmt.Errorf("failed to fetch documents: %w", err)
+		}
+		if err := tx.Model(&entities.ProjectDocument{}).Where("project_id = ?", id).Pluck("storage_key", &purge.StorageKeys).Error; err != nil {
+			return fmt.Errorf("failed to fetch document files: %w", err)
+		}
+		if len(ocrProjectIDs) > 0 {
+			if err := tx.Model(&entities.OcrRun{}).Where("ocr_project_id IN ?", ocrProjectIDs).Pluck("id", &runIDs).Error; err != nil {
+				return fmt.Errorf("failed to fetch OCR runs: %w", err)
+			}
+		}
+
+		// Children first, so foreign keys never point at a removed row
+		remove := func(model interface{}, query string, ids interface{}) (int64, error) {
+			result := tx.Where(query, ids).Delete(model)
+			if result.Error != nil {
+				return 0, fmt.Errorf("failed to purge project %d: %w", id, result.Error)
+			}
+			return result.RowsAffected, nil
+		}
+
+		var err error
+		if len(runIDs) > 0 {
+			if _, err = remove(&entities.OcrRunField{}, "run_id IN ?", runIDs); err != nil {
+				return err
+			}
+			if purge.OcrRuns, err = remove(&entities.OcrRun{}, "id IN ?", runIDs); err != nil {
+				return err
+			}
+		}
+		if len(documentIDs) > 0 {
+			if _, err = remove(&entities.DocumentPage{}, "document_id IN ?", documentIDs); err != nil {
+				return err
+			}
+			if purge.Documents, err = remove(&entities.ProjectDocument{}, "id IN ?", documentIDs); err != nil {
+				return err
+			}
+		}
+		if len(ocrProjectIDs) > 0 {
+			if _, err = remove(&entities.OcrFieldResult{}, "ocr_project_id IN ?", ocrProjectIDs); err != nil {
+				return err
+			}
+			if purge.OcrProjects, err = remove(&entities.OcrProject{}, "id IN ?", ocrProjectIDs); err != nil {
+				return err
+			}
+		}
+		if _, err = remove(&entities.PermitExpiryReminder{}, "project_id = ?", id); err != nil {
+			return err
+		}
+		if _, err = remove(&entities.Notification{}, "project_id = ?", id); err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&entities.Project{}, id).Error; err != nil {
+			return fmt.Errorf("failed to purge project %d: %w", id, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return purge, nil
+}
+
+// UpdateWithLock loads a live project of the current tenant with its OCR projects under a row lock,
+// lets update modify it and saves the project in the same transaction
+func (r *ProjectRepository) UpdateWithLock(ctx context.Context, id int, update func(project *entities.Project) error) (*entities.Project, error) {
+	var project entities.Project
+	err := r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(currentTenantScope(ctx), notDeletedScope).
+			First(&project, id).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return apperrors.NotFound("project with ID %d not found", id)
+			}
+			return fmt.Errorf("failed to fetch project: %w", err)
+		}
+
+		if err := tx.Where("project_id = ? AND is_deleted = ?", id, false).
+			Order("type ASC, id ASC").
+			Find(&project.OcrProjects).Error; err != nil {
+			return fmt.Errorf("failed to fetch OCR projects: %w", err)
+		}
+
+		if err := update(&project); err != nil {
+			return err
+		}
+
+		if err := tx.Omit("OcrProjects").Save(&project).Error; err != nil {
+			return fmt.Errorf("failed to update project: %w", err)
+		}
+
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &project, nil
+}
+
+// ProjectSearchHit is one ranked full-text search result. Highlights hold ts_headline snippets of the
+// matching project fields keyed by field name; the page fields point at the best matching OCR page.
+// Snippets are HTML: the stored text is escaped and only the <mark> tags around matches are markup.
+type ProjectSearchHit struct {
+	ID          int
+	ProjectCode string
+	ProjectName string
+	GroupID     *int
+	Rank        float64
+	Highlights  map[string]string
+	DocumentID  *int
+	PageNumber  *int
+	PageSnippet *string
+}
+
+// projectSearchColumns are the project columns covered by the search vector
+var projectSearchColumns = []string{"project_name", "bildirim_no", "project_muellef", "yapi_sahibi", "adress"}
+
+// projectSearchRow is a row of the search query; highlights are NULL for fields that do not match
+type projectSearchRow struct {
+	ID                      int
+	ProjectCode             string
+	ProjectName             string
+	GroupID                 *int
+	Rank                    float64
+	ProjectNameHighlight    *string
+	BildirimNoHighlight     *string
+	ProjectMuellefHighlight *string
+	YapiSahibiHighlight     *string
+	AdressHighlight         *string
+	DocumentID              *int
+	PageNumber              *int
+	PageSnippet             *string
+}
+
+func (row *projectSearchRow) highlights() map[string]string {
+	highlights := make(map[string]string)
+	for field, snippet := range map[string]*string{
+		"projectName":    row.ProjectNameHighlight,
+		"bildirimNo":     row.BildirimNoHighlight,
+		"projectMuellef": row.ProjectMuellefHighlight,
+		"yapiSahibi":     row.YapiSahibiHighlight,
+		"adress":         row.AdressHighlight,
+	} {
+		if snippet != nil {
+			highlights[field] = *snippet
+		}
+	}
+	return highlights
+}
+
+const (
+	// fieldHeadlineOptions highlight short fields in full
+	fieldHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, HighlightAll=true"
+	// pageHeadlineOptions cut a few fragments out of a page of OCR text
+	pageHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=3, FragmentDelimiter=\" … \""
+)
+
+// projectSearchMatches selects the live projects of the tenant whose own fields or OCR page text match
+// @query, with their rank. Page matches count half as much as a match on the project itself.
+const projectSearchMatches = `
+	WITH q AS (SELECT websearch_to_tsquery('` + searchConfig + `', @query) AS query),
+	page_matches AS (
+		SELECT d.project_id, max(ts_rank_cd(dp.search_vector, q.query)) AS rank
+		FROM document_pages dp
+		JOIN project_documents d ON d.id = dp.document_id AND d.is_deleted = false AND {documentTenantFilter}
+		CROSS JOIN q
+		WHERE dp.search_vector @@ q.query
+		GROUP BY d.project_id
+	),
+	matches AS (
+		SELECT p.*, q.query,
+			ts_rank_cd(p.search_vector, q.query) + coalesce(pm.rank, 0) * 0.5 AS rank
+		FROM projects p
+		CROSS JOIN q
+		LEFT JOIN page_matches pm ON pm.project_id = p.id
+		WHERE p.is_deleted = false AND {projectTenantFilter}
+			AND (p.search_vector @@ q.query OR pm.project_id IS NOT NULL)
+			AND {groupFilter}
+	)`
+
+// escapeHTML wraps a text column so the characters HTML gives a meaning are escaped; ts_headline then
+// adds the <mark> tags as the only markup
+func escapeHTML(column string) string {
+	return `replace(replace(replace(replace(replace(` + column +
+		`, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+}
+
+// tenantCondition is the condition of tenantScope on the tenant_id column of a table alias
+func tenantCondition(alias string, tenantID *int, params map[string]interface{}) string {
+	if tenantID == nil {
+		return alias + ".tenant_id IS NULL"
+	}
+	params["tenant"] = *tenantID
+	return alias + ".tenant_id = @tenant"
+}
+
+// Search runs a ranked full-text search over the projects of the tenant and the OCR text of their
+// documents. The query uses web search syntax: words, "quoted phrases", or, and -excluded words. A
+// non-nil groupIDs limits the results to ungrouped projects and projects of those groups.
+func (r *ProjectRepository) Search(ctx context.Context, tenantID *int, query string, groupIDs []int, pageNumber, pageSize int) ([]ProjectSearchHit, int64, error) {
+	params := map[string]interface{}{
+		"query":  query,
+		"limit":  pageSize,
+		"offset": (pageNumber - 1) * pageSize,
+	}
+
+	documentTenantFilter := tenantCondition("d", tenantID, params)
+	matches := strings.NewReplacer(
+		"{documentTenantFilter}", documentTenantFilter,
+		"{projectTenantFilter}", tenantCondition("p", tenantID, params),
+	).Replace(projectSearchMatches)
+	switch {
+	case groupIDs == nil:
+		matches = strings.Replace(matches, "{groupFilter}", "true", 1)
+	case len(groupIDs) == 0:
+		matches = strings.Replace(matches, "{groupFilter}", "p.group_id IS NULL", 1)
+	default:
+		matches = strings.Replace(matches, "{groupFilter}", "(p.group_id IS NULL OR p.group_id IN @groups)", 1)
+		params["groups"] = groupIDs
+	}
+
+	var totalCount int64
+	if err := r.DB(ctx).
+		Raw(matches+` SELECT count(*) FROM matches`, params).
+		Scan(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count search results: %w", err)
+	}
+	if totalCount == 0 {
+		return []ProjectSearchHit{}, 0, nil
+	}
+
+	var highlights strings.Builder
+	for _, column := range projectSearchColumns {
+		fmt.Fprintf(&highlights,
+			",\n\t\tCASE WHEN to_tsvector('%[1]s', coalesce(hits.%[2]s, '')) @@ hits.query THEN ts_headline('%[1]s', %[4]s, hits.query, '%[3]s') END AS %[2]s_highlight",
+			searchConfig, column, fieldHeadlineOptions, escapeHTML("hits."+column))
+	}
+
+	// Headlines are expensive, so they are only computed for the rows of the requested page
+	sql := matches + `
+	SELECT hits.id, hits.project_code, hits.project_name, hits.group_id, hits.rank` + highlights.String() + `,
+		page.document_id, page.page_number, page.snippet AS page_snippet
+	FROM (SELECT * FROM matches ORDER BY rank DESC, id ASC LIMIT @limit OFFSET @offset) hits
+	LEFT JOIN LATERAL (
+		SELECT dp.document_id, dp.page_number,
+			ts_headline('` + searchConfig + `', ` + escapeHTML("dp.text") + `, hits.query, '` + pageHeadlineOptions + `') AS snippet
+		FROM document_pages dp
+		JOIN project_documents d ON d.id = dp.document_id AND d.is_deleted = false AND ` + documentTenantFilter + `
+		WHERE d.project_id = hits.id AND dp.search_vector @@ hits.query
+		ORDER BY ts_rank_cd(dp.search_vector, hits.query) DESC, dp.id ASC
+		LIMIT 1
+	) page ON true
+	ORDER BY hits.rank DESC, hits.id ASC`
+
+	var rows []projectSearchRow
+	if err := r.DB(ctx).Raw(sql, params).Scan(&rows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to search projects: %w", err)
+	}
+
+	hits := make([]ProjectSearchHit, len(rows))
+	for i := range rows {
+		row := &rows[i]
+		hits[i] = ProjectSearchHit{
+			ID:          row.ID,
+			ProjectCode: row.ProjectCode,
+			ProjectName: row.ProjectName,
+			GroupID:     row.GroupID,
+			Rank:        row.Rank,
+			Highlights:  row.highlights(),
+			DocumentID:  row.DocumentID,
+			PageNumber:  row.PageNumber,
+			PageSnippet: row.PageSnippet,
+		}
+	}
+
+	return hits, totalCount, nil
 }
@@ -0,0 +1,373 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/specifications"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/testutil"
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/multitenancy"
+)
+
+func TestProjectRepositoryFilter(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	repo := persistence.NewProjectRepository(db)
+	group := f.ProjectGroup().Create()
+
+	f.Project().WithCode("A-1").Named("Güneş Enerji Santrali").WithMuellef("Ayşe Demir").
+		WithKuruluGuc(250).WithTalepGucu(300).WithPermitDate("2025-03-01").InGroup(group.ID).Create()
+	f.Project().WithCode("A-2").Named("Rüzgar Türbini").WithMuellef("Mehmet Kaya").
+		WithKuruluGuc(1000).WithPermitDate("2026-06-15").Create()
+	f.Project().WithCode("B-1").Named("Çatı GES").WithBildirimNo("BN-77").
+		WithKuruluGuc(50).InGroup(group.ID).Create()
+	f.Project().WithCode("B-2").Named("Depo").Create()
+
+	tests := []struct {
+		name   string
+		filter string
+		want   []string
+	}{
+		{"equals", "projectCode eq 'A-2'", []string{"A-2"}},
+		{"not equals", "projectCode ne 'A-2'", []string{"A-1", "B-1", "B-2"}},
+		{"greater than", "kuruluGuc gt 50", []string{"A-1", "A-2"}},
+		{"at most", "kuruluGuc le 250", []string{"A-1", "B-1"}},
+		{"contains ignores case", "projectName contains 'ges'", []string{"B-1"}},
+		{"in", "projectCode in ('A-1', 'B-2', 'C-9')", []string{"A-1", "B-2"}},
+		{"null", "kuruluGuc eq null", []string{"B-2"}},
+		{"not null", "groupId ne null", []string{"A-1", "B-1"}},
+		{"not", "not (projectCode contains 'A')", []string{"B-1", "B-2"}},
+		{"and binds tighter than or", "bildirimNo eq 'BN-77' or kuruluGuc ge 250 and talepGucu eq null", []string{"A-2", "B-1"}},
+		{"parentheses", "(bildirimNo eq 'BN-77' or kuruluGuc ge 250) and groupId ne null", []string{"A-1", "B-1"}},
+		{"permit date", "ruhsatGecerlilikDate lt '2026-01-01'", []string{"A-1"}},
+		{"permit date in another format", "ruhsatGecerlilikDate ge '15.06.2026'", []string{"A-2"}},
+		{"no match", "projectMuellef eq 'Nobody'", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := repo.ParseFilter(tt.filter)
+			if err != nil {
+				t.Fatalf("ParseFilter(%q): %v", tt.filter, err)
+			}
+			projects, total, err := repo.GetAllIncludingOcrProjects(context.Background(), 1, 10, "projectCode asc", spec)
+			if err != nil {
+				t.Fatalf("GetAllIncludingOcrProjects: %v", err)
+			}
+			if got := projectCodes(projects); !equalStrings(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if total != int64(len(tt.want)) {
+				t.Errorf("total %d, want %d", total, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestProjectRepositoryFilterRejectsInvalidExpressions(t *testing.T) {
+	repo := persistence.NewProjectRepository(testutil.NewDatabase(t))
+
+	for _, filter := range []string{
+		"passwordHash eq 'x'",
+		"projectCode eq",
+		"kuruluGuc contains '5'",
+		"kuruluGuc gt 'many'",
+		"(projectCode eq 'A'",
+		"projectCode in ('A', null)",
+	} {
+		if _, err := repo.ParseFilter(filter); !apperrors.IsKind(err, apperrors.KindValidation) {
+			t.Errorf("ParseFilter(%q) = %v, want a validation error", filter, err)
+		}
+	}
+}
+
+func TestProjectRepositoryPaging(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	repo := persistence.NewProjectRepository(db)
+
+	for _, code := range []string{"P-5", "P-3", "P-1", "P-4", "P-2"} {
+		f.Project().WithCode(code).WithOcrProjects().Create()
+	}
+
+	projects, total, err := repo.GetAllIncludingOcrProjects(context.Background(), 2, 2, "projectCode desc", nil)
+	if err != nil {
+		t.Fatalf("GetAllIncludingOcrProjects: %v", err)
+	}
+	if total != 5 {
+		t.Errorf("total %d, want 5", total)
+	}
+	if got := projectCodes(projects); !equalStrings(got, []string{"P-3", "P-2"}) {
+		t.Errorf("got %v, want [P-3 P-2]", got)
+	}
+	for _, project := range projects {
+		if len(project.OcrProjects) != len(entities.DefaultOcrProjectTypes) {
+			t.Errorf("project %s has %d OCR projects, want %d", project.ProjectCode, len(project.OcrProjects), len(entities.DefaultOcrProjectTypes))
+		}
+	}
+
+	if _, _, err := repo.GetAllIncludingOcrProjects(context.Background(), 1, 10, "passwordHash asc", nil); !apperrors.IsKind(err, apperrors.KindValidation) {
+		t.Errorf("sorting by an unknown field = %v, want a validation error", err)
+	}
+}
+
+func TestProjectRepositoryFindBulkCandidatesIsScopedToTenant(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	repo := persistence.NewProjectRepository(db)
+	tenant := f.Tenant().Create()
+	other := f.Tenant().Create()
+
+	live := f.Project().ForTenant(tenant.ID).Create()
+	deleted := f.Project().ForTenant(tenant.ID).Deleted(1).Create()
+	f.Project().ForTenant(other.ID).Create()
+	host := f.Project().Create()
+
+	tests := []struct {
+		name     string
+		tenantID *int
+		deleted  *bool
+		want     []int
+	}{
+		{"tenant", &tenant.ID, nil, []int{live.ID, deleted.ID}},
+		{"tenant live", &tenant.ID, boolPtr(false), []int{live.ID}},
+		{"tenant deleted", &tenant.ID, boolPtr(true), []int{deleted.ID}},
+		{"host", nil, nil, []int{host.ID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := multitenancy.WithTenantID(context.Background(), tt.tenantID)
+			candidates, err := repo.FindBulkCandidates(ctx, nil, tt.deleted, 100)
+			if err != nil {
+				t.Fatalf("FindBulkCandidates: %v", err)
+			}
+			var got []int
+			for _, candidate := range candidates {
+				got = append(got, candidate.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	spec := specifications.Equals("projectCode", live.ProjectCode)
+	candidates, err := repo.FindBulkCandidates(multitenancy.WithTenantID(context.Background(), &other.ID), spec, nil, 100)
+	if err != nil {
+		t.Fatalf("FindBulkCandidates: %v", err)
+	}
+	if len(candidates) != 0 {
+		t.Errorf("another tenant selected %v", candidates)
+	}
+}
+
+func TestProjectRepositoryReadsAreScopedToCurrentTenant(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	repo := persistence.NewProjectRepository(db)
+	tenant := f.Tenant().Create()
+	other := f.Tenant().Create()
+
+	own := f.Project().ForTenant(tenant.ID).Create()
+	foreign := f.Project().ForTenant(other.ID).Create()
+	host := f.Project().Create()
+	ctx := multitenancy.WithTenantID(context.Background(), &tenant.ID)
+
+	projects, total, err := repo.GetAllIncludingOcrProjects(ctx, 1, 10, "", nil)
+	if err != nil {
+		t.Fatalf("GetAllIncludingOcrProjects: %v", err)
+	}
+	if total != 1 || len(projects) != 1 || projects[0].ID != own.ID {
+		t.Errorf("tenant lists %v, want only %s", projectCodes(projects), own.ProjectCode)
+	}
+
+	for _, id := range []int{foreign.ID, host.ID} {
+		if _, err := repo.GetByIDIncludingOcrProjects(ctx, id); !apperrors.IsKind(err, apperrors.KindNotFound) {
+			t.Errorf("GetByIDIncludingOcrProjects(%d) = %v, want not found", id, err)
+		}
+		if _, err := repo.GetByID(ctx, id); !apperrors.IsKind(err, apperrors.KindNotFound) {
+			t.Errorf("GetByID(%d) = %v, want not found", id, err)
+		}
+		updated := false
+		_, err := repo.UpdateWithLock(ctx, id, func(*entities.Project) error {
+			updated = true
+			return nil
+		})
+		if !apperrors.IsKind(err, apperrors.KindNotFound) || updated {
+			t.Errorf("UpdateWithLock(%d) = %v, want not found", id, err)
+		}
+	}
+	if project := reloadProject(t, context.Background(), repo, host.ID); project.ID != host.ID {
+		t.Errorf("host loaded project %d, want %d", project.ID, host.ID)
+	}
+}
+
+func TestProjectRepositorySoftDeleteCascadesToChildren(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	repo := persistence.NewProjectRepository(db)
+	fixtures := testutil.LoadFixtures(f)
+	ctx := multitenancy.WithTenantID(context.Background(), &fixtures.Tenant.ID)
+
+	project := f.Project().ForTenant(fixtures.Tenant.ID).WithOcrProjects(entities.ProjeAntenti, entities.Tapu).Create()
+	earlier := f.OcrProject(project).OfType(entities.YapiRuhsati).Deleted(fixtures.User.ID).Create()
+
+	if err := repo.SoftDelete(ctx, project.ID, fixtures.Admin.ID); err != nil {
+		t.Fatalf("SoftDelete: %v", err)
+	}
+
+	deleted := reloadProject(t, ctx, repo, project.ID)
+	if !deleted.IsDeleted || deleted.DeletionTime == nil || deleted.DeleterUserID == nil || *deleted.DeleterUserID != fixtures.Admin.ID {
+		t.Fatalf("project not marked deleted by the admin: %+v", deleted.FullAuditedEntity)
+	}
+	for _, ocrProject := range ocrProjectsOf(t, f, project.ID) {
+		if !ocrProject.IsDeleted {
+			t.Errorf("OCR project %d is still live", ocrProject.ID)
+		}
+		if ocrProject.ID == earlier.ID {
+			if *ocrProject.DeleterUserID != fixtures.User.ID {
+				t.Errorf("OCR project deleted earlier got deleter %d", *ocrProject.DeleterUserID)
+			}
+			continue
+		}
+		if !ocrProject.DeletionTime.Equal(*deleted.DeletionTime) {
+			t.Errorf("OCR project %d deleted at %v, project at %v", ocrProject.ID, ocrProject.DeletionTime, deleted.DeletionTime)
+		}
+	}
+
+	if err := repo.Restore(ctx, deleted); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	restored := reloadProject(t, ctx, repo, project.ID)
+	if restored.IsDeleted || restored.DeletionTime != nil || restored.DeleterUserID != nil {
+		t.Errorf("project still deleted: %+v", restored.FullAuditedEntity)
+	}
+	if len(restored.OcrProjects) != 2 {
+		t.Errorf("%d live OCR projects after restore, want 2", len(restored.OcrProjects))
+	}
+	for _, ocrProject := range ocrProjectsOf(t, f, project.ID) {
+		if ocrProject.ID == earlier.ID && !ocrProject.IsDeleted {
+			t.Errorf("OCR project deleted before the project was restored with it")
+		}
+	}
+}
+
+func TestProjectRepositoryPurgesExpiredProjects(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	repo := persistence.NewProjectRepository(db)
+	ctx := context.Background()
+
+	expired := f.Project().WithOcrProjects().Deleted(1).Create()
+	recent := f.Project().Deleted(1).Create()
+	f.Project().Create()
+
+	cutoff := time.Now().Add(-24 * time.Hour)
+	if err := db.Model(expired).UpdateColumn("deletion_time", cutoff.Add(-time.Hour)).Error; err != nil {
+		t.Fatalf("failed to backdate the deletion: %v", err)
+	}
+
+	projects, err := repo.FindDeletedBefore(ctx, cutoff, 0, 10)
+	if err != nil {
+		t.Fatalf("FindDeletedBefore: %v", err)
+	}
+	if len(projects) != 1 || projects[0].ID != expired.ID {
+		t.Fatalf("FindDeletedBefore returned %v, want only project %d and not %d", projectCodes(projects), expired.ID, recent.ID)
+	}
+	if projects, _ := repo.FindDeletedBefore(ctx, cutoff, expired.ID, 10); len(projects) != 0 {
+		t.Errorf("FindDeletedBefore after the last ID returned %v", projectCodes(projects))
+	}
+
+	purge, err := repo.HardDelete(ctx, expired.ID)
+	if err != nil {
+		t.Fatalf("HardDelete: %v", err)
+	}
+	if purge.OcrProjects != int64(len(entities.DefaultOcrProjectTypes)) {
+		t.Errorf("purged %d OCR projects, want %d", purge.OcrProjects, len(entities.DefaultOcrProjectTypes))
+	}
+	if _, err := repo.GetByID(ctx, expired.ID); err == nil {
+		t.Error("purged project can still be loaded")
+	}
+	if remaining := ocrProjectsOf(t, f, expired.ID); len(remaining) != 0 {
+		t.Errorf("%d OCR projects left behind", len(remaining))
+	}
+}
+
+func TestProjectRepositoryRejectsStaleUpdates(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	repo := persistence.NewProjectRepository(db)
+	ctx := context.Background()
+
+	project := f.Project().Create()
+	first := reloadProject(t, ctx, repo, project.ID)
+	second := reloadProject(t, ctx, repo, project.ID)
+
+	first.ProjectName = "First"
+	if err := repo.Update(ctx, first); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if first.Version != 2 {
+		t.Errorf("version %d after the first update, want 2", first.Version)
+	}
+
+	second.ProjectName = "Second"
+	if err := repo.Update(ctx, second); !apperrors.IsKind(err, apperrors.KindConcurrencyConflict) {
+		t.Fatalf("stale Update = %v, want a concurrency conflict", err)
+	}
+	if got := reloadProject(t, ctx, repo, project.ID).ProjectName; got != "First" {
+		t.Errorf("project name %q, want First", got)
+	}
+}
+
+func reloadProject(t *testing.T, ctx context.Context, repo *persistence.ProjectRepository, id int) *entities.Project {
+	t.Helper()
+	project, err := repo.GetByIDIncludingOcrProjects(ctx, id)
+	if err != nil {
+		t.Fatalf("failed to load project %d: %v", id, err)
+	}
+	return project
+}
+
+// ocrProjectsOf returns every OCR project of the project, deleted ones included
+func ocrProjectsOf(t *testing.T, f *testutil.Factory, projectID int) []entities.OcrProject {
+	t.Helper()
+	var ocrProjects []entities.OcrProject
+	if err := f.DB().Where("project_id = ?", projectID).Order("id ASC").Find(&ocrProjects).Error; err != nil {
+		t.Fatalf("failed to load OCR projects: %v", err)
+	}
+	return ocrProjects
+}
+
+func projectCodes(projects []entities.Project) []string {
+	var codes []string
+	for _, project := range projects {
+		codes = append(codes, project.ProjectCode)
+	}
+	return codes
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,67 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// roleColumns are the RoleDto fields role lists can be sorted and filtered by
+var roleColumns = FieldColumns{
+	"id":          "id",
+	"createdAt":   "created_at",
+	"name":        "name",
+	"displayName": "display_name",
+	"isStatic":    "is_static",
+	"isDefault":   "is_default",
+}
+
+// RoleRepository implements role-specific repository operations
+type RoleRepository struct {
+	*BaseRepository[entities.Role, int]
+}
+
+// NewRoleRepository creates a new role repository
+func NewRoleRepository(db *gorm.DB) *RoleRepository {
+	return &RoleRepository{
+		BaseRepository: NewBaseRepository[entities.Role, int](db).WithColumns(roleColumns),
+	}
+}
+
+// GetByName returns a live role with its permissions by its unique name
+func (r *RoleRepository) GetByName(ctx context.Context, name string) (*entities.Role, error) {
+	var role entities.Role
+	result := r.DB(ctx).
+		Preload("Permissions").
+		Scopes(notDeletedScope).
+		Where("name = ?", name).
+		First(&role)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("role %q not found", name)
+		}
+		return nil, fmt.Errorf("failed to fetch role: %w", result.Error)
+	}
+
+	return &role, nil
+}
+
+// GrantPermissions adds permissions to a role; permissions it already has are left alone
+func (r *RoleRepository) GrantPermissions(ctx context.Context, role *entities.Role, permissions []entities.Permission) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+	if err := r.DB(ctx).
+		Omit("Permissions.*").
+		Model(role).
+		Association("Permissions").
+		Append(permissions); err != nil {
+		return fmt.Errorf("failed to grant permissions to role %s: %w", role.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package persistence
+
+import (
+	"context"
+
+	"hatika-go/pkg/multitenancy"
+
+	"gorm.io/gorm"
+)
+
+// tenantScope restricts a query to one tenant; a nil tenant means host-owned rows
+func tenantScope(tenantID *int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if tenantID == nil {
+			return db.Where("tenant_id IS NULL")
+		}
+		return db.Where("tenant_id = ?", *tenantID)
+	}
+}
+
+// currentTenantScope restricts a query to the tenant of the request the context belongs to
+func currentTenantScope(ctx context.Context) func(db *gorm.DB) *gorm.DB {
+	return tenantScope(multitenancy.TenantIDFromContext(ctx))
+}
+
+// notDeletedScope hides soft-deleted rows
+func notDeletedScope(db *gorm.DB) *gorm.DB {
+	return db.Where("is_deleted = ?", false)
+}
@@ -0,0 +1,6 @@
+package persistence
+
+// searchConfig is the text search configuration used for every search vector and query. It is the
+// built-in Turkish configuration with unaccent in front of the stemmer, so "Yılmaz" and "yilmaz" match.
+// The configuration and the generated search_vector columns are created by the baseline migration.
+const searchConfig = "turkish_unaccent"
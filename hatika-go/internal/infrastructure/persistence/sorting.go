@@ -0,0 +1,70 @@
+package persistence
+
+import (
+	"strings"
+
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// SortField is one validated "field direction" term of a sorting expression
+type SortField struct {
+	Field  string
+	Column string
+	Desc   bool
+}
+
+// ParseSorting parses an expression such as "projectName desc, createdAt asc".
+// Field names are matched case-insensitively against the whitelist; anything else is a validation error.
+func ParseSorting(sorting string, columns FieldColumns) ([]SortField, error) {
+	var fields []SortField
+	seen := make(map[string]bool)
+
+	for _, term := range strings.Split(sorting, ",") {
+		parts := strings.Fields(term)
+		if len(parts) == 0 {
+			continue
+		}
+		if len(parts) > 2 {
+			return nil, apperrors.Validation("invalid sorting term %q", strings.TrimSpace(term))
+		}
+
+		field, column, ok := columns.lookup(parts[0])
+		if !ok {
+			return nil, apperrors.Validation("cannot sort by %q", parts[0]).
+				WithDetails(map[string]interface{}{"sortableFields": columns.fields()})
+		}
+		if seen[field] {
+			return nil, apperrors.Validation("field %q is sorted more than once", field)
+		}
+		seen[field] = true
+
+		desc := false
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return nil, apperrors.Validation("invalid sort direction %q, expected asc or desc", parts[1])
+			}
+		}
+
+		fields = append(fields, SortField{Field: field, Column: column, Desc: desc})
+	}
+
+	return fields, nil
+}
+
+// sortScope orders by the parsed fields, then by id so paging is stable.
+// With no fields the fallback order is used.
+func sortScope(fields []SortField, fallback ...SortField) func(db *gorm.DB) *gorm.DB {
+	if len(fields) == 0 {
+		fields = fallback
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Clauses(keysetOrder(keysetFields(fields), false))
+	}
+}
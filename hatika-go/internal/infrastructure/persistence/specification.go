@@ -0,0 +1,164 @@
+package persistence
+
+import (
+	"fmt"
+	"strings"
+
+	"hatika-go/internal/domain/specifications"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// matchNothing is used for empty IN lists and empty OR groups
+var matchNothing = clause.Expr{SQL: "1 = 0"}
+
+// Filter compiles a specification against the repository's whitelist into a where scope.
+// Unknown fields are a validation error rather than being ignored.
+func (r *BaseRepository[T, ID]) Filter(spec specifications.Specification) (func(db *gorm.DB) *gorm.DB, error) {
+	expression, err := compileSpecification(spec, r.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		if expression == nil {
+			return db
+		}
+		return db.Where(expression)
+	}, nil
+}
+
+// compileSpecification translates a specification to a parameterized expression; nil means no condition
+func compileSpecification(spec specifications.Specification, columns FieldColumns) (clause.Expression, error) {
+	switch s := spec.(type) {
+	case nil:
+		return nil, nil
+
+	case specifications.EqualsSpec:
+		column, err := filterColumn(columns, s.Field)
+		if err != nil {
+			return nil, err
+		}
+		if s.Value == nil {
+			return clause.Expr{SQL: "? IS NULL", Vars: []interface{}{column}}, nil
+		}
+		return clause.Expr{SQL: "? = ?", Vars: []interface{}{column, s.Value}}, nil
+
+	case specifications.CompareSpec:
+		column, err := filterColumn(columns, s.Field)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case s.Operator == specifications.OpNotEquals && s.Value == nil:
+			return clause.Expr{SQL: "? IS NOT NULL", Vars: []interface{}{column}}, nil
+		case s.Value == nil:
+			return nil, apperrors.Validation("cannot compare %q with null using %s", s.Field, s.Operator)
+		}
+		switch s.Operator {
+		case specifications.OpNotEquals, specifications.OpGreaterThan, specifications.OpGreaterOrEqual,
+			specifications.OpLessThan, specifications.OpLessOrEqual:
+			return clause.Expr{SQL: "? " + string(s.Operator) + " ?", Vars: []interface{}{column, s.Value}}, nil
+		}
+		return nil, fmt.Errorf("unsupported operator %q", s.Operator)
+
+	case specifications.ContainsSpec:
+		column, err := filterColumn(columns, s.Field)
+		if err != nil {
+			return nil, err
+		}
+		pattern := "%" + likeEscaper.Replace(s.Value) + "%"
+		return clause.Expr{SQL: `LOWER(?) LIKE LOWER(?) ESCAPE '\'`, Vars: []interface{}{column, pattern}}, nil
+
+	case specifications.InSpec:
+		column, err := filterColumn(columns, s.Field)
+		if err != nil {
+			return nil, err
+		}
+		if len(s.Values) == 0 {
+			return matchNothing, nil
+		}
+		return clause.Expr{SQL: "? IN ?", Vars: []interface{}{column, s.Values}}, nil
+
+	case specifications.RangeSpec:
+		column, err := filterColumn(columns, s.Field)
+		if err != nil {
+			return nil, err
+		}
+		var bounds []clause.Expression
+		if s.From != nil {
+			bounds = append(bounds, clause.Expr{SQL: "? >= ?", Vars: []interface{}{column, s.From}})
+		}
+		if s.To != nil {
+			bounds = append(bounds, clause.Expr{SQL: "? <= ?", Vars: []interface{}{column, s.To}})
+		}
+		return andExpression(bounds), nil
+
+	case specifications.AndSpec:
+		expressions, err := compileSpecifications(s.Specs, columns)
+		if err != nil {
+			return nil, err
+		}
+		return andExpression(expressions), nil
+
+	case specifications.OrSpec:
+		expressions, err := compileSpecifications(s.Specs, columns)
+		if err != nil {
+			return nil, err
+		}
+		if len(expressions) < len(s.Specs) {
+			// One branch has no condition, so the whole OR matches everything
+			return nil, nil
+		}
+		if len(expressions) == 0 {
+			return matchNothing, nil
+		}
+		return clause.Or(expressions...), nil
+
+	case specifications.NotSpec:
+		expression, err := compileSpecification(s.Spec, columns)
+		if err != nil {
+			return nil, err
+		}
+		if expression == nil {
+			return matchNothing, nil
+		}
+		return clause.Expr{SQL: "NOT (?)", Vars: []interface{}{expression}}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported specification %T", spec)
+}
+
+func compileSpecifications(specs []specifications.Specification, columns FieldColumns) ([]clause.Expression, error) {
+	expressions := make([]clause.Expression, 0, len(specs))
+	for _, spec := range specs {
+		expression, err := compileSpecification(spec, columns)
+		if err != nil {
+			return nil, err
+		}
+		if expression != nil {
+			expressions = append(expressions, expression)
+		}
+	}
+	return expressions, nil
+}
+
+func andExpression(expressions []clause.Expression) clause.Expression {
+	if len(expressions) == 0 {
+		return nil
+	}
+	return clause.And(expressions...)
+}
+
+func filterColumn(columns FieldColumns, field string) (clause.Column, error) {
+	_, column, ok := columns.lookup(field)
+	if !ok {
+		return clause.Column{}, apperrors.Validation("cannot filter by %q", field).
+			WithDetails(map[string]interface{}{"filterableFields": columns.fields()})
+	}
+	return clause.Column{Name: column}, nil
+}
@@ -0,0 +1,53 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// TenantRepository implements tenant-specific repository operations
+type TenantRepository struct {
+	*BaseRepository[entities.Tenant, int]
+}
+
+// NewTenantRepository creates a new tenant repository
+func NewTenantRepository(db *gorm.DB) *TenantRepository {
+	return &TenantRepository{
+		BaseRepository: NewBaseRepository[entities.Tenant, int](db),
+	}
+}
+
+// GetByTenancyName returns a live tenant by its unique tenancy name
+func (r *TenantRepository) GetByTenancyName(ctx context.Context, tenancyName string) (*entities.Tenant, error) {
+	var tenant entities.Tenant
+	result := r.DB(ctx).
+		Scopes(notDeletedScope).
+		Where("tenancy_name = ?", tenancyName).
+		First(&tenant)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("tenant %q not found", tenancyName)
+		}
+		return nil, fmt.Errorf("failed to fetch tenant: %w", result.Error)
+	}
+
+	return &tenant, nil
+}
+
+// ExistsByTenancyName reports whether any tenant, deleted ones included, uses the tenancy name
+func (r *TenantRepository) ExistsByTenancyName(ctx context.Context, tenancyName string) (bool, error) {
+	var count int64
+	if err := r.DB(ctx).
+		Model(&entities.Tenant{}).
+		Where("tenancy_name = ?", tenancyName).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check tenancy name: %w", err)
+	}
+	return count > 0, nil
+}
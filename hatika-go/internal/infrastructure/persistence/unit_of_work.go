@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type unitOfWorkKey struct{}
+
+// unitOfWork is the transaction a context carries, with the work waiting for it to commit
+type unitOfWork struct {
+	tx          *gorm.DB
+	parent      *unitOfWork
+	afterCommit []func()
+}
+
+// UnitOfWork runs work in one transaction carried by the context. Every repository method called with
+// that context joins the transaction, so changes spanning several repositories commit or roll back
+// together.
+type UnitOfWork struct {
+	db *gorm.DB
+}
+
+// NewUnitOfWork creates a unit of work over the database
+func NewUnitOfWork(db *gorm.DB) *UnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+// Do runs fn in a transaction, committed when fn returns nil and rolled back when it returns an error
+// or panics; the panic is re-raised after the rollback. Inside a unit of work already, fn runs in a
+// savepoint of the outer transaction and only the outermost commit is final.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	parent, _ := ctx.Value(unitOfWorkKey{}).(*unitOfWork)
+	db := u.db
+	if parent != nil {
+		db = parent.tx
+	}
+
+	work := &unitOfWork{parent: parent}
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		work.tx = tx
+		return fn(context.WithValue(ctx, unitOfWorkKey{}, work))
+	}); err != nil {
+		return err
+	}
+
+	if parent != nil {
+		parent.afterCommit = append(parent.afterCommit, work.afterCommit...)
+		return nil
+	}
+	for _, hook := range work.afterCommit {
+		hook()
+	}
+	return nil
+}
+
+// AfterCommit runs fn once the unit of work of the context commits, or right away outside one. It
+// is dropped when the unit of work rolls back. Work that must only see committed data, such as
+// starting a background job for a row just inserted, belongs here.
+func AfterCommit(ctx context.Context, fn func()) {
+	work, _ := ctx.Value(unitOfWorkKey{}).(*unitOfWork)
+	if work == nil {
+		fn()
+		return
+	}
+	work.afterCommit = append(work.afterCommit, fn)
+}
+
+// WithoutUnitOfWork returns a context that no longer carries a unit of work, for work outliving it
+func WithoutUnitOfWork(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unitOfWorkKey{}, (*unitOfWork)(nil))
+}
+
+// InUnitOfWork reports whether the context carries a unit of work
+func InUnitOfWork(ctx context.Context) bool {
+	work, _ := ctx.Value(unitOfWorkKey{}).(*unitOfWork)
+	return work != nil
+}
+
+// dbFor returns the transaction of the context's unit of work, or db outside one, bound to ctx
+func dbFor(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if work, _ := ctx.Value(unitOfWorkKey{}).(*unitOfWork); work != nil {
+		return work.tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// ErrRollback makes a unit of work roll back without reporting a failure of its own, for callers
+// that already handled the error, such as an HTTP request that was answered with an error status
+var ErrRollback = errors.New("unit of work rolled back")
@@ -0,0 +1,172 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/testutil"
+)
+
+func TestUnitOfWorkCommitsAcrossRepositories(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	unitOfWork := persistence.NewUnitOfWork(db)
+	projectRepo := persistence.NewProjectRepository(db)
+	ocrProjectRepo := persistence.NewOcrProjectRepository(db)
+
+	project := f.Project().Build()
+	committed := false
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		if !persistence.InUnitOfWork(ctx) {
+			t.Error("context does not carry the unit of work")
+		}
+		if err := projectRepo.Insert(ctx, project); err != nil {
+			return err
+		}
+		persistence.AfterCommit(ctx, func() { committed = true })
+		return ocrProjectRepo.Insert(ctx, f.OcrProject(project).Build())
+	})
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	if !committed {
+		t.Error("AfterCommit did not run after the commit")
+	}
+	if got := reloadProject(t, context.Background(), projectRepo, project.ID); len(got.OcrProjects) != 1 {
+		t.Errorf("%d OCR projects committed, want 1", len(got.OcrProjects))
+	}
+}
+
+func TestUnitOfWorkRollsBackOnError(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	unitOfWork := persistence.NewUnitOfWork(db)
+	projectRepo := persistence.NewProjectRepository(db)
+
+	failure := errors.New("failure")
+	committed := false
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		if err := projectRepo.Insert(ctx, f.Project().WithCode("ROLLED-BACK").Build()); err != nil {
+			return err
+		}
+		persistence.AfterCommit(ctx, func() { committed = true })
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("Do = %v, want the error of the work", err)
+	}
+
+	if committed {
+		t.Error("AfterCommit ran although the unit of work rolled back")
+	}
+	if count := countProjects(t, projectRepo); count != 0 {
+		t.Errorf("%d projects after rollback, want 0", count)
+	}
+}
+
+func TestUnitOfWorkRollsBackOnPanic(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	unitOfWork := persistence.NewUnitOfWork(db)
+	projectRepo := persistence.NewProjectRepository(db)
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("panic was not re-raised")
+			}
+		}()
+		_ = unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+			if err := projectRepo.Insert(ctx, f.Project().Build()); err != nil {
+				return err
+			}
+			panic("failure")
+		})
+	}()
+
+	if count := countProjects(t, projectRepo); count != 0 {
+		t.Errorf("%d projects after panic, want 0", count)
+	}
+}
+
+func TestUnitOfWorkNestedWorkRunsInSavepoint(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	unitOfWork := persistence.NewUnitOfWork(db)
+	projectRepo := persistence.NewProjectRepository(db)
+
+	var hooks []string
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		if err := projectRepo.Insert(ctx, f.Project().WithCode("OUTER").Build()); err != nil {
+			return err
+		}
+
+		// A failing item rolls back to its savepoint and the outer work goes on
+		err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+			if err := projectRepo.Insert(ctx, f.Project().WithCode("FAILED").Build()); err != nil {
+				return err
+			}
+			persistence.AfterCommit(ctx, func() { hooks = append(hooks, "FAILED") })
+			return persistence.ErrRollback
+		})
+		if !errors.Is(err, persistence.ErrRollback) {
+			t.Errorf("nested Do = %v, want ErrRollback", err)
+		}
+
+		return unitOfWork.Do(ctx, func(ctx context.Context) error {
+			persistence.AfterCommit(ctx, func() { hooks = append(hooks, "NESTED") })
+			if len(hooks) != 0 {
+				t.Error("AfterCommit of nested work ran before the outer commit")
+			}
+			return projectRepo.Insert(ctx, f.Project().WithCode("NESTED").Build())
+		})
+	})
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	projects, _, err := projectRepo.GetAllIncludingOcrProjects(context.Background(), 1, 10, "projectCode asc", nil)
+	if err != nil {
+		t.Fatalf("GetAllIncludingOcrProjects: %v", err)
+	}
+	if got := projectCodes(projects); !equalStrings(got, []string{"NESTED", "OUTER"}) {
+		t.Errorf("committed %v, want [NESTED OUTER]", got)
+	}
+	if !equalStrings(hooks, []string{"NESTED"}) {
+		t.Errorf("AfterCommit ran %v, want [NESTED]", hooks)
+	}
+}
+
+func TestWithoutUnitOfWorkLeavesTransaction(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	unitOfWork := persistence.NewUnitOfWork(db)
+
+	ran := false
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		detached := persistence.WithoutUnitOfWork(ctx)
+		if persistence.InUnitOfWork(detached) {
+			t.Error("detached context still carries the unit of work")
+		}
+		persistence.AfterCommit(detached, func() { ran = true })
+		if !ran {
+			t.Error("AfterCommit outside a unit of work did not run right away")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+}
+
+func countProjects(t *testing.T, repo *persistence.ProjectRepository) int64 {
+	t.Helper()
+	var count int64
+	if err := repo.GetDB().Model(&entities.Project{}).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count projects: %v", err)
+	}
+	return count
+}
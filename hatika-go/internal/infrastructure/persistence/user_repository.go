@@ -0,0 +1,145 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+// userColumns are the UserDto fields user lists can be sorted and filtered by
+var userColumns = FieldColumns{
+	"id":             "id",
+	"createdAt":      "created_at",
+	"username":       "username",
+	"email":          "email",
+	"name":           "name",
+	"surname":        "surname",
+	"isActive":       "is_active",
+	"emailConfirmed": "email_confirmed",
+}
+
+// UserRepository implements user-specific repository operations
+type UserRepository struct {
+	*BaseRepository[entities.User, int]
+}
+
+// NewUserRepository creates a new user repository
+func NewUserRepository(db *gorm.DB) *UserRepository {
+	return &UserRepository{
+		BaseRepository: NewBaseRepository[entities.User, int](db).WithColumns(userColumns),
+	}
+}
+
+// HasRole reports whether a user has the named role
+func (r *UserRepository) HasRole(ctx context.Context, userID int, roleName string) (bool, error) {
+	var count int64
+	if err := r.DB(ctx).
+		Table("user_roles ur").
+		Joins("JOIN roles r ON r.id = ur.role_id").
+		Where("ur.user_id = ? AND r.name = ? AND r.is_deleted = ?", userID, roleName, false).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check user roles: %w", err)
+	}
+	return count > 0, nil
+}
+
+// HasPermission reports whether a role of the user grants the named permission
+func (r *UserRepository) HasPermission(ctx context.Context, userID int, permissionName string) (bool, error) {
+	var count int64
+	if err := r.DB(ctx).
+		Table("user_roles ur").
+		Joins("JOIN roles r ON r.id = ur.role_id").
+		Joins("JOIN role_permissions rp ON rp.role_id = r.id").
+		Joins("JOIN permissions p ON p.id = rp.permission_id").
+		Where("ur.user_id = ? AND p.name = ? AND r.is_deleted = ?", userID, permissionName, false).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check user permissions: %w", err)
+	}
+	return count > 0, nil
+}
+
+// GetExistingIDs returns which of the given IDs belong to live users of the current tenant
+func (r *UserRepository) GetExistingIDs(ctx context.Context, ids []int) ([]int, error) {
+	existing := []int{}
+	if len(ids) == 0 {
+		return existing, nil
+	}
+	if err := r.DB(ctx).
+		Model(&entities.User{}).
+		Scopes(currentTenantScope(ctx), notDeletedScope).
+		Where("id IN ?", ids).
+		Pluck("id", &existing).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	return existing, nil
+}
+
+// GetByUsername returns a live user by their unique username
+func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*entities.User, error) {
+	var user entities.User
+	result := r.DB(ctx).
+		Scopes(notDeletedScope).
+		Where("username = ?", username).
+		First(&user)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("user %q not found", username)
+		}
+		return nil, fmt.Errorf("failed to fetch user: %w", result.Error)
+	}
+
+	return &user, nil
+}
+
+// FindTaken returns which of the username and email are already used, deleted users included, since
+// both columns are unique across the whole table
+func (r *UserRepository) FindTaken(ctx context.Context, username, email string) (bool, bool, error) {
+	var users []entities.User
+	if err := r.DB(ctx).
+		Select("username", "email").
+		Where("username = ? OR email = ?", username, email).
+		Find(&users).Error; err != nil {
+		return false, false, fmt.Errorf("failed to check users: %w", err)
+	}
+
+	usernameTaken, emailTaken := false, false
+	for _, user := range users {
+		usernameTaken = usernameTaken || user.Username == username
+		emailTaken = emailTaken || user.Email == email
+	}
+	return usernameTaken, emailTaken, nil
+}
+
+// CreateWithRoles inserts a user together with the grants of existing roles
+func (r *UserRepository) CreateWithRoles(ctx context.Context, user *entities.User, roles []entities.Role) error {
+	user.Roles = roles
+	if err := r.DB(ctx).Omit("Roles.*").Create(user).Error; err != nil {
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+	return nil
+}
+
+// SetPassword replaces a user's password hash and lifts any lockout
+func (r *UserRepository) SetPassword(ctx context.Context, id int, passwordHash string) error {
+	result := r.DB(ctx).
+		Model(&entities.User{}).
+		Scopes(notDeletedScope).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"password_hash":       passwordHash,
+			"access_failed_count": 0,
+			"lockout_end_date":    nil,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to set password: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("user with ID %d not found", id)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/testutil"
+)
+
+func TestUserRepositoryHasPermission(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	f := testutil.NewFactory(t, db)
+	repo := persistence.NewUserRepository(db)
+
+	reviewers := f.Role().WithPermissions(entities.OcrProjectsReview).Create()
+	editors := f.Role().WithPermissions(entities.ProjectsEdit).Create()
+	reviewer := f.User().WithRoles(editors, reviewers).Create()
+	editor := f.User().WithRoles(editors).Create()
+
+	tests := []struct {
+		name   string
+		userID int
+		want   bool
+	}{
+		{"granted by a role", reviewer.ID, true},
+		{"not granted", editor.ID, false},
+		{"unknown user", reviewer.ID + editor.ID, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.HasPermission(context.Background(), tt.userID, entities.OcrProjectsReview)
+			if err != nil {
+				t.Fatalf("HasPermission: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("HasPermission = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"reflect"
+
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const expectedVersionKey = "hatikago:expected_version"
+
+// RegisterVersioning makes every update of an entity with a Version column optimistic: saving a
+// loaded row adds "WHERE version = <loaded version>" and increments the version, and an update that
+// matches no row fails with a concurrency conflict instead of silently overwriting someone else's
+// change. Bulk updates through a map only increment the version. UpdateColumn(s), which skip hooks,
+// leave the version alone.
+func RegisterVersioning(db *gorm.DB) error {
+	if err := db.Callback().Update().Before("gorm:update").Register("hatikago:check_version", checkVersion); err != nil {
+		return err
+	}
+	return db.Callback().Update().After("gorm:update").Register("hatikago:verify_version", verifyVersion)
+}
+
+func checkVersion(db *gorm.DB) {
+	stmt := db.Statement
+	if db.Error != nil || stmt.Schema == nil || stmt.SkipHooks {
+		return
+	}
+	field := stmt.Schema.LookUpField("Version")
+	if field == nil || stmt.ReflectValue.Kind() != reflect.Struct {
+		return
+	}
+
+	if len(stmt.Selects) > 0 && !selects(stmt.Selects, "*") && !selects(stmt.Selects, field.Name) && !selects(stmt.Selects, field.DBName) {
+		stmt.Selects = append(stmt.Selects, field.DBName)
+	}
+
+	if stmt.Schema.PrioritizedPrimaryField == nil {
+		return
+	}
+	if _, isZero := stmt.Schema.PrioritizedPrimaryField.ValueOf(stmt.Context, stmt.ReflectValue); isZero {
+		// A bulk update such as Model(&Entity{}).Where(...).Updates(map) has no single loaded version
+		if _, isMap := stmt.Dest.(map[string]interface{}); isMap {
+			stmt.SetColumn(field.DBName, gorm.Expr(stmt.Quote(field.DBName)+" + 1"), true)
+		}
+		return
+	}
+
+	value, _ := field.ValueOf(stmt.Context, stmt.ReflectValue)
+	current, ok := value.(int)
+	if !ok {
+		return
+	}
+	stmt.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: current},
+	}})
+	stmt.SetColumn(field.Name, current+1, true)
+	if stmt.Dest != stmt.Model {
+		_ = field.Set(stmt.Context, stmt.ReflectValue, current+1)
+	}
+	db.InstanceSet(expectedVersionKey, current)
+}
+
+func verifyVersion(db *gorm.DB) {
+	value, ok := db.InstanceGet(expectedVersionKey)
+	if !ok || db.Error != nil {
+		return
+	}
+	stmt := db.Statement
+	expected := value.(int)
+	if db.RowsAffected > 0 {
+		return
+	}
+
+	// Put the loaded version back, so the caller still sees the row as it was before the update
+	_ = stmt.Schema.LookUpField("Version").Set(stmt.Context, stmt.ReflectValue, expected)
+	primaryKey, _ := stmt.Schema.PrioritizedPrimaryField.ValueOf(stmt.Context, stmt.ReflectValue)
+	_ = db.AddError(apperrors.ConcurrencyConflict(
+		"%s %v was changed or deleted by someone else since version %d; reload it and try again",
+		stmt.Schema.Name, primaryKey, expected))
+}
+
+func selects(columns []string, name string) bool {
+	for _, column := range columns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	apperrors "hatika-go/pkg/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WebhookSubscriptionRepository implements webhook subscription-specific repository operations
+type WebhookSubscriptionRepository struct {
+	*BaseRepository[entities.WebhookSubscription, int]
+}
+
+// NewWebhookSubscriptionRepository creates a new webhook subscription repository
+func NewWebhookSubscriptionRepository(db *gorm.DB) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{
+		BaseRepository: NewBaseRepository[entities.WebhookSubscription, int](db),
+	}
+}
+
+// GetAllForTenant returns the live subscriptions of a tenant, oldest first
+func (r *WebhookSubscriptionRepository) GetAllForTenant(ctx context.Context, tenantID *int) ([]entities.WebhookSubscription, error) {
+	var subscriptions []entities.WebhookSubscription
+	if err := r.DB(ctx).
+		Scopes(tenantScope(tenantID), notDeletedScope).
+		Order("id ASC").
+		Find(&subscriptions).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch webhook subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// GetByIDForTenant returns a live subscription of the tenant
+func (r *WebhookSubscriptionRepository) GetByIDForTenant(ctx context.Context, id int, tenantID *int) (*entities.WebhookSubscription, error) {
+	var subscription entities.WebhookSubscription
+	result := r.DB(ctx).
+		Scopes(tenantScope(tenantID), notDeletedScope).
+		First(&subscription, id)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("webhook subscription with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch webhook subscription: %w", result.Error)
+	}
+
+	return &subscription, nil
+}
+
+// GetActiveForEvent returns the active subscriptions of a tenant that want events with the name
+func (r *WebhookSubscriptionRepository) GetActiveForEvent(ctx context.Context, tenantID *int, eventName string) ([]entities.WebhookSubscription, error) {
+	var subscriptions []entities.WebhookSubscription
+	if err := r.DB(ctx).
+		Scopes(tenantScope(tenantID), notDeletedScope).
+		Where("is_active = ?", true).
+		Order("id ASC").
+		Find(&subscriptions).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch webhook subscriptions: %w", err)
+	}
+
+	// Event types are stored as JSON; filtering here keeps the query portable
+	matching := subscriptions[:0]
+	for _, subscription := range subscriptions {
+		if subscription.Subscribes(eventName) {
+			matching = append(matching, subscription)
+		}
+	}
+	return matching, nil
+}
+
+// WebhookDeliveryRepository implements webhook delivery-specific repository operations
+type WebhookDeliveryRepository struct {
+	*BaseRepository[entities.WebhookDelivery, int]
+}
+
+// NewWebhookDeliveryRepository creates a new webhook delivery repository
+func NewWebhookDeliveryRepository(db *gorm.DB) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		BaseRepository: NewBaseRepository[entities.WebhookDelivery, int](db),
+	}
+}
+
+// InsertNew adds deliveries, skipping those whose subscription already has a delivery of the event,
+// so fanning out the same event twice sends it once
+func (r *WebhookDeliveryRepository) InsertNew(ctx context.Context, deliveries []entities.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := r.DB(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}},
+			DoNothing: true,
+		}).
+		Create(&deliveries).Error; err != nil {
+		return fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// GetPageForSubscription pages through the deliveries of a subscription, newest first
+func (r *WebhookDeliveryRepository) GetPageForSubscription(
+	ctx context.Context,
+	subscriptionID int,
+	status entities.WebhookDeliveryStatus,
+	pageNumber, pageSize int,
+) ([]entities.WebhookDelivery, int64, error) {
+	query := r.DB(ctx).
+		Model(&entities.WebhookDelivery{}).
+		Where("subscription_id = ?", subscriptionID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	var deliveries []entities.WebhookDelivery
+	if err := query.
+		Order("id DESC").
+		Offset((pageNumber - 1) * pageSize).
+		Limit(pageSize).
+		Find(&deliveries).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch webhook deliveries: %w", err)
+	}
+
+	return deliveries, totalCount, nil
+}
+
+// GetByIDForSubscription returns a delivery of the subscription
+func (r *WebhookDeliveryRepository) GetByIDForSubscription(ctx context.Context, id int, subscriptionID int) (*entities.WebhookDelivery, error) {
+	var delivery entities.WebhookDelivery
+	result := r.DB(ctx).
+		Where("subscription_id = ?", subscriptionID).
+		First(&delivery, id)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, apperrors.NotFound("webhook delivery with ID %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch webhook delivery: %w", result.Error)
+	}
+
+	return &delivery, nil
+}
+
+// ClaimDue leases up to limit pending deliveries due by now, oldest first with their subscription, by
+// moving their next attempt to leaseUntil. The lease is committed before the caller sends them, so
+// no transaction stays open during the HTTP requests, and other workers skip the deliveries until the
+// lease runs out. A worker that stops before saving the outcome leaves them to be sent again then.
+func (r *WebhookDeliveryRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]entities.WebhookDelivery, error) {
+	var deliveries []entities.WebhookDelivery
+	err := r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Where("status = ? AND next_attempt_at <= ?", entities.WebhookDeliveryStatusPending, now).
+			Order("id ASC").
+			Limit(limit)
+		if tx.Dialector.Name() == "postgres" {
+			query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		}
+		if err := query.Preload("Subscription").Find(&deliveries).Error; err != nil {
+			return fmt.Errorf("failed to fetch webhook deliveries: %w", err)
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]int, len(deliveries))
+		for i := range deliveries {
+			ids[i] = deliveries[i].ID
+			deliveries[i].NextAttemptAt = leaseUntil
+		}
+		if err := tx.Model(&entities.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", leaseUntil).Error; err != nil {
+			return fmt.Errorf("failed to lease webhook deliveries: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// SaveOutcome stores the result of sending a claimed delivery, which also ends its lease
+func (r *WebhookDeliveryRepository) SaveOutcome(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	if err := r.DB(ctx).Omit("Subscription").Save(delivery).Error; err != nil {
+		return fmt.Errorf("failed to update webhook delivery %d: %w", delivery.ID, err)
+	}
+	return nil
+}
+
+// DeleteSucceededBefore removes deliveries that succeeded before the cutoff and returns how many went
+func (r *WebhookDeliveryRepository) DeleteSucceededBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.DB(ctx).
+		Where("status = ? AND delivered_at < ?", entities.WebhookDeliveryStatusSucceeded, cutoff).
+		Delete(&entities.WebhookDelivery{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete webhook deliveries: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
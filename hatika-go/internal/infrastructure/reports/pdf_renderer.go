@@ -0,0 +1,155 @@
+package reports
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+const (
+	pageMargin   = 15.0
+	lineHeight   = 6.0
+	labelWidth   = 60.0
+	fontFamily   = "body"
+	coreFontName = "Helvetica"
+)
+
+// ProjectSummary is the content of a one-project summary report
+type ProjectSummary struct {
+	Title     string
+	Subtitle  string
+	Fields    []SummaryField
+	Documents []SummaryDocument
+	Footer    string
+}
+
+// SummaryField is a labelled value of the summary
+type SummaryField struct {
+	Label string
+	Value string
+}
+
+// SummaryDocument is a row of the document table of the summary
+type SummaryDocument struct {
+	Code   string
+	Type   string
+	Status string
+	Detail string
+}
+
+// PdfRenderer renders reports as PDF. Without a TrueType font the built-in Helvetica is used,
+// which lacks ı, ğ and ş, so those are written without accents.
+type PdfRenderer struct {
+	fontPath string
+}
+
+// NewPdfRenderer creates a PDF renderer; fontPath is an optional UTF-8 capable .ttf file
+func NewPdfRenderer(fontPath string) *PdfRenderer {
+	return &PdfRenderer{fontPath: fontPath}
+}
+
+// RenderProjectSummary writes the summary as an A4 PDF
+func (r *PdfRenderer) RenderProjectSummary(w io.Writer, summary *ProjectSummary) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin+5)
+
+	family, text := r.setupFont(pdf)
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-pageMargin)
+		pdf.SetFont(family, "", 8)
+		pdf.SetTextColor(128, 128, 128)
+		pdf.CellFormat(0, 5, text(summary.Footer), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 5, fmt.Sprintf("%d / {nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+	})
+	pdf.AliasNbPages("")
+	pdf.AddPage()
+
+	pdf.SetFont(family, "B", 16)
+	pdf.MultiCell(0, 8, text(summary.Title), "", "L", false)
+	if summary.Subtitle != "" {
+		pdf.SetFont(family, "", 10)
+		pdf.SetTextColor(96, 96, 96)
+		pdf.MultiCell(0, lineHeight, text(summary.Subtitle), "", "L", false)
+		pdf.SetTextColor(0, 0, 0)
+	}
+	pdf.Ln(4)
+
+	contentWidth, _ := pdf.GetPageSize()
+	contentWidth -= 2 * pageMargin
+
+	for _, field := range summary.Fields {
+		pdf.SetFont(family, "B", 10)
+		y := pdf.GetY()
+		pdf.CellFormat(labelWidth, lineHeight, text(field.Label), "", 0, "L", false, 0, "")
+		pdf.SetFont(family, "", 10)
+		value := field.Value
+		if value == "" {
+			value = "-"
+		}
+		pdf.SetXY(pageMargin+labelWidth, y)
+		pdf.MultiCell(contentWidth-labelWidth, lineHeight, text(value), "", "L", false)
+	}
+
+	if len(summary.Documents) > 0 {
+		pdf.Ln(6)
+		widths := []float64{55, 45, 30, contentWidth - 130}
+
+		pdf.SetFont(family, "B", 10)
+		pdf.SetFillColor(230, 230, 230)
+		for i, header := range []string{"Kod", "Belge", "Durum", "Ayrıntı"} {
+			pdf.CellFormat(widths[i], lineHeight+1, text(header), "1", 0, "L", true, 0, "")
+		}
+		pdf.Ln(-1)
+
+		pdf.SetFont(family, "", 9)
+		for _, document := range summary.Documents {
+			for i, cell := range []string{document.Code, document.Type, document.Status, document.Detail} {
+				pdf.CellFormat(widths[i], lineHeight, fitText(pdf, cell, widths[i]-2, text), "1", 0, "L", false, 0, "")
+			}
+			pdf.Ln(-1)
+		}
+	}
+
+	return pdf.Output(w)
+}
+
+// setupFont registers the configured TrueType font, falling back to Helvetica when there is none or it cannot be loaded
+func (r *PdfRenderer) setupFont(pdf *gofpdf.Fpdf) (string, func(string) string) {
+	if r.fontPath != "" {
+		pdf.AddUTF8Font(fontFamily, "", r.fontPath)
+		pdf.AddUTF8Font(fontFamily, "B", r.fontPath)
+		if !pdf.Err() {
+			return fontFamily, func(s string) string { return s }
+		}
+		log.Printf("Warning: could not load PDF font %s: %v", r.fontPath, pdf.Error())
+		pdf.ClearError()
+	}
+
+	// Core fonts expect cp1252 bytes rather than UTF-8
+	toCp1252 := pdf.UnicodeTranslatorFromDescriptor("")
+	return coreFontName, func(s string) string { return toCp1252(foldTurkish(s)) }
+}
+
+// fitText encodes s with text, shortening it with an ellipsis until it fits the width
+func fitText(pdf *gofpdf.Fpdf, s string, width float64, text func(string) string) string {
+	if encoded := text(s); pdf.GetStringWidth(encoded) <= width {
+		return encoded
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && pdf.GetStringWidth(text(string(runes)+"...")) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return text(string(runes) + "...")
+}
+
+var turkishFolding = strings.NewReplacer("ı", "i", "İ", "I", "ğ", "g", "Ğ", "G", "ş", "s", "Ş", "S")
+
+// foldTurkish replaces the Turkish letters missing from cp1252, the encoding of the core fonts
+func foldTurkish(s string) string {
+	return turkishFolding.Replace(s)
+}
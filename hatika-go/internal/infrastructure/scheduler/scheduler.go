@@ -0,0 +1,130 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"time"
+	// Embedded zone data so the configured time zone loads in minimal containers
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+	"gorm.io/gorm"
+)
+
+// Job is a unit of scheduled work. The context is cancelled when the scheduler stops.
+type Job func(ctx context.Context) error
+
+// Scheduler runs jobs on cron schedules inside the API process. On Postgres every run holds a
+// session advisory lock named after the job, so when several replicas fire at the same time only
+// one of them does the work and the others skip that run. Jobs should still be idempotent: a replica
+// whose clock is late can take the lock after the first run released it.
+type Scheduler struct {
+	db     *gorm.DB
+	cron   *cron.Cron
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// New creates a scheduler evaluating schedules in the location
+func New(db *gorm.DB, location *time.Location) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	logger := cron.PrintfLogger(log.Default())
+	return &Scheduler{
+		db: db,
+		cron: cron.New(
+			cron.WithLocation(location),
+			cron.WithChain(cron.Recover(logger), cron.SkipIfStillRunning(logger)),
+		),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// LoadLocation resolves a configured time zone name; an empty name is the server's local zone
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.Local, nil
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q: %w", name, err)
+	}
+	return location, nil
+}
+
+// Register adds a job with a five-field cron schedule such as "0 7 * * *"
+func (s *Scheduler) Register(name, schedule string, job Job) error {
+	if _, err := s.cron.AddFunc(schedule, func() { s.run(name, job) }); err != nil {
+		return fmt.Errorf("invalid schedule %q for job %s: %w", schedule, name, err)
+	}
+	log.Printf("Scheduled job %s: %s", name, schedule)
+	return nil
+}
+
+// Start begins running the registered jobs in the background
+func (s *Scheduler) Start() {
+	s.cron.Start()
+}
+
+// Stop cancels running jobs and waits for them to return
+func (s *Scheduler) Stop() {
+	s.cancel()
+	<-s.cron.Stop().Done()
+}
+
+func (s *Scheduler) run(name string, job Job) {
+	started := time.Now()
+	ran, err := s.withLock(name, job)
+	switch {
+	case err != nil:
+		log.Printf("Job %s failed after %s: %v", name, time.Since(started).Round(time.Millisecond), err)
+	case ran:
+		log.Printf("Job %s completed in %s", name, time.Since(started).Round(time.Millisecond))
+	default:
+		log.Printf("Job %s skipped, another instance holds its lock", name)
+	}
+}
+
+// withLock runs the job while holding its advisory lock. Session locks belong to a connection, so
+// the lock is taken and released on one dedicated connection of the pool.
+func (s *Scheduler) withLock(name string, job Job) (bool, error) {
+	if s.db.Dialector.Name() != "postgres" {
+		return true, job(s.ctx)
+	}
+
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return false, err
+	}
+	conn, err := sqlDB.Conn(s.ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get a connection for the job lock: %w", err)
+	}
+	defer conn.Close()
+
+	key := lockKey(name)
+	var locked bool
+	if err := conn.QueryRowContext(s.ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&locked); err != nil {
+		return false, fmt.Errorf("failed to take the job lock: %w", err)
+	}
+	if !locked {
+		return false, nil
+	}
+	defer func() {
+		// The job context may be cancelled by now; unlock regardless so the connection returns clean
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			log.Printf("Warning: failed to release the lock of job %s: %v", name, err)
+		}
+	}()
+
+	return true, job(s.ctx)
+}
+
+// lockKey derives the 64-bit advisory lock key of a job from its name
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("hatikago:job:" + name))
+	return int64(h.Sum64())
+}
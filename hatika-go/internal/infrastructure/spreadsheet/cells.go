@@ -0,0 +1,68 @@
+package spreadsheet
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// dateLayouts are the date formats accepted in text cells; "2.1.2006" also matches zero padded days and months
+var dateLayouts = []string{"2006-01-02", "2.1.2006", "2/1/2006"}
+
+// groupedInt matches integers written with dots or spaces as thousand separators, e.g. "1.250"
+var groupedInt = regexp.MustCompile(`^-?\d{1,3}([. ]\d{3})+$`)
+
+// ParseInt reads an integer cell, accepting thousand separators and a zero fraction such as "12,0"
+func ParseInt(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if groupedInt.MatchString(value) {
+		value = strings.NewReplacer(".", "", " ", "").Replace(value)
+	}
+	if n, err := strconv.Atoi(value); err == nil {
+		return n, nil
+	}
+
+	f, err := ParseFloat(value)
+	if err != nil || f != float64(int(f)) {
+		return 0, fmt.Errorf("%q is not a whole number", value)
+	}
+	return int(f), nil
+}
+
+// ParseFloat reads a decimal cell in either "12.5" or the Turkish "1.234,5" notation
+func ParseFloat(value string) (float64, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), " ", "")
+	if strings.Contains(value, ",") {
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.Replace(value, ",", ".", 1)
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", strings.TrimSpace(value))
+	}
+	return f, nil
+}
+
+// ParseDate reads a date cell written as yyyy-MM-dd, dd.MM.yyyy or dd/MM/yyyy, or stored as an Excel serial number
+func ParseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial > 0 {
+		t, err := excelize.ExcelDateToTime(serial, false)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%q is not a date; use dd.MM.yyyy or yyyy-MM-dd", value)
+}
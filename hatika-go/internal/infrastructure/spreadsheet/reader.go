@@ -0,0 +1,120 @@
+package spreadsheet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Format is the file format of a table
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+var (
+	utf8BOM   = []byte{0xEF, 0xBB, 0xBF}
+	zipHeader = []byte("PK\x03\x04")
+)
+
+// DetectFormat determines the format from the file name and checks it against the first bytes of the file
+func DetectFormat(fileName string, head []byte) (Format, error) {
+	isZip := bytes.HasPrefix(head, zipHeader)
+
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".xlsx":
+		if !isZip {
+			return "", fmt.Errorf("file is not a valid XLSX workbook")
+		}
+		return FormatXLSX, nil
+	case ".csv", ".txt":
+		if isZip {
+			return "", fmt.Errorf("file has a .csv extension but is a workbook")
+		}
+		return FormatCSV, nil
+	case ".xls":
+		return "", fmt.Errorf("legacy .xls workbooks are not supported; save the file as .xlsx or .csv")
+	}
+
+	if isZip {
+		return FormatXLSX, nil
+	}
+	return "", fmt.Errorf("unsupported file type %q; upload a .csv or .xlsx file", filepath.Ext(fileName))
+}
+
+// ReadAll reads every row of a CSV file or of the first sheet of a workbook. Cells are returned
+// as stored, e.g. dates in workbooks come back as Excel serial numbers.
+func ReadAll(r io.Reader, format Format) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatXLSX:
+		return readXLSX(r)
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+// readCSV accepts the comma, semicolon or tab separated files spreadsheet programs export;
+// Turkish locales use semicolons because the comma is the decimal separator.
+func readCSV(r io.Reader) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV: %w", err)
+	}
+	data = bytes.TrimPrefix(data, utf8BOM)
+	if !utf8.Valid(data) {
+		return nil, fmt.Errorf("CSV file must be UTF-8 encoded")
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = sniffDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	return rows, nil
+}
+
+// sniffDelimiter picks the separator that occurs most often in the header line
+func sniffDelimiter(data []byte) rune {
+	line, _ := bufio.NewReader(bytes.NewReader(data)).ReadString('\n')
+
+	delimiter, best := ',', 0
+	for _, candidate := range []rune{',', ';', '\t'} {
+		if count := strings.Count(line, string(candidate)); count > best {
+			delimiter, best = candidate, count
+		}
+	}
+	return delimiter
+}
+
+func readXLSX(r io.Reader) ([][]string, error) {
+	workbook, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open workbook: %w", err)
+	}
+	defer workbook.Close()
+
+	sheets := workbook.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, fmt.Errorf("workbook has no sheets")
+	}
+
+	rows, err := workbook.GetRows(sheets[0], excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sheet %q: %w", sheets[0], err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,142 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// ContentTypes are the MIME types of the formats
+var ContentTypes = map[Format]string{
+	FormatCSV:  "text/csv; charset=utf-8",
+	FormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// RowWriter writes a table row by row. Cells may be nil, strings, ints, floats or times;
+// times are written as dates.
+type RowWriter interface {
+	WriteRow(cells []interface{}) error
+	// Close flushes the remaining rows; for workbooks this writes the whole file
+	Close() error
+}
+
+// NewWriter creates a row writer for the format
+func NewWriter(w io.Writer, format Format, sheetName string) (RowWriter, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatXLSX:
+		return newXLSXWriter(w, sheetName)
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+// csvWriter writes semicolon separated UTF-8 with a byte order mark, which Excel opens correctly in
+// Turkish locales and which the project import reads back
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	if _, err := w.Write(utf8BOM); err != nil {
+		return nil, err
+	}
+	writer := csv.NewWriter(w)
+	writer.Comma = ';'
+	return &csvWriter{writer: writer}, nil
+}
+
+func (w *csvWriter) WriteRow(cells []interface{}) error {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		record[i] = formatCell(cell)
+	}
+	return w.writer.Write(record)
+}
+
+func (w *csvWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func formatCell(cell interface{}) string {
+	switch v := cell.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.Format("2006-01-02")
+	}
+	return fmt.Sprint(cell)
+}
+
+// xlsxWriter uses excelize's stream writer, which spills rows to a temporary file instead of
+// keeping the whole sheet in memory
+type xlsxWriter struct {
+	out       io.Writer
+	file      *excelize.File
+	stream    *excelize.StreamWriter
+	row       int
+	dateStyle int
+}
+
+func newXLSXWriter(w io.Writer, sheetName string) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	if sheetName != "" && sheetName != "Sheet1" {
+		if err := file.SetSheetName("Sheet1", sheetName); err != nil {
+			file.Close()
+			return nil, err
+		}
+	} else {
+		sheetName = "Sheet1"
+	}
+
+	stream, err := file.NewStreamWriter(sheetName)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	dateFormat := "yyyy-mm-dd"
+	dateStyle, err := file.NewStyle(&excelize.Style{CustomNumFmt: &dateFormat})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &xlsxWriter{out: w, file: file, stream: stream, dateStyle: dateStyle}, nil
+}
+
+func (w *xlsxWriter) WriteRow(cells []interface{}) error {
+	w.row++
+	values := make([]interface{}, len(cells))
+	for i, cell := range cells {
+		if t, ok := cell.(time.Time); ok {
+			values[i] = excelize.Cell{StyleID: w.dateStyle, Value: t}
+			continue
+		}
+		values[i] = cell
+	}
+
+	axis, err := excelize.CoordinatesToCellName(1, w.row)
+	if err != nil {
+		return err
+	}
+	return w.stream.SetRow(axis, values)
+}
+
+func (w *xlsxWriter) Close() error {
+	defer w.file.Close()
+	if err := w.stream.Flush(); err != nil {
+		return err
+	}
+	return w.file.Write(w.out)
+}
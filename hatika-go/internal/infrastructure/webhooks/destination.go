@@ -0,0 +1,76 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"syscall"
+)
+
+// reservedNetworks are ranges outside net.IP's own classification that do not lead to the public internet
+var reservedNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+	mustParseCIDR("198.18.0.0/15"),
+	mustParseCIDR("64:ff9b:1::/48"),
+}
+
+// IsPrivateAddress reports whether an address is loopback, private, link-local, multicast or otherwise
+// not on the public internet. Webhooks may not be sent to such addresses unless private networks are
+// allowed, so a subscription cannot reach the services around the API or cloud metadata endpoints.
+func IsPrivateAddress(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return true
+	}
+	for _, network := range reservedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckHost resolves the host of a webhook URL and fails when it has no address or any of its
+// addresses is private
+func CheckHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if IsPrivateAddress(ip) {
+			return fmt.Errorf("%s is a loopback or private network address", host)
+		}
+		return nil
+	}
+
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addresses) == 0 {
+		return fmt.Errorf("%s cannot be resolved", host)
+	}
+	for _, address := range addresses {
+		if IsPrivateAddress(address.IP) {
+			return fmt.Errorf("%s resolves to the loopback or private network address %s", host, address.IP)
+		}
+	}
+	return nil
+}
+
+// refusePrivateAddress is a dialer control that refuses connections to private addresses. Checking
+// when connecting, after the name is resolved, also covers hosts that resolved to a public address
+// when the subscription was saved.
+func refusePrivateAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || IsPrivateAddress(ip) {
+		return fmt.Errorf("webhook destination %s is a loopback or private network address", host)
+	}
+	return nil
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
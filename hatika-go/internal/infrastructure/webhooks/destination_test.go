@@ -0,0 +1,46 @@
+package webhooks_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"hatika-go/internal/infrastructure/webhooks"
+)
+
+func TestIsPrivateAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		private bool
+	}{
+		{"127.0.0.1", true},
+		{"::1", true},
+		{"10.1.2.3", true},
+		{"172.16.0.1", true},
+		{"192.168.1.10", true},
+		{"169.254.169.254", true},
+		{"100.64.0.1", true},
+		{"0.0.0.0", true},
+		{"fd00::1", true},
+		{"fe80::1", true},
+		{"::ffff:10.0.0.1", true},
+		{"8.8.8.8", false},
+		{"2606:4700:4700::1111", false},
+	}
+	for _, tt := range tests {
+		if got := webhooks.IsPrivateAddress(net.ParseIP(tt.address)); got != tt.private {
+			t.Errorf("IsPrivateAddress(%s) = %v, want %v", tt.address, got, tt.private)
+		}
+	}
+}
+
+func TestCheckHostRefusesPrivateLiterals(t *testing.T) {
+	for _, host := range []string{"127.0.0.1", "::1", "10.0.0.5", "169.254.169.254"} {
+		if err := webhooks.CheckHost(context.Background(), host); err == nil {
+			t.Errorf("CheckHost(%s) accepted a private address", host)
+		}
+	}
+	if err := webhooks.CheckHost(context.Background(), "93.184.216.34"); err != nil {
+		t.Errorf("CheckHost(93.184.216.34) = %v", err)
+	}
+}
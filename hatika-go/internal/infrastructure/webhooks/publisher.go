@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/eventbus"
+	"hatika-go/internal/infrastructure/persistence"
+)
+
+// Publisher is the outbox relay's way into webhooks: it turns each event into a pending delivery per
+// subscription of the event's tenant that wants it. Sending is left to the Worker, so a slow customer
+// endpoint never holds up the relay or the other brokers.
+type Publisher struct {
+	subscriptionRepo *persistence.WebhookSubscriptionRepository
+	deliveryRepo     *persistence.WebhookDeliveryRepository
+}
+
+// NewPublisher creates a publisher queueing webhook deliveries
+func NewPublisher(subscriptionRepo *persistence.WebhookSubscriptionRepository, deliveryRepo *persistence.WebhookDeliveryRepository) *Publisher {
+	return &Publisher{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+	}
+}
+
+func (p *Publisher) Name() string {
+	return "webhooks"
+}
+
+func (p *Publisher) Publish(ctx context.Context, message *eventbus.Message) error {
+	subscriptions, err := p.subscriptionRepo.GetActiveForEvent(ctx, message.TenantID, message.Name)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	deliveries := make([]entities.WebhookDelivery, len(subscriptions))
+	for i := range subscriptions {
+		deliveries[i] = entities.WebhookDelivery{
+			MultiTenantEntity: entities.MultiTenantEntity{TenantID: message.TenantID},
+			SubscriptionID:    subscriptions[i].ID,
+			EventID:           message.ID,
+			EventName:         message.Name,
+			Body:              string(body),
+			Status:            entities.WebhookDeliveryStatusPending,
+			NextAttemptAt:     now,
+		}
+	}
+	return p.deliveryRepo.InsertNew(ctx, deliveries)
+}
+
+func (p *Publisher) Close() {}
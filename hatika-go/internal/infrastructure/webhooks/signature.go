@@ -0,0 +1,35 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// Request headers of a webhook delivery
+const (
+	HeaderEvent      = "X-Hatikago-Event"
+	HeaderEventID    = "X-Hatikago-Event-Id"
+	HeaderDeliveryID = "X-Hatikago-Delivery"
+	HeaderTimestamp  = "X-Hatikago-Timestamp"
+	HeaderSignature  = "X-Hatikago-Signature"
+)
+
+// signaturePrefix names the algorithm in the signature header, as in "sha256=<hex>"
+const signaturePrefix = "sha256="
+
+// Sign returns the signature header value of a request body sent at the Unix timestamp. The signed
+// message is "<timestamp>.<body>", so receivers can reject replays of old requests by the timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the signature of the body at the timestamp, in constant time
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,244 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/persistence"
+)
+
+const (
+	// retryBaseDelay is the wait before the first retry; it doubles with every failed attempt
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = 6 * time.Hour
+	// pruneInterval is how often succeeded deliveries past their retention are deleted
+	pruneInterval = time.Hour
+	// responseBodyLimit is how much of the receiver's response the delivery log keeps
+	responseBodyLimit = 1024
+	userAgent         = "hatikago-webhooks/1.0"
+	// leaseMargin is how long a claimed delivery stays leased beyond the request timeout, covering
+	// the time it takes to save the outcome
+	leaseMargin = time.Minute
+)
+
+// Worker sends pending webhook deliveries. The deliveries of a batch are sent concurrently; one that
+// fails is retried with exponential backoff and dead-lettered after MaxAttempts. Receivers get every
+// event at least once and should drop duplicates by the event ID.
+type Worker struct {
+	deliveryRepo *persistence.WebhookDeliveryRepository
+	client       *http.Client
+	config       config.WebhooksConfig
+
+	cancel    context.CancelFunc
+	done      chan struct{}
+	lastPrune time.Time
+}
+
+// NewWorker creates a delivery worker
+func NewWorker(deliveryRepo *persistence.WebhookDeliveryRepository, webhooksConfig config.WebhooksConfig) *Worker {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if !webhooksConfig.AllowPrivateNetworks {
+		dialer.Control = refusePrivateAddress
+	}
+
+	return &Worker{
+		deliveryRepo: deliveryRepo,
+		client: &http.Client{
+			// No proxy from the environment: the dialer has to see the receiver's address to refuse private ones
+			Transport: &http.Transport{
+				DialContext:           dialer.DialContext,
+				ForceAttemptHTTP2:     true,
+				MaxIdleConns:          100,
+				IdleConnTimeout:       90 * time.Second,
+				TLSHandshakeTimeout:   10 * time.Second,
+				ExpectContinueTimeout: time.Second,
+			},
+			Timeout: time.Duration(webhooksConfig.TimeoutSeconds) * time.Second,
+			// A redirect could take the signed body somewhere the subscriber did not register
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		config: webhooksConfig,
+	}
+}
+
+// Start polls for due deliveries in the background until Stop
+func (w *Worker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	log.Printf("Webhook delivery worker running every %ds", w.config.IntervalSeconds)
+
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(time.Duration(w.config.IntervalSeconds) * time.Second)
+		defer ticker.Stop()
+		for {
+			w.tick(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop waits for the current batch to finish
+func (w *Worker) Stop() {
+	if w.cancel != nil {
+		w.cancel()
+		<-w.done
+	}
+}
+
+func (w *Worker) tick(ctx context.Context) {
+	// Drain the backlog batch by batch before waiting for the next tick
+	for ctx.Err() == nil {
+		count, err := w.DeliverOnce(ctx)
+		if err != nil {
+			log.Printf("Webhook delivery failed: %v", err)
+			break
+		}
+		if count < w.config.BatchSize {
+			break
+		}
+	}
+
+	if w.config.RetentionDays > 0 && time.Since(w.lastPrune) >= pruneInterval {
+		w.lastPrune = time.Now()
+		cutoff := time.Now().AddDate(0, 0, -w.config.RetentionDays)
+		if deleted, err := w.deliveryRepo.DeleteSucceededBefore(ctx, cutoff); err != nil {
+			log.Printf("Webhook delivery worker failed to prune: %v", err)
+		} else if deleted > 0 {
+			log.Printf("Webhook delivery worker pruned %d succeeded deliveries", deleted)
+		}
+	}
+}
+
+// DeliverOnce claims one batch of due deliveries, sends them and saves their outcomes, returning how
+// many it handled. The claim is a lease committed before sending, so no database transaction is held
+// open while the receivers respond.
+func (w *Worker) DeliverOnce(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	leaseUntil := now.Add(time.Duration(w.config.TimeoutSeconds)*time.Second + leaseMargin)
+	deliveries, err := w.deliveryRepo.ClaimDue(ctx, now, leaseUntil, w.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		wg.Add(1)
+		go func(delivery *entities.WebhookDelivery) {
+			defer wg.Done()
+			w.deliver(ctx, delivery)
+		}(&deliveries[i])
+	}
+	wg.Wait()
+
+	// Outcomes are saved even when the worker is stopping; an unsaved delivery would be sent again
+	// once its lease runs out
+	saveCtx := context.WithoutCancel(ctx)
+	var saveErr error
+	for i := range deliveries {
+		if err := w.deliveryRepo.SaveOutcome(saveCtx, &deliveries[i]); err != nil && saveErr == nil {
+			saveErr = err
+		}
+	}
+	return len(deliveries), saveErr
+}
+
+// deliver sends a delivery to its subscription and records the outcome on it
+func (w *Worker) deliver(ctx context.Context, delivery *entities.WebhookDelivery) {
+	subscription := delivery.Subscription
+	if subscription == nil || subscription.IsDeleted || !subscription.IsActive {
+		delivery.Status = entities.WebhookDeliveryStatusDeadLettered
+		delivery.LastError = "subscription was deleted or deactivated"
+		return
+	}
+
+	started := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &started
+	statusCode, responseBody, err := w.send(ctx, subscription, delivery, started)
+	duration := time.Since(started).Milliseconds()
+	delivery.DurationMs = &duration
+	delivery.ResponseBody = responseBody
+	delivery.ResponseStatusCode = nil
+	if statusCode != 0 {
+		delivery.ResponseStatusCode = &statusCode
+	}
+
+	if err == nil {
+		delivered := time.Now().UTC()
+		delivery.Status = entities.WebhookDeliveryStatusSucceeded
+		delivery.DeliveredAt = &delivered
+		delivery.LastError = ""
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= w.config.MaxAttempts {
+		delivery.Status = entities.WebhookDeliveryStatusDeadLettered
+		log.Printf("Webhook delivery %d (%s to subscription %d) failed %d times and was dead-lettered: %v",
+			delivery.ID, delivery.EventName, delivery.SubscriptionID, delivery.Attempts, err)
+		return
+	}
+	delivery.NextAttemptAt = time.Now().UTC().Add(retryDelay(delivery.Attempts))
+}
+
+// send posts the signed body and returns the response status and the start of its body. Any status
+// outside 2xx is an error.
+func (w *Worker) send(ctx context.Context, subscription *entities.WebhookSubscription, delivery *entities.WebhookDelivery, now time.Time) (int, string, error) {
+	body := []byte(delivery.Body)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid webhook request: %w", err)
+	}
+
+	timestamp := now.Unix()
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", userAgent)
+	request.Header.Set(HeaderEvent, delivery.EventName)
+	request.Header.Set(HeaderEventID, delivery.EventID)
+	request.Header.Set(HeaderDeliveryID, strconv.Itoa(delivery.ID))
+	request.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, body))
+
+	response, err := w.client.Do(request)
+	if err != nil {
+		return 0, "", err
+	}
+	defer response.Body.Close()
+
+	responseBody, _ := io.ReadAll(io.LimitReader(response.Body, responseBodyLimit))
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, string(responseBody), fmt.Errorf("receiver responded with %s", response.Status)
+	}
+	return response.StatusCode, string(responseBody), nil
+}
+
+// retryDelay is the exponential backoff after the given number of failed attempts
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return delay
+}
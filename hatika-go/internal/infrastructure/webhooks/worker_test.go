@@ -0,0 +1,249 @@
+package webhooks_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/infrastructure/config"
+	"hatika-go/internal/infrastructure/persistence"
+	"hatika-go/internal/infrastructure/webhooks"
+	"hatika-go/internal/testutil"
+
+	"gorm.io/gorm"
+)
+
+const testSecret = "whsec_test"
+
+func testConfig() config.WebhooksConfig {
+	return config.WebhooksConfig{
+		Enabled:              true,
+		BatchSize:            10,
+		MaxAttempts:          3,
+		TimeoutSeconds:       5,
+		AllowPrivateNetworks: true,
+	}
+}
+
+// queueDelivery stores a subscription to url and a delivery due now
+func queueDelivery(t *testing.T, db *gorm.DB, url string) *entities.WebhookDelivery {
+	t.Helper()
+	subscription := &entities.WebhookSubscription{
+		URL:        url,
+		Secret:     testSecret,
+		EventTypes: []string{entities.WebhookAllEvents},
+		IsActive:   true,
+	}
+	if err := db.Create(subscription).Error; err != nil {
+		t.Fatalf("failed to create subscription: %v", err)
+	}
+	delivery := &entities.WebhookDelivery{
+		SubscriptionID: subscription.ID,
+		EventID:        "event-1",
+		EventName:      "ProjectCreated",
+		Body:           `{"id":"event-1","name":"ProjectCreated"}`,
+		Status:         entities.WebhookDeliveryStatusPending,
+		NextAttemptAt:  time.Now().UTC().Add(-time.Second),
+	}
+	if err := db.Create(delivery).Error; err != nil {
+		t.Fatalf("failed to create delivery: %v", err)
+	}
+	return delivery
+}
+
+func reloadDelivery(t *testing.T, db *gorm.DB, id int) *entities.WebhookDelivery {
+	t.Helper()
+	var delivery entities.WebhookDelivery
+	if err := db.First(&delivery, id).Error; err != nil {
+		t.Fatalf("failed to reload delivery %d: %v", id, err)
+	}
+	return &delivery
+}
+
+// makeDue lets a delivery waiting for its retry be sent right away
+func makeDue(t *testing.T, db *gorm.DB, id int) {
+	t.Helper()
+	if err := db.Model(&entities.WebhookDelivery{}).Where("id = ?", id).
+		Update("next_attempt_at", time.Now().UTC().Add(-time.Second)).Error; err != nil {
+		t.Fatalf("failed to make delivery %d due: %v", id, err)
+	}
+}
+
+func deliverOnce(t *testing.T, worker *webhooks.Worker, want int) {
+	t.Helper()
+	count, err := worker.DeliverOnce(context.Background())
+	if err != nil {
+		t.Fatalf("DeliverOnce: %v", err)
+	}
+	if count != want {
+		t.Fatalf("DeliverOnce handled %d deliveries, want %d", count, want)
+	}
+}
+
+func TestWorkerSendsSignedRequest(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	var request *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		body, _ = io.ReadAll(r.Body)
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	delivery := queueDelivery(t, db, server.URL)
+	worker := webhooks.NewWorker(persistence.NewWebhookDeliveryRepository(db), testConfig())
+	deliverOnce(t, worker, 1)
+
+	if request == nil {
+		t.Fatal("receiver got no request")
+	}
+	if string(body) != delivery.Body {
+		t.Errorf("body = %s, want %s", body, delivery.Body)
+	}
+	if got := request.Header.Get(webhooks.HeaderEventID); got != delivery.EventID {
+		t.Errorf("%s = %q, want %q", webhooks.HeaderEventID, got, delivery.EventID)
+	}
+	if got := request.Header.Get(webhooks.HeaderDeliveryID); got != strconv.Itoa(delivery.ID) {
+		t.Errorf("%s = %q, want %d", webhooks.HeaderDeliveryID, got, delivery.ID)
+	}
+	timestamp, err := strconv.ParseInt(request.Header.Get(webhooks.HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid %s: %v", webhooks.HeaderTimestamp, err)
+	}
+	if !webhooks.Verify(testSecret, timestamp, body, request.Header.Get(webhooks.HeaderSignature)) {
+		t.Errorf("signature %q does not verify", request.Header.Get(webhooks.HeaderSignature))
+	}
+
+	sent := reloadDelivery(t, db, delivery.ID)
+	if sent.Status != entities.WebhookDeliveryStatusSucceeded || sent.DeliveredAt == nil {
+		t.Errorf("status = %s, delivered at %v, want Succeeded", sent.Status, sent.DeliveredAt)
+	}
+	if sent.Attempts != 1 || sent.ResponseStatusCode == nil || *sent.ResponseStatusCode != http.StatusOK || sent.ResponseBody != "ok" {
+		t.Errorf("logged %d attempts, status %v, body %q", sent.Attempts, sent.ResponseStatusCode, sent.ResponseBody)
+	}
+}
+
+func TestWorkerRetriesWithBackoffAndDeadLetters(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	delivery := queueDelivery(t, db, server.URL)
+	worker := webhooks.NewWorker(persistence.NewWebhookDeliveryRepository(db), testConfig())
+
+	for attempt, wantDelay := range []time.Duration{30 * time.Second, time.Minute} {
+		started := time.Now().UTC()
+		deliverOnce(t, worker, 1)
+
+		failed := reloadDelivery(t, db, delivery.ID)
+		if failed.Status != entities.WebhookDeliveryStatusPending || failed.Attempts != attempt+1 {
+			t.Fatalf("after attempt %d: status %s with %d attempts", attempt+1, failed.Status, failed.Attempts)
+		}
+		if delay := failed.NextAttemptAt.Sub(started); delay < wantDelay || delay > wantDelay+5*time.Second {
+			t.Errorf("after attempt %d: next attempt in %v, want %v", attempt+1, delay, wantDelay)
+		}
+		if !strings.Contains(failed.LastError, "503") {
+			t.Errorf("after attempt %d: last error %q", attempt+1, failed.LastError)
+		}
+		deliverOnce(t, worker, 0)
+		makeDue(t, db, delivery.ID)
+	}
+
+	deliverOnce(t, worker, 1)
+	if deadLettered := reloadDelivery(t, db, delivery.ID); deadLettered.Status != entities.WebhookDeliveryStatusDeadLettered {
+		t.Errorf("status after %d attempts = %s, want DeadLettered", deadLettered.Attempts, deadLettered.Status)
+	}
+	makeDue(t, db, delivery.ID)
+	deliverOnce(t, worker, 0)
+	if got := requests.Load(); got != 3 {
+		t.Errorf("receiver got %d requests, want 3", got)
+	}
+}
+
+func TestWorkerDeadLettersDeliveriesOfInactiveSubscriptions(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	delivery := queueDelivery(t, db, server.URL)
+	if err := db.Model(&entities.WebhookSubscription{}).Where("id = ?", delivery.SubscriptionID).
+		Update("is_active", false).Error; err != nil {
+		t.Fatalf("failed to deactivate subscription: %v", err)
+	}
+
+	worker := webhooks.NewWorker(persistence.NewWebhookDeliveryRepository(db), testConfig())
+	deliverOnce(t, worker, 1)
+
+	if got := reloadDelivery(t, db, delivery.ID).Status; got != entities.WebhookDeliveryStatusDeadLettered {
+		t.Errorf("status = %s, want DeadLettered", got)
+	}
+	if requests.Load() != 0 {
+		t.Error("delivery of an inactive subscription was sent")
+	}
+}
+
+func TestWorkerLeasesDeliveriesWhileSending(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	deliveryRepo := persistence.NewWebhookDeliveryRepository(db)
+	var claimed []entities.WebhookDelivery
+	var claimErr error
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Another worker claiming now must neither wait for a transaction nor get the delivery
+		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+		defer cancel()
+		claimed, claimErr = deliveryRepo.ClaimDue(ctx, time.Now().UTC(), time.Now().UTC().Add(time.Minute), 10)
+	}))
+	defer server.Close()
+
+	delivery := queueDelivery(t, db, server.URL)
+	worker := webhooks.NewWorker(deliveryRepo, testConfig())
+	deliverOnce(t, worker, 1)
+
+	if claimErr != nil {
+		t.Fatalf("ClaimDue while sending: %v", claimErr)
+	}
+	if len(claimed) != 0 {
+		t.Errorf("claimed %d leased deliveries while sending", len(claimed))
+	}
+	if got := reloadDelivery(t, db, delivery.ID).Status; got != entities.WebhookDeliveryStatusSucceeded {
+		t.Errorf("status = %s, want Succeeded", got)
+	}
+}
+
+func TestWorkerRefusesPrivateAddresses(t *testing.T) {
+	db := testutil.NewDatabase(t)
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	delivery := queueDelivery(t, db, server.URL)
+	webhooksConfig := testConfig()
+	webhooksConfig.AllowPrivateNetworks = false
+	worker := webhooks.NewWorker(persistence.NewWebhookDeliveryRepository(db), webhooksConfig)
+	deliverOnce(t, worker, 1)
+
+	refused := reloadDelivery(t, db, delivery.ID)
+	if refused.Status != entities.WebhookDeliveryStatusPending || !strings.Contains(refused.LastError, "private network") {
+		t.Errorf("status = %s, last error %q, want a refused attempt", refused.Status, refused.LastError)
+	}
+	if requests.Load() != 0 {
+		t.Error("request reached a loopback address")
+	}
+}
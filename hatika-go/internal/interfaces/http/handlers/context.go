@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// currentUserID returns the ID of the user making the request
+func currentUserID(c *gin.Context) int {
+	// TODO: Get user ID from JWT context
+	return 1 // Placeholder
+}
+
+// parseIDParam reads a positive integer path parameter
+func parseIDParam(c *gin.Context, name string) (int, bool) {
+	id, err := strconv.Atoi(c.Param(name))
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
+
+// setETag exposes the version of the returned resource as a strong entity tag, which clients send
+// back in If-Match to change it
+func setETag(c *gin.Context, version int) {
+	c.Header("ETag", strconv.Quote(strconv.Itoa(version)))
+}
+
+// ifMatchVersion reads the version a change is based on from the If-Match header. It returns nil
+// when the header is absent or "*", and responds with 400 and returns false when the header is not
+// an entity tag issued by setETag.
+func ifMatchVersion(c *gin.Context) (*int, bool) {
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, true
+	}
+
+	tag, err := strconv.Unquote(ifMatch)
+	version, convErr := strconv.Atoi(tag)
+	if err != nil || convErr != nil || version <= 0 {
+		utils.RespondWithValidationError(c, fmt.Sprintf("If-Match must be a single strong ETag such as \"3\", got %s", ifMatch))
+		return nil, false
+	}
+	return &version, true
+}
+
+// negotiateFormat picks the response format from the format query parameter, or else from the Accept
+// header. offers maps each format to its media type; the first offer is the default. It responds with
+// 400 or 406 and returns false when no offered format applies.
+func negotiateFormat(c *gin.Context, offers ...formatOffer) (string, bool) {
+	if format := c.Query("format"); format != "" {
+		for _, offer := range offers {
+			if strings.EqualFold(format, offer.Format) {
+				return offer.Format, true
+			}
+		}
+		utils.RespondWithValidationError(c, fmt.Sprintf("unsupported format %q", format))
+		return "", false
+	}
+
+	if c.GetHeader("Accept") == "" {
+		return offers[0].Format, true
+	}
+	mediaTypes := make([]string, len(offers))
+	for i, offer := range offers {
+		mediaTypes[i] = offer.MediaType
+	}
+	if negotiated := c.NegotiateFormat(mediaTypes...); negotiated != "" {
+		for _, offer := range offers {
+			if offer.MediaType == negotiated {
+				return offer.Format, true
+			}
+		}
+	}
+	utils.RespondWithError(c, http.StatusNotAcceptable, "None of the accepted media types can be produced", mediaTypes)
+	return "", false
+}
+
+// formatOffer is a response format with its media type
+type formatOffer struct {
+	Format    string
+	MediaType string
+}
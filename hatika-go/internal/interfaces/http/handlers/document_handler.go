@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DocumentHandler handles HTTP requests for uploaded project documents
+type DocumentHandler struct {
+	documentService *services.DocumentService
+}
+
+// NewDocumentHandler creates a new document handler
+func NewDocumentHandler(documentService *services.DocumentService) *DocumentHandler {
+	return &DocumentHandler{
+		documentService: documentService,
+	}
+}
+
+// Upload godoc
+// @Summary Upload a project document
+// @Description Upload a multi-document PDF; each page is classified and attached to the matching OCR project of the project. Pages without a text layer are read by the configured text recognition service; PDFs with pages no text could be read from are rejected.
+// @Tags documents
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param file formData file true "PDF file"
+// @Security BearerAuth
+// @Success 201 {object} dtos.ProjectDocumentDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id}/documents [post]
+func (h *DocumentHandler) Upload(c *gin.Context) {
+	projectID, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		utils.RespondWithValidationError(c, "file is required")
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Could not read uploaded file", err.Error())
+		return
+	}
+	defer file.Close()
+
+	result, err := h.documentService.Upload(c.Request.Context(), projectID, fileHeader.Filename, file, fileHeader.Size, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusCreated, result, "Document uploaded and split successfully")
+}
+
+// GetAll godoc
+// @Summary List project documents
+// @Description List the documents uploaded to a project with their page ranges
+// @Tags documents
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Security BearerAuth
+// @Success 200 {array} dtos.ProjectDocumentDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id}/documents [get]
+func (h *DocumentHandler) GetAll(c *gin.Context) {
+	projectID, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+
+	result, err := h.documentService.GetAll(c.Request.Context(), projectID, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// GetByID godoc
+// @Summary Get a project document
+// @Description Get an uploaded document with its page ranges
+// @Tags documents
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param documentId path int true "Document ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectDocumentDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id}/documents/{documentId} [get]
+func (h *DocumentHandler) GetByID(c *gin.Context) {
+	projectID, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+
+	documentID, ok := parseIDParam(c, "documentId")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid document ID", nil)
+		return
+	}
+
+	result, err := h.documentService.GetByID(c.Request.Context(), projectID, documentID, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// ReassignPages godoc
+// @Summary Reassign document pages
+// @Description Move a page range of a document to another OCR project of the same project, or unassign it
+// @Tags documents
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param documentId path int true "Document ID"
+// @Param pages body dtos.ReassignDocumentPagesDto true "Page range and target OCR project"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectDocumentDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id}/documents/{documentId}/pages [put]
+func (h *DocumentHandler) ReassignPages(c *gin.Context) {
+	projectID, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+
+	documentID, ok := parseIDParam(c, "documentId")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid document ID", nil)
+		return
+	}
+
+	var input dtos.ReassignDocumentPagesDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.documentService.ReassignPages(c.Request.Context(), projectID, documentID, &input, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "Pages reassigned successfully")
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+
+	apperrors "hatika-go/pkg/errors"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// readMergePatch reads a JSON Merge Patch (RFC 7396) request body. It responds with 415 or 400 and
+// returns false unless the body is a JSON object sent as application/merge-patch+json or
+// application/json.
+func readMergePatch(c *gin.Context) ([]byte, bool) {
+	if contentType := c.ContentType(); contentType != utils.MergePatchContentType && contentType != binding.MIMEJSON {
+		utils.RespondWithError(c, http.StatusUnsupportedMediaType,
+			"PATCH expects a JSON Merge Patch", []string{utils.MergePatchContentType, binding.MIMEJSON})
+		return nil, false
+	}
+
+	patch, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return nil, false
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &members); err != nil || members == nil {
+		utils.RespondWithValidationError(c, "merge patch must be a JSON object")
+		return nil, false
+	}
+	return patch, true
+}
+
+// applyMergePatch applies a merge patch to target, a pointer to an update DTO holding the current
+// values. The result must be valid under the DTO's binding rules, as a full update is; a member the
+// DTO does not have is rejected.
+func applyMergePatch(target interface{}, patch []byte) error {
+	current, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+	merged, err := utils.ApplyMergePatch(current, patch)
+	if err != nil {
+		return apperrors.Validation("%v", err)
+	}
+
+	// Members removed by the patch must end up as zero values, not keep their current ones
+	reflect.ValueOf(target).Elem().SetZero()
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return apperrors.Validation("%v", err)
+	}
+
+	if err := binding.Validator.ValidateStruct(target); err != nil {
+		return apperrors.Validation("%v", err)
+	}
+	return nil
+}
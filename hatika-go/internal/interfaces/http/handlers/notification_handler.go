@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationHandler handles HTTP requests for the current user's notifications
+type NotificationHandler struct {
+	notificationService *services.NotificationService
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(notificationService *services.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// GetAll godoc
+// @Summary Get notifications
+// @Description List the notifications of the current user, newest first
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param pageNumber query int true "Page number" minimum(1)
+// @Param pageSize query int true "Page size" minimum(1) maximum(100)
+// @Param unreadOnly query bool false "Only unread notifications"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} object "Paged result with notifications"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /notifications [get]
+func (h *NotificationHandler) GetAll(c *gin.Context) {
+	var request dtos.NotificationListRequestDto
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.notificationService.GetAll(c.Request.Context(), &request, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// MarkRead godoc
+// @Summary Mark notification as read
+// @Description Mark a notification of the current user as read
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param id path int true "Notification ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.NotificationDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /notifications/{id}/read [post]
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid notification ID", nil)
+		return
+	}
+
+	result, err := h.notificationService.MarkRead(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
@@ -0,0 +1,339 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OcrProjectHandler handles HTTP requests for OCR projects
+type OcrProjectHandler struct {
+	ocrProjectService *services.OcrProjectService
+}
+
+// NewOcrProjectHandler creates a new OCR project handler
+func NewOcrProjectHandler(ocrProjectService *services.OcrProjectService) *OcrProjectHandler {
+	return &OcrProjectHandler{
+		ocrProjectService: ocrProjectService,
+	}
+}
+
+// GetByID godoc
+// @Summary Get OCR project by ID
+// @Description Get a single OCR project with its extracted field results
+// @Tags ocr-projects
+// @Accept json
+// @Produce json
+// @Param id path int true "OCR Project ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrProjectDto
+// @Header 200 {string} ETag "Version of the OCR project, for If-Match"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-projects/{id} [get]
+func (h *OcrProjectHandler) GetByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR project ID", nil)
+		return
+	}
+
+	result, err := h.ocrProjectService.GetByID(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	setETag(c, result.Version)
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// Patch godoc
+// @Summary Partially update an OCR project
+// @Description Correct the values of an OCR project with a JSON Merge Patch (RFC 7396): members sent replace the current values, null clears a value and omitted members are kept
+// @Tags ocr-projects
+// @Accept application/merge-patch+json
+// @Accept json
+// @Produce json
+// @Param id path int true "OCR Project ID"
+// @Param patch body dtos.UpdateOcrProjectDto true "Members to change"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrProjectDto
+// @Header 200 {string} ETag "Version of the changed OCR project"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 415 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-projects/{id} [patch]
+func (h *OcrProjectHandler) Patch(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR project ID", nil)
+		return
+	}
+
+	patch, ok := readMergePatch(c)
+	if !ok {
+		return
+	}
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.ocrProjectService.Patch(c.Request.Context(), id, func(input *dtos.UpdateOcrProjectDto) error {
+		return applyMergePatch(input, patch)
+	}, currentUserID(c), expectedVersion)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	setETag(c, result.Version)
+	utils.RespondWithSuccess(c, http.StatusOK, result, "OCR project updated successfully")
+}
+
+// GetReviewQueue godoc
+// @Summary Get the OCR review queue
+// @Description List OCR projects with low-confidence fields waiting for review, oldest first
+// @Tags ocr-projects
+// @Accept json
+// @Produce json
+// @Param pageNumber query int true "Page number" minimum(1)
+// @Param pageSize query int true "Page size" minimum(1) maximum(100)
+// @Param reviewerUserId query int false "Only OCR projects assigned to this reviewer"
+// @Param sorting query string false "Sort expression, e.g. 'status asc, updatedAt desc'"
+// @Param filter query string false "Filter expression, e.g. 'type in (0,1) and updatedAt lt 2026-01-01'"
+// @Security BearerAuth
+// @Success 200 {object} object "Paged result with OCR projects"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-projects/review-queue [get]
+func (h *OcrProjectHandler) GetReviewQueue(c *gin.Context) {
+	var request dtos.PagedReviewQueueRequestDto
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.ocrProjectService.GetReviewQueue(c.Request.Context(), &request, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// StartProcessing godoc
+// @Summary Start processing an OCR project
+// @Description Move a pending or rejected OCR project to Processing
+// @Tags ocr-projects
+// @Accept json
+// @Produce json
+// @Param id path int true "OCR Project ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrProjectDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-projects/{id}/start [post]
+func (h *OcrProjectHandler) StartProcessing(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR project ID", nil)
+		return
+	}
+
+	result, err := h.ocrProjectService.StartProcessing(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "OCR project processing started")
+}
+
+// SubmitResults godoc
+// @Summary Submit OCR results
+// @Description Store extracted fields; fields below their confidence threshold send the OCR project to review
+// @Tags ocr-projects
+// @Accept json
+// @Produce json
+// @Param id path int true "OCR Project ID"
+// @Param results body dtos.SubmitOcrResultsDto true "Extracted fields"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrProjectDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-projects/{id}/results [post]
+func (h *OcrProjectHandler) SubmitResults(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR project ID", nil)
+		return
+	}
+
+	var input dtos.SubmitOcrResultsDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.ocrProjectService.SubmitResults(c.Request.Context(), id, &input, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "OCR results stored successfully")
+}
+
+// AssignReviewer godoc
+// @Summary Assign a reviewer
+// @Description Assign a user of the tenant holding the Pages.OcrProjects.Review permission to review an OCR project in NeedsReview; requires the same permission
+// @Tags ocr-projects
+// @Accept json
+// @Produce json
+// @Param id path int true "OCR Project ID"
+// @Param reviewer body dtos.AssignReviewerDto true "Reviewer"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrProjectDto
+// @Header 200 {string} ETag "Version of the changed OCR project"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-projects/{id}/assign [post]
+func (h *OcrProjectHandler) AssignReviewer(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR project ID", nil)
+		return
+	}
+
+	var input dtos.AssignReviewerDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.ocrProjectService.AssignReviewer(c.Request.Context(), id, &input, currentUserID(c), expectedVersion)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	setETag(c, result.Version)
+	utils.RespondWithSuccess(c, http.StatusOK, result, "Reviewer assigned successfully")
+}
+
+// Approve godoc
+// @Summary Approve an OCR project
+// @Description Approve reviewed field values and apply all approved values to the parent project; requires the Pages.OcrProjects.Review permission
+// @Tags ocr-projects
+// @Accept json
+// @Produce json
+// @Param id path int true "OCR Project ID"
+// @Param approval body dtos.ApproveOcrProjectDto true "Field decisions"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrProjectDto
+// @Header 200 {string} ETag "Version of the changed OCR project"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-projects/{id}/approve [post]
+func (h *OcrProjectHandler) Approve(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR project ID", nil)
+		return
+	}
+
+	var input dtos.ApproveOcrProjectDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.ocrProjectService.Approve(c.Request.Context(), id, currentUserID(c), &input, expectedVersion)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	setETag(c, result.Version)
+	utils.RespondWithSuccess(c, http.StatusOK, result, "OCR project approved successfully")
+}
+
+// Reject godoc
+// @Summary Reject an OCR project
+// @Description Reject an OCR project under review so it can be processed again; requires the Pages.OcrProjects.Review permission
+// @Tags ocr-projects
+// @Accept json
+// @Produce json
+// @Param id path int true "OCR Project ID"
+// @Param rejection body dtos.RejectOcrProjectDto true "Rejection reason"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrProjectDto
+// @Header 200 {string} ETag "Version of the changed OCR project"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-projects/{id}/reject [post]
+func (h *OcrProjectHandler) Reject(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR project ID", nil)
+		return
+	}
+
+	var input dtos.RejectOcrProjectDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.ocrProjectService.Reject(c.Request.Context(), id, currentUserID(c), &input, expectedVersion)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	setETag(c, result.Version)
+	utils.RespondWithSuccess(c, http.StatusOK, result, "OCR project rejected")
+}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OcrProjectTemplateHandler handles HTTP requests for OCR project templates
+type OcrProjectTemplateHandler struct {
+	templateService *services.OcrProjectTemplateService
+}
+
+// NewOcrProjectTemplateHandler creates a new OCR project template handler
+func NewOcrProjectTemplateHandler(templateService *services.OcrProjectTemplateService) *OcrProjectTemplateHandler {
+	return &OcrProjectTemplateHandler{
+		templateService: templateService,
+	}
+}
+
+// GetAll godoc
+// @Summary Get all OCR project templates
+// @Description List the OCR project templates of the current tenant
+// @Tags ocr-project-templates
+// @Accept json
+// @Produce json
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {array} dtos.OcrProjectTemplateDto
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-project-templates [get]
+func (h *OcrProjectTemplateHandler) GetAll(c *gin.Context) {
+	result, err := h.templateService.GetAll(c.Request.Context())
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// GetByID godoc
+// @Summary Get OCR project template by ID
+// @Description Get a single OCR project template with its document slots
+// @Tags ocr-project-templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Template ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrProjectTemplateDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-project-templates/{id} [get]
+func (h *OcrProjectTemplateHandler) GetByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid template ID", nil)
+		return
+	}
+
+	result, err := h.templateService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// Create godoc
+// @Summary Create an OCR project template
+// @Description Define which document types new projects of the tenant (or one of its groups) require
+// @Tags ocr-project-templates
+// @Accept json
+// @Produce json
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param template body dtos.CreateOcrProjectTemplateDto true "Template data"
+// @Security BearerAuth
+// @Success 201 {object} dtos.OcrProjectTemplateDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-project-templates [post]
+func (h *OcrProjectTemplateHandler) Create(c *gin.Context) {
+	var input dtos.CreateOcrProjectTemplateDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.templateService.Create(c.Request.Context(), &input)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusCreated, result, "OCR project template created successfully")
+}
+
+// Update godoc
+// @Summary Update an OCR project template
+// @Description Update a template; existing projects keep their OCR projects
+// @Tags ocr-project-templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Template ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param template body dtos.UpdateOcrProjectTemplateDto true "Template data"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrProjectTemplateDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-project-templates/{id} [put]
+func (h *OcrProjectTemplateHandler) Update(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid template ID", nil)
+		return
+	}
+
+	var input dtos.UpdateOcrProjectTemplateDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.templateService.Update(c.Request.Context(), id, &input)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "OCR project template updated successfully")
+}
+
+// Delete godoc
+// @Summary Delete an OCR project template
+// @Description Soft delete a template; new projects fall back to the next matching template
+// @Tags ocr-project-templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Template ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-project-templates/{id} [delete]
+func (h *OcrProjectTemplateHandler) Delete(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid template ID", nil)
+		return
+	}
+
+	if err := h.templateService.Delete(c.Request.Context(), id, currentUserID(c)); err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, nil, "OCR project template deleted successfully")
+}
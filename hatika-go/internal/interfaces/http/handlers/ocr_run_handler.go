@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OcrRunHandler handles HTTP requests for OCR re-processing runs
+type OcrRunHandler struct {
+	ocrRunService *services.OcrRunService
+}
+
+// NewOcrRunHandler creates a new OCR run handler
+func NewOcrRunHandler(ocrRunService *services.OcrRunService) *OcrRunHandler {
+	return &OcrRunHandler{
+		ocrRunService: ocrRunService,
+	}
+}
+
+// GetEngines godoc
+// @Summary List OCR engines
+// @Description List the configured extraction engines and their versions
+// @Tags ocr-runs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dtos.OcrEngineDto
+// @Router /ocr-engines [get]
+func (h *OcrRunHandler) GetEngines(c *gin.Context) {
+	utils.RespondWithSuccess(c, http.StatusOK, h.ocrRunService.GetEngines(), "")
+}
+
+// Reprocess godoc
+// @Summary Re-run OCR extraction
+// @Description Queue an extraction run for an OCR project with the given (or default) engine; the run is processed in the background and followed through GET /ocr-runs/{id}. With apply, the results are submitted to the review workflow.
+// @Tags ocr-runs
+// @Accept json
+// @Produce json
+// @Param id path int true "OCR Project ID"
+// @Param run body dtos.ReprocessOcrProjectDto true "Engine and apply option"
+// @Security BearerAuth
+// @Success 202 {object} dtos.OcrRunDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-projects/{id}/reprocess [post]
+func (h *OcrRunHandler) Reprocess(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR project ID", nil)
+		return
+	}
+
+	var input dtos.ReprocessOcrProjectDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.ocrRunService.Reprocess(c.Request.Context(), id, currentUserID(c), &input)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusAccepted, result, "OCR run queued")
+}
+
+// GetByOcrProjectID godoc
+// @Summary List OCR runs of an OCR project
+// @Description List every extraction run of an OCR project, newest first
+// @Tags ocr-runs
+// @Accept json
+// @Produce json
+// @Param id path int true "OCR Project ID"
+// @Security BearerAuth
+// @Success 200 {array} dtos.OcrRunDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-projects/{id}/runs [get]
+func (h *OcrRunHandler) GetByOcrProjectID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR project ID", nil)
+		return
+	}
+
+	result, err := h.ocrRunService.GetByOcrProjectID(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// BulkReprocess godoc
+// @Summary Re-run OCR extraction in bulk
+// @Description Queue a run for every OCR project matching the filter; runs are processed in the background
+// @Tags ocr-runs
+// @Accept json
+// @Produce json
+// @Param filter body dtos.BulkReprocessDto true "Engine, apply option and filter"
+// @Security BearerAuth
+// @Success 202 {object} dtos.BulkReprocessResultDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-runs/bulk [post]
+func (h *OcrRunHandler) BulkReprocess(c *gin.Context) {
+	var input dtos.BulkReprocessDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.ocrRunService.BulkReprocess(c.Request.Context(), currentUserID(c), &input)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusAccepted, result, "OCR runs queued")
+}
+
+// GetBatch godoc
+// @Summary Get a bulk re-processing batch
+// @Description List the runs queued by a bulk re-processing request with their progress
+// @Tags ocr-runs
+// @Accept json
+// @Produce json
+// @Param batchId path string true "Batch ID"
+// @Security BearerAuth
+// @Success 200 {array} dtos.OcrRunDto
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-runs/batches/{batchId} [get]
+func (h *OcrRunHandler) GetBatch(c *gin.Context) {
+	result, err := h.ocrRunService.GetBatch(c.Request.Context(), c.Param("batchId"), currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// GetByID godoc
+// @Summary Get OCR run by ID
+// @Description Get an extraction run with the fields it produced
+// @Tags ocr-runs
+// @Accept json
+// @Produce json
+// @Param id path int true "OCR Run ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrRunDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-runs/{id} [get]
+func (h *OcrRunHandler) GetByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR run ID", nil)
+		return
+	}
+
+	result, err := h.ocrRunService.GetByID(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// Compare godoc
+// @Summary Compare two OCR runs
+// @Description Diff the fields of two runs of the same OCR project, with accuracy against human-approved values when available
+// @Tags ocr-runs
+// @Accept json
+// @Produce json
+// @Param baseRunId query int true "Base run ID"
+// @Param candidateRunId query int true "Candidate run ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrRunComparisonDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-runs/compare [get]
+func (h *OcrRunHandler) Compare(c *gin.Context) {
+	var request dtos.CompareOcrRunsRequestDto
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.ocrRunService.Compare(c.Request.Context(), &request, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// CompareEngines godoc
+// @Summary Compare two OCR engine versions
+// @Description Per-field accuracy of two engine versions on OCR projects approved by a reviewer
+// @Tags ocr-runs
+// @Accept json
+// @Produce json
+// @Param baseEngine query string true "Base engine name"
+// @Param baseVersion query string true "Base engine version"
+// @Param candidateEngine query string true "Candidate engine name"
+// @Param candidateVersion query string true "Candidate engine version"
+// @Param fields query []string false "Fields to score, all OCR fields when empty" collectionFormat(multi)
+// @Security BearerAuth
+// @Success 200 {object} dtos.OcrEngineComparisonDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /ocr-runs/engine-comparison [get]
+func (h *OcrRunHandler) CompareEngines(c *gin.Context) {
+	var request dtos.CompareOcrEnginesRequestDto
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.ocrRunService.CompareEngines(c.Request.Context(), &request)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
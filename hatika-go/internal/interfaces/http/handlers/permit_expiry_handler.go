@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PermitExpiryHandler handles HTTP requests for expiring building permits
+type PermitExpiryHandler struct {
+	permitExpiryService *services.PermitExpiryService
+}
+
+// NewPermitExpiryHandler creates a new permit expiry handler
+func NewPermitExpiryHandler(permitExpiryService *services.PermitExpiryService) *PermitExpiryHandler {
+	return &PermitExpiryHandler{
+		permitExpiryService: permitExpiryService,
+	}
+}
+
+// GetExpiring godoc
+// @Summary Get projects with expiring permits
+// @Description List the projects whose building permit expires within the given days, soonest first
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param pageNumber query int true "Page number" minimum(1)
+// @Param pageSize query int true "Page size" minimum(1) maximum(100)
+// @Param days query int false "Days ahead, defaults to the largest reminder window" minimum(1) maximum(3650)
+// @Param includeExpired query bool false "Also list projects whose permit has already expired"
+// @Param groupId query int false "Group ID filter"
+// @Param bildirimNo query string false "Bildirim No filter"
+// @Param projectCode query string false "Project Code filter"
+// @Param projectName query string false "Project Name filter"
+// @Param projectMuellef query string false "Project Muellef filter"
+// @Param sorting query string false "Sort expression, e.g. 'ruhsatGecerlilikDate desc'"
+// @Param filter query string false "Filter expression, e.g. 'groupId in (1,2)'"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} object "Paged result with projects and their days left"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/expiring [get]
+func (h *PermitExpiryHandler) GetExpiring(c *gin.Context) {
+	var request dtos.ExpiringProjectsRequestDto
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.permitExpiryService.GetExpiring(c.Request.Context(), &request, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProjectBulkHandler handles HTTP requests for bulk project operations
+type ProjectBulkHandler struct {
+	bulkService *services.ProjectBulkService
+}
+
+// NewProjectBulkHandler creates a new project bulk handler
+func NewProjectBulkHandler(bulkService *services.ProjectBulkService) *ProjectBulkHandler {
+	return &ProjectBulkHandler{
+		bulkService: bulkService,
+	}
+}
+
+// Run godoc
+// @Summary Run a bulk project operation
+// @Description Update fields (a JSON Merge Patch in fields), change the group, delete or restore the projects selected by idList or by the list filters. Every project is checked as a single request would be and gets its own result. Small selections run in one transaction and answer 200 with every result; larger ones, or background=true, answer 202 and run in chunks.
+// @Tags project-bulk
+// @Accept json
+// @Produce json
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param input body dtos.ProjectBulkRequestDto true "Selection and operation"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectBulkOperationDto
+// @Success 202 {object} dtos.ProjectBulkOperationDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/bulk [post]
+func (h *ProjectBulkHandler) Run(c *gin.Context) {
+	var input dtos.ProjectBulkRequestDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	var applyFields func(input *dtos.UpdateProjectDto) error
+	if len(input.Fields) > 0 {
+		var members map[string]json.RawMessage
+		if err := json.Unmarshal(input.Fields, &members); err != nil || members == nil {
+			utils.RespondWithValidationError(c, "fields must be a JSON object")
+			return
+		}
+		patch := input.Fields
+		applyFields = func(input *dtos.UpdateProjectDto) error {
+			return applyMergePatch(input, patch)
+		}
+	}
+
+	result, err := h.bulkService.Run(c.Request.Context(), &input, applyFields, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	if result.Background {
+		utils.RespondWithSuccess(c, http.StatusAccepted, result, "Project bulk operation queued")
+		return
+	}
+	utils.RespondWithSuccess(c, http.StatusOK, result, "Project bulk operation completed")
+}
+
+// GetByID godoc
+// @Summary Get project bulk operation
+// @Description Progress and counters of a bulk project operation
+// @Tags project-bulk
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Bulk Operation ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectBulkOperationDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/bulk/{id} [get]
+func (h *ProjectBulkHandler) GetByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project bulk operation ID", nil)
+		return
+	}
+
+	result, err := h.bulkService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// GetItems godoc
+// @Summary Get project bulk operation results
+// @Description Per-project result of a bulk operation in processing order
+// @Tags project-bulk
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Bulk Operation ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param pageNumber query int true "Page number"
+// @Param pageSize query int true "Page size (max 1000)"
+// @Param outcome query int false "Only results with this outcome (0 Succeeded, 1 Skipped, 2 NotFound, 3 Forbidden, 4 Failed)"
+// @Security BearerAuth
+// @Success 200 {object} object "Paged result with bulk operation items"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/bulk/{id}/items [get]
+func (h *ProjectBulkHandler) GetItems(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project bulk operation ID", nil)
+		return
+	}
+
+	var request dtos.ProjectBulkItemsRequestDto
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.bulkService.GetItems(c.Request.Context(), id, &request)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"log"
+	"mime"
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/internal/infrastructure/spreadsheet"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProjectExportHandler handles HTTP requests for project exports
+type ProjectExportHandler struct {
+	exportService *services.ProjectExportService
+}
+
+// NewProjectExportHandler creates a new project export handler
+func NewProjectExportHandler(exportService *services.ProjectExportService) *ProjectExportHandler {
+	return &ProjectExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportList godoc
+// @Summary Export projects
+// @Description Export the projects matching the list filters as CSV or XLSX. The format comes from the format parameter or the Accept header; CSV is the default. Rows are streamed, so errors after the first byte only end the download early.
+// @Tags project-exports
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param format query string false "Export format" Enums(csv, xlsx)
+// @Param groupId query int false "Group ID filter"
+// @Param bildirimNo query string false "Bildirim No filter"
+// @Param projectCode query string false "Project Code filter"
+// @Param projectName query string false "Project Name filter"
+// @Param projectMuellef query string false "Project Muellef filter"
+// @Param sorting query string false "Sort expression, e.g. 'projectName desc, createdAt asc'"
+// @Param filter query string false "Filter expression, e.g. 'kuruluGuc gt 500 and groupId in (1,2)'"
+// @Security BearerAuth
+// @Success 200 {file} file
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 406 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/export [get]
+func (h *ProjectExportHandler) ExportList(c *gin.Context) {
+	format, ok := negotiateFormat(c,
+		formatOffer{string(spreadsheet.FormatCSV), "text/csv"},
+		formatOffer{string(spreadsheet.FormatXLSX), spreadsheet.ContentTypes[spreadsheet.FormatXLSX]},
+	)
+	if !ok {
+		return
+	}
+
+	var request dtos.ProjectExportRequestDto
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	export, err := h.exportService.ExportList(c.Request.Context(), &request, spreadsheet.Format(format), currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	writeExport(c, export)
+}
+
+// ExportSummary godoc
+// @Summary Export project summary
+// @Description PDF summary of a project with its fields and the state of each OCR document
+// @Tags project-exports
+// @Produce application/pdf
+// @Param id path int true "Project ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param format query string false "Export format" Enums(pdf)
+// @Security BearerAuth
+// @Success 200 {file} file
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 406 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id}/export [get]
+func (h *ProjectExportHandler) ExportSummary(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+	if _, ok := negotiateFormat(c, formatOffer{"pdf", services.ProjectExportContentTypePDF}); !ok {
+		return
+	}
+
+	export, err := h.exportService.ExportSummary(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	writeExport(c, export)
+}
+
+// writeExport sends the export as a download. Once the body has started the status cannot change,
+// so write errors are only logged.
+func writeExport(c *gin.Context, export *services.ProjectExport) {
+	c.Header("Content-Type", export.ContentType)
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": export.FileName}))
+	c.Status(http.StatusOK)
+
+	if err := export.Write(c.Writer); err != nil {
+		log.Printf("Project export %s failed: %v", export.FileName, err)
+		c.Abort()
+	}
+}
@@ -0,0 +1,290 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProjectGroupHandler handles HTTP requests for project groups
+type ProjectGroupHandler struct {
+	groupService *services.ProjectGroupService
+}
+
+// NewProjectGroupHandler creates a new project group handler
+func NewProjectGroupHandler(groupService *services.ProjectGroupService) *ProjectGroupHandler {
+	return &ProjectGroupHandler{
+		groupService: groupService,
+	}
+}
+
+// GetAll godoc
+// @Summary Get all project groups
+// @Description List the project groups of the current tenant the user may see, sorted by name
+// @Tags project-groups
+// @Accept json
+// @Produce json
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {array} dtos.ProjectGroupDto
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /project-groups [get]
+func (h *ProjectGroupHandler) GetAll(c *gin.Context) {
+	result, err := h.groupService.GetAll(c.Request.Context(), currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// GetAllStats godoc
+// @Summary Get statistics of all project groups
+// @Description Project count and OCR completion of every visible group, including its visible subgroups
+// @Tags project-groups
+// @Accept json
+// @Produce json
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {array} dtos.ProjectGroupStatsDto
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /project-groups/stats [get]
+func (h *ProjectGroupHandler) GetAllStats(c *gin.Context) {
+	result, err := h.groupService.GetAllStats(c.Request.Context(), currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// GetByID godoc
+// @Summary Get project group by ID
+// @Description Get a single project group
+// @Tags project-groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Group ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectGroupDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /project-groups/{id} [get]
+func (h *ProjectGroupHandler) GetByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project group ID", nil)
+		return
+	}
+
+	result, err := h.groupService.GetByID(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// GetStats godoc
+// @Summary Get project group statistics
+// @Description Project count and OCR completion of a group, including its visible subgroups
+// @Tags project-groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Group ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectGroupStatsDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /project-groups/{id}/stats [get]
+func (h *ProjectGroupHandler) GetStats(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project group ID", nil)
+		return
+	}
+
+	result, err := h.groupService.GetStats(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// Create godoc
+// @Summary Create a project group
+// @Description Create a project group for the current tenant, optionally below a parent group
+// @Tags project-groups
+// @Accept json
+// @Produce json
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param group body dtos.CreateProjectGroupDto true "Project group data"
+// @Security BearerAuth
+// @Success 201 {object} dtos.ProjectGroupDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /project-groups [post]
+func (h *ProjectGroupHandler) Create(c *gin.Context) {
+	var input dtos.CreateProjectGroupDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.groupService.Create(c.Request.Context(), &input, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusCreated, result, "Project group created successfully")
+}
+
+// Update godoc
+// @Summary Update a project group
+// @Description Rename a project group or move it below another group
+// @Tags project-groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Group ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param group body dtos.UpdateProjectGroupDto true "Project group data"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectGroupDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /project-groups/{id} [put]
+func (h *ProjectGroupHandler) Update(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project group ID", nil)
+		return
+	}
+
+	var input dtos.UpdateProjectGroupDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.groupService.Update(c.Request.Context(), id, &input, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "Project group updated successfully")
+}
+
+// Delete godoc
+// @Summary Delete a project group
+// @Description Soft delete a project group; groups with projects or subgroups cannot be deleted
+// @Tags project-groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Group ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /project-groups/{id} [delete]
+func (h *ProjectGroupHandler) Delete(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project group ID", nil)
+		return
+	}
+
+	if err := h.groupService.Delete(c.Request.Context(), id, currentUserID(c)); err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, nil, "Project group deleted successfully")
+}
+
+// GetPermissions godoc
+// @Summary Get project group permissions
+// @Description List the users granted access to a project group
+// @Tags project-groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Group ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectGroupPermissionsDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /project-groups/{id}/permissions [get]
+func (h *ProjectGroupHandler) GetPermissions(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project group ID", nil)
+		return
+	}
+
+	result, err := h.groupService.GetPermissions(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// SetPermissions godoc
+// @Summary Set project group permissions
+// @Description Replace the users granted access to a project group and its subgroups; an empty list lifts the restriction
+// @Tags project-groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Group ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param permissions body dtos.ProjectGroupPermissionsDto true "Granted users"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectGroupPermissionsDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /project-groups/{id}/permissions [put]
+func (h *ProjectGroupHandler) SetPermissions(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project group ID", nil)
+		return
+	}
+
+	var input dtos.ProjectGroupPermissionsDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.groupService.SetPermissions(c.Request.Context(), id, &input, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "Project group permissions updated successfully")
+}
@@ -29,13 +29,19 @@ func NewProjectHandler(projectService *services.ProjectService) *ProjectHandler
 // @Tags projects
 // @Accept json
 // @Produce json
-// @Param pageNumber query int true "Page number" minimum(1)
-// @Param pageSize query int true "Page size" minimum(1) maximum(100)
+// @Param pageNumber query int false "Page number, required in offset mode" minimum(1)
+// @Param pageSize query int false "Page size, required in offset mode" minimum(1) maximum(100)
+// @Param paging query string false "Set to cursor for keyset pagination" Enums(offset, cursor)
+// @Param cursor query string false "Cursor from a previous nextCursor or prevCursor; implies cursor mode"
+// @Param limit query int false "Page size, required in cursor mode" minimum(1) maximum(100)
+// @Param includeTotal query bool false "Also count all matching projects in cursor mode"
 // @Param groupId query int false "Group ID filter"
 // @Param bildirimNo query string false "Bildirim No filter"
 // @Param projectCode query string false "Project Code filter"
 // @Param projectName query string false "Project Name filter"
 // @Param projectMuellef query string false "Project Muellef filter"
+// @Param sorting query string false "Sort expression, e.g. 'projectName desc, createdAt asc'"
+// @Param filter query string false "Filter expression, e.g. 'kuruluGuc gt 500 and groupId in (1,2)'"
 // @Security BearerAuth
 // @Success 200 {object} object "Paged result with projects"
 // @Failure 400 {object} utils.ErrorResponse
@@ -43,6 +49,11 @@ func NewProjectHandler(projectService *services.ProjectService) *ProjectHandler
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /projects [get]
 func (h *ProjectHandler) GetAll(c *gin.Context) {
+	if c.Query("paging") == "cursor" || c.Query("cursor") != "" {
+		h.getAllByCursor(c)
+		return
+	}
+
 	var request dtos.PagedProjectResultRequestDto
 
 	if err := c.ShouldBindQuery(&request); err != nil {
@@ -50,9 +61,58 @@ func (h *ProjectHandler) GetAll(c *gin.Context) {
 		return
 	}
 
-	result, err := h.projectService.GetAll(c.Request.Context(), &request)
+	result, err := h.projectService.GetAll(c.Request.Context(), &request, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+func (h *ProjectHandler) getAllByCursor(c *gin.Context) {
+	var request dtos.CursorProjectResultRequestDto
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.projectService.GetAllByCursor(c.Request.Context(), &request, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// Search godoc
+// @Summary Full-text search projects
+// @Description Rank projects by their name, owner, contractor, address, permit number and OCR text, with highlighted snippets
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param q query string true "Search text; supports quoted phrases, or and -excluded words" minlength(2) maxlength(200)
+// @Param pageNumber query int true "Page number" minimum(1)
+// @Param pageSize query int true "Page size" minimum(1) maximum(100)
+// @Security BearerAuth
+// @Success 200 {object} object "Paged result with search hits"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/search [get]
+func (h *ProjectHandler) Search(c *gin.Context) {
+	var request dtos.ProjectSearchRequestDto
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.projectService.Search(c.Request.Context(), &request, currentUserID(c))
 	if err != nil {
-		utils.RespondInternalError(c, err.Error())
+		utils.RespondWithAppError(c, err)
 		return
 	}
 
@@ -68,6 +128,7 @@ func (h *ProjectHandler) GetAll(c *gin.Context) {
 // @Param id path int true "Project ID"
 // @Security BearerAuth
 // @Success 200 {object} dtos.ProjectDto
+// @Header 200 {string} ETag "Version of the project, for If-Match"
 // @Failure 400 {object} utils.ErrorResponse
 // @Failure 404 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
@@ -80,25 +141,23 @@ func (h *ProjectHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	result, err := h.projectService.GetByID(c.Request.Context(), id)
+	result, err := h.projectService.GetByID(c.Request.Context(), id, currentUserID(c))
 	if err != nil {
-		if err.Error() == "project not found" {
-			utils.RespondNotFound(c, "Project not found")
-			return
-		}
-		utils.RespondInternalError(c, err.Error())
+		utils.RespondWithAppError(c, err)
 		return
 	}
 
+	setETag(c, result.Version)
 	utils.RespondWithSuccess(c, http.StatusOK, result, "")
 }
 
 // Create godoc
 // @Summary Create a new project
-// @Description Create a new project with 4 OCR projects automatically
+// @Description Create a new project with the OCR projects of the tenant's matching template (by default four)
 // @Tags projects
 // @Accept json
 // @Produce json
+// @Param Abp.TenantId header int false "Tenant ID"
 // @Param project body dtos.CreateProjectDto true "Project data"
 // @Security BearerAuth
 // @Success 201 {object} dtos.ProjectDto
@@ -114,9 +173,9 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 		return
 	}
 
-	result, err := h.projectService.Create(c.Request.Context(), &input)
+	result, err := h.projectService.Create(c.Request.Context(), &input, currentUserID(c))
 	if err != nil {
-		utils.RespondInternalError(c, err.Error())
+		utils.RespondWithAppError(c, err)
 		return
 	}
 
@@ -131,10 +190,13 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 // @Produce json
 // @Param id path int true "Project ID"
 // @Param project body dtos.UpdateProjectDto true "Project data"
+// @Param If-Match header string false "ETag of the version the change is based on"
 // @Security BearerAuth
 // @Success 200 {object} dtos.ProjectDto
+// @Header 200 {string} ETag "Version of the updated project"
 // @Failure 400 {object} utils.ErrorResponse
 // @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /projects/{id} [put]
 func (h *ProjectHandler) Update(c *gin.Context) {
@@ -151,30 +213,81 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 		return
 	}
 
-	result, err := h.projectService.Update(c.Request.Context(), id, &input)
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.projectService.Update(c.Request.Context(), id, &input, currentUserID(c), expectedVersion)
 	if err != nil {
-		if err.Error() == "project not found" {
-			utils.RespondNotFound(c, "Project not found")
-			return
-		}
-		utils.RespondInternalError(c, err.Error())
+		utils.RespondWithAppError(c, err)
 		return
 	}
 
+	setETag(c, result.Version)
+	utils.RespondWithSuccess(c, http.StatusOK, result, "Project updated successfully")
+}
+
+// Patch godoc
+// @Summary Partially update a project
+// @Description Apply a JSON Merge Patch (RFC 7396): members sent replace the current values, null clears a value and omitted members are kept. The result must pass the same validation as a full update.
+// @Tags projects
+// @Accept application/merge-patch+json
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param patch body dtos.UpdateProjectDto true "Members to change"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectDto
+// @Header 200 {string} ETag "Version of the updated project"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 415 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id} [patch]
+func (h *ProjectHandler) Patch(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+
+	patch, ok := readMergePatch(c)
+	if !ok {
+		return
+	}
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.projectService.Patch(c.Request.Context(), id, func(input *dtos.UpdateProjectDto) error {
+		return applyMergePatch(input, patch)
+	}, currentUserID(c), expectedVersion)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	setETag(c, result.Version)
 	utils.RespondWithSuccess(c, http.StatusOK, result, "Project updated successfully")
 }
 
 // Delete godoc
 // @Summary Delete a project
-// @Description Soft delete a project
+// @Description Soft delete a project with its OCR projects and documents
 // @Tags projects
 // @Accept json
 // @Produce json
 // @Param id path int true "Project ID"
+// @Param If-Match header string false "ETag of the version the deletion is based on"
 // @Security BearerAuth
 // @Success 200 {object} utils.SuccessResponse
 // @Failure 400 {object} utils.ErrorResponse
 // @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /projects/{id} [delete]
 func (h *ProjectHandler) Delete(c *gin.Context) {
@@ -185,17 +298,132 @@ func (h *ProjectHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	// TODO: Get user ID from JWT context
-	userID := 1 // Placeholder
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
 
-	if err := h.projectService.Delete(c.Request.Context(), id, userID); err != nil {
-		if err.Error() == "project not found" {
-			utils.RespondNotFound(c, "Project not found")
-			return
-		}
-		utils.RespondInternalError(c, err.Error())
+	userID := currentUserID(c)
+
+	if err := h.projectService.Delete(c.Request.Context(), id, userID, expectedVersion); err != nil {
+		utils.RespondWithAppError(c, err)
 		return
 	}
 
 	utils.RespondWithSuccess(c, http.StatusOK, nil, "Project deleted successfully")
 }
+
+// Restore godoc
+// @Summary Restore a project
+// @Description Take a soft-deleted project out of the recycle bin, with the OCR projects and documents deleted along with it
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param If-Match header string false "ETag of the version the restore is based on"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id}/restore [post]
+func (h *ProjectHandler) Restore(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.projectService.Restore(c.Request.Context(), id, currentUserID(c), expectedVersion)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	setETag(c, result.Version)
+	utils.RespondWithSuccess(c, http.StatusOK, result, "Project restored successfully")
+}
+
+// AddOcrProject godoc
+// @Summary Add a document slot
+// @Description Add an OCR project of the given document type to an existing project
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param slot body dtos.AddOcrProjectSlotDto true "Document slot"
+// @Security BearerAuth
+// @Success 201 {object} dtos.OcrProjectDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id}/ocr-projects [post]
+func (h *ProjectHandler) AddOcrProject(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+
+	var input dtos.AddOcrProjectSlotDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.projectService.AddOcrProjectSlot(c.Request.Context(), id, &input, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusCreated, result, "Document slot added successfully")
+}
+
+// RemoveOcrProject godoc
+// @Summary Remove a document slot
+// @Description Soft delete an OCR project of a project
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param ocrProjectId path int true "OCR Project ID"
+// @Param If-Match header string false "ETag of the OCR project version the removal is based on"
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id}/ocr-projects/{ocrProjectId} [delete]
+func (h *ProjectHandler) RemoveOcrProject(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+	ocrProjectID, ok := parseIDParam(c, "ocrProjectId")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid OCR project ID", nil)
+		return
+	}
+
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	if err := h.projectService.RemoveOcrProjectSlot(c.Request.Context(), id, ocrProjectID, currentUserID(c), expectedVersion); err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, nil, "Document slot removed successfully")
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProjectImportHandler handles HTTP requests for bulk project imports
+type ProjectImportHandler struct {
+	importService *services.ProjectImportService
+}
+
+// NewProjectImportHandler creates a new project import handler
+func NewProjectImportHandler(importService *services.ProjectImportService) *ProjectImportHandler {
+	return &ProjectImportHandler{
+		importService: importService,
+	}
+}
+
+// Import godoc
+// @Summary Import projects from CSV or XLSX
+// @Description Create projects, or update them by ProjectCode, from the rows of a CSV file or the first sheet of a workbook. The header row is checked immediately; rows are processed in the background.
+// @Tags project-imports
+// @Accept multipart/form-data
+// @Produce json
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param file formData file true "CSV or XLSX file"
+// @Param dryRun formData bool false "Validate every row without saving"
+// @Param mapping formData string false "JSON object from column header to project field, e.g. {\"Dosya No\": \"projectCode\"}"
+// @Security BearerAuth
+// @Success 202 {object} dtos.ProjectImportDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/import [post]
+func (h *ProjectImportHandler) Import(c *gin.Context) {
+	var input dtos.ProjectImportRequestDto
+	if err := c.ShouldBind(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		utils.RespondWithValidationError(c, "file is required")
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Could not read uploaded file", err.Error())
+		return
+	}
+	defer file.Close()
+
+	result, err := h.importService.Import(c.Request.Context(), currentUserID(c), fileHeader.Filename, file, fileHeader.Size, &input)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusAccepted, result, "Project import queued")
+}
+
+// GetByID godoc
+// @Summary Get project import
+// @Description Progress and counters of a project import
+// @Tags project-imports
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Import ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ProjectImportDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/imports/{id} [get]
+func (h *ProjectImportHandler) GetByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project import ID", nil)
+		return
+	}
+
+	result, err := h.importService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// GetRows godoc
+// @Summary Get project import report
+// @Description Per-row result of a project import in file order: created, updated or failed with reasons
+// @Tags project-imports
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Import ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param pageNumber query int true "Page number"
+// @Param pageSize query int true "Page size (max 1000)"
+// @Param action query int false "Only rows with this action (0 Created, 1 Updated, 2 Failed)"
+// @Security BearerAuth
+// @Success 200 {object} object "Paged result with import rows"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/imports/{id}/rows [get]
+func (h *ProjectImportHandler) GetRows(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project import ID", nil)
+		return
+	}
+
+	var request dtos.ProjectImportRowsRequestDto
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.importService.GetRows(c.Request.Context(), id, &request)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
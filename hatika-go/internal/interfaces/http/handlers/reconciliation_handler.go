@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReconciliationHandler handles HTTP requests for cross-document reconciliation
+type ReconciliationHandler struct {
+	reconciliationService *services.ReconciliationService
+}
+
+// NewReconciliationHandler creates a new reconciliation handler
+func NewReconciliationHandler(reconciliationService *services.ReconciliationService) *ReconciliationHandler {
+	return &ReconciliationHandler{
+		reconciliationService: reconciliationService,
+	}
+}
+
+// GetReport godoc
+// @Summary Get reconciliation report
+// @Description Compare shared fields (ada, parsel, yapiSahibi, adress, kuruluGuc) across a project's approved OCR documents and the project; documents not approved in review are left out
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ReconciliationReportDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id}/reconciliation [get]
+func (h *ReconciliationHandler) GetReport(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+
+	result, err := h.reconciliationService.GetReport(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// Apply godoc
+// @Summary Apply reconciled values
+// @Description Copy the values all approved OCR documents agree on onto the project in one transaction
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param fields body dtos.ApplyReconciliationDto false "Fields to apply; empty applies every agreed field"
+// @Security BearerAuth
+// @Success 200 {object} dtos.ReconciliationReportDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /projects/{id}/reconciliation/apply [post]
+func (h *ReconciliationHandler) Apply(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid project ID", nil)
+		return
+	}
+
+	var input dtos.ApplyReconciliationDto
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			utils.RespondWithValidationError(c, err.Error())
+			return
+		}
+	}
+
+	result, err := h.reconciliationService.Apply(c.Request.Context(), id, &input, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "Reconciled values applied successfully")
+}
@@ -0,0 +1,258 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hatika-go/internal/application/dtos"
+	"hatika-go/internal/application/services"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookHandler handles HTTP requests for webhook subscriptions and their delivery logs
+type WebhookHandler struct {
+	webhookService *services.WebhookService
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(webhookService *services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// GetEventTypes godoc
+// @Summary Get webhook event types
+// @Description List the event types a webhook can subscribe to; "*" subscribes to every event
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} string
+// @Router /webhooks/event-types [get]
+func (h *WebhookHandler) GetEventTypes(c *gin.Context) {
+	utils.RespondWithSuccess(c, http.StatusOK, h.webhookService.GetEventTypes(), "")
+}
+
+// GetAll godoc
+// @Summary Get all webhook subscriptions
+// @Description List the webhook subscriptions of the current tenant
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {array} dtos.WebhookSubscriptionDto
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks [get]
+func (h *WebhookHandler) GetAll(c *gin.Context) {
+	result, err := h.webhookService.GetAll(c.Request.Context())
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// GetByID godoc
+// @Summary Get webhook subscription by ID
+// @Description Get a single webhook subscription of the current tenant
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook Subscription ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} dtos.WebhookSubscriptionDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) GetByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid webhook subscription ID", nil)
+		return
+	}
+
+	result, err := h.webhookService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// Create godoc
+// @Summary Create a webhook subscription
+// @Description Subscribe an HTTP endpoint to events of the current tenant. The response carries the signing secret, which cannot be read again.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param subscription body dtos.CreateWebhookSubscriptionDto true "Webhook subscription data"
+// @Security BearerAuth
+// @Success 201 {object} dtos.WebhookSubscriptionCreatedDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks [post]
+func (h *WebhookHandler) Create(c *gin.Context) {
+	var input dtos.CreateWebhookSubscriptionDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.webhookService.Create(c.Request.Context(), &input, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusCreated, result, "Webhook subscription created successfully")
+}
+
+// Update godoc
+// @Summary Update a webhook subscription
+// @Description Change the URL, event types or secret of a webhook subscription, or deactivate it
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook Subscription ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Param subscription body dtos.UpdateWebhookSubscriptionDto true "Webhook subscription data"
+// @Security BearerAuth
+// @Success 200 {object} dtos.WebhookSubscriptionDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks/{id} [put]
+func (h *WebhookHandler) Update(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid webhook subscription ID", nil)
+		return
+	}
+
+	var input dtos.UpdateWebhookSubscriptionDto
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.webhookService.Update(c.Request.Context(), id, &input, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "Webhook subscription updated successfully")
+}
+
+// Delete godoc
+// @Summary Delete a webhook subscription
+// @Description Soft delete a webhook subscription; its pending deliveries are dead-lettered
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook Subscription ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid webhook subscription ID", nil)
+		return
+	}
+
+	if err := h.webhookService.Delete(c.Request.Context(), id, currentUserID(c)); err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, nil, "Webhook subscription deleted successfully")
+}
+
+// GetDeliveries godoc
+// @Summary Get webhook deliveries
+// @Description Page through the delivery log of a webhook subscription, newest first
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook Subscription ID"
+// @Param pageNumber query int true "Page number" minimum(1)
+// @Param pageSize query int true "Page size" minimum(1) maximum(100)
+// @Param status query string false "Delivery status" Enums(Pending, Succeeded, DeadLettered)
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 200 {object} object "Paged result with webhook deliveries"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid webhook subscription ID", nil)
+		return
+	}
+
+	var request dtos.WebhookDeliveryListRequestDto
+	if err := c.ShouldBindQuery(&request); err != nil {
+		utils.RespondWithValidationError(c, err.Error())
+		return
+	}
+
+	result, err := h.webhookService.GetDeliveries(c.Request.Context(), id, &request)
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusOK, result, "")
+}
+
+// Redeliver godoc
+// @Summary Redeliver a webhook delivery
+// @Description Queue a delivery to be sent again right away with a fresh set of attempts, e.g. after it was dead-lettered
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook Subscription ID"
+// @Param deliveryId path int true "Webhook Delivery ID"
+// @Param Abp.TenantId header int false "Tenant ID"
+// @Security BearerAuth
+// @Success 202 {object} dtos.WebhookDeliveryDto
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid webhook subscription ID", nil)
+		return
+	}
+	deliveryID, ok := parseIDParam(c, "deliveryId")
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid webhook delivery ID", nil)
+		return
+	}
+
+	result, err := h.webhookService.Redeliver(c.Request.Context(), id, deliveryID, currentUserID(c))
+	if err != nil {
+		utils.RespondWithAppError(c, err)
+		return
+	}
+
+	utils.RespondWithSuccess(c, http.StatusAccepted, result, "Webhook delivery queued")
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"strconv"
+
+	"hatika-go/pkg/multitenancy"
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TenantResolverMiddleware puts the tenant selected by the Abp.TenantId header into the request context
+func TenantResolverMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader(multitenancy.TenantIDHeader)
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		tenantID, err := strconv.Atoi(header)
+		if err != nil || tenantID <= 0 {
+			utils.RespondWithValidationError(c, "Invalid "+multitenancy.TenantIDHeader+" header")
+			c.Abort()
+			return
+		}
+
+		ctx := multitenancy.WithTenantID(c.Request.Context(), &tenantID)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
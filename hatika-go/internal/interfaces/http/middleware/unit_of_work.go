@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"hatika-go/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TransactionRunner runs work in one transaction carried by the context, rolling it back when the
+// work returns an error or panics
+type TransactionRunner interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// errRequestFailed rolls back the transaction of a request that was answered with an error status
+var errRequestFailed = errors.New("request failed")
+
+// UnitOfWorkMiddleware runs each mutating request in one transaction, committed when the handler
+// answers with a success status and rolled back on an error status or a panic. The response is held
+// back until the commit, so a client never sees success for changes that failed to commit.
+func UnitOfWorkMiddleware(runner TransactionRunner) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		buffered := &bufferedResponseWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = buffered
+		defer func() { c.Writer = original }()
+
+		err := runner.Do(c.Request.Context(), func(ctx context.Context) error {
+			c.Request = c.Request.WithContext(ctx)
+			c.Next()
+			if buffered.status >= http.StatusBadRequest || len(c.Errors) > 0 {
+				return errRequestFailed
+			}
+			return nil
+		})
+
+		c.Writer = original
+		if err != nil && !errors.Is(err, errRequestFailed) {
+			log.Printf("Failed to commit %s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+			utils.RespondWithError(c, http.StatusInternalServerError, "The changes could not be saved", nil)
+			return
+		}
+		buffered.flush()
+	}
+}
+
+// bufferedResponseWriter holds a response in memory until the transaction behind it is settled
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	if code > 0 && !w.written {
+		w.status = code
+	}
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {
+	w.written = true
+}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	w.written = true
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	if !w.written {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.written
+}
+
+// Flush is a no-op; the response goes out as a whole once the transaction is settled
+func (w *bufferedResponseWriter) Flush() {}
+
+// flush sends the held response to the client. A status set without a body is passed on for gin to
+// write when the request ends, as it would have been without buffering.
+func (w *bufferedResponseWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	if !w.written {
+		return
+	}
+	w.ResponseWriter.WriteHeaderNow()
+	if w.body.Len() > 0 {
+		if _, err := w.ResponseWriter.Write(w.body.Bytes()); err != nil {
+			log.Printf("Failed to write response: %v", err)
+		}
+	}
+}
@@ -10,8 +10,22 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// SetupRouter wires the routes; with a unit of work every mutating request runs in one transaction
 func SetupRouter(
+	unitOfWork middleware.TransactionRunner,
 	projectHandler *handlers.ProjectHandler,
+	ocrProjectHandler *handlers.OcrProjectHandler,
+	reconciliationHandler *handlers.ReconciliationHandler,
+	ocrProjectTemplateHandler *handlers.OcrProjectTemplateHandler,
+	documentHandler *handlers.DocumentHandler,
+	ocrRunHandler *handlers.OcrRunHandler,
+	projectGroupHandler *handlers.ProjectGroupHandler,
+	projectImportHandler *handlers.ProjectImportHandler,
+	projectBulkHandler *handlers.ProjectBulkHandler,
+	projectExportHandler *handlers.ProjectExportHandler,
+	permitExpiryHandler *handlers.PermitExpiryHandler,
+	notificationHandler *handlers.NotificationHandler,
+	webhookHandler *handlers.WebhookHandler,
 ) *gin.Engine {
 	gin.SetMode(gin.ReleaseMode)
 
@@ -20,7 +34,12 @@ func SetupRouter(
 	router.Use(middleware.LoggerMiddleware())
 	router.Use(middleware.ErrorHandlerMiddleware())
 	router.Use(middleware.CorsMiddleware())
+	router.Use(middleware.TenantResolverMiddleware())
 	router.Use(gin.Recovery())
+	if unitOfWork != nil {
+		// Innermost, so a panicking handler rolls back before it is recovered
+		router.Use(middleware.UnitOfWorkMiddleware(unitOfWork))
+	}
 
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -38,17 +57,106 @@ func SetupRouter(
 		projects := v1.Group("/projects")
 		{
 			projects.GET("", projectHandler.GetAll)
+			projects.GET("/search", projectHandler.Search)
+			projects.GET("/export", projectExportHandler.ExportList)
+			projects.GET("/expiring", permitExpiryHandler.GetExpiring)
+			projects.POST("/import", projectImportHandler.Import)
+			projects.GET("/imports/:id", projectImportHandler.GetByID)
+			projects.GET("/imports/:id/rows", projectImportHandler.GetRows)
+			projects.POST("/bulk", projectBulkHandler.Run)
+			projects.GET("/bulk/:id", projectBulkHandler.GetByID)
+			projects.GET("/bulk/:id/items", projectBulkHandler.GetItems)
 			projects.GET("/:id", projectHandler.GetByID)
 			projects.POST("", projectHandler.Create)
 			projects.PUT("/:id", projectHandler.Update)
+			projects.PATCH("/:id", projectHandler.Patch)
 			projects.DELETE("/:id", projectHandler.Delete)
+			projects.POST("/:id/restore", projectHandler.Restore)
+			projects.GET("/:id/export", projectExportHandler.ExportSummary)
+			projects.GET("/:id/reconciliation", reconciliationHandler.GetReport)
+			projects.POST("/:id/reconciliation/apply", reconciliationHandler.Apply)
+			projects.POST("/:id/ocr-projects", projectHandler.AddOcrProject)
+			projects.DELETE("/:id/ocr-projects/:ocrProjectId", projectHandler.RemoveOcrProject)
+			projects.POST("/:id/documents", documentHandler.Upload)
+			projects.GET("/:id/documents", documentHandler.GetAll)
+			projects.GET("/:id/documents/:documentId", documentHandler.GetByID)
+			projects.PUT("/:id/documents/:documentId/pages", documentHandler.ReassignPages)
+		}
+
+		// Project Groups
+		projectGroups := v1.Group("/project-groups")
+		{
+			projectGroups.GET("", projectGroupHandler.GetAll)
+			projectGroups.GET("/stats", projectGroupHandler.GetAllStats)
+			projectGroups.GET("/:id", projectGroupHandler.GetByID)
+			projectGroups.POST("", projectGroupHandler.Create)
+			projectGroups.PUT("/:id", projectGroupHandler.Update)
+			projectGroups.DELETE("/:id", projectGroupHandler.Delete)
+			projectGroups.GET("/:id/stats", projectGroupHandler.GetStats)
+			projectGroups.GET("/:id/permissions", projectGroupHandler.GetPermissions)
+			projectGroups.PUT("/:id/permissions", projectGroupHandler.SetPermissions)
+		}
+
+		// OCR Project Templates
+		ocrProjectTemplates := v1.Group("/ocr-project-templates")
+		{
+			ocrProjectTemplates.GET("", ocrProjectTemplateHandler.GetAll)
+			ocrProjectTemplates.GET("/:id", ocrProjectTemplateHandler.GetByID)
+			ocrProjectTemplates.POST("", ocrProjectTemplateHandler.Create)
+			ocrProjectTemplates.PUT("/:id", ocrProjectTemplateHandler.Update)
+			ocrProjectTemplates.DELETE("/:id", ocrProjectTemplateHandler.Delete)
+		}
+
+		// OCR Projects
+		ocrProjects := v1.Group("/ocr-projects")
+		{
+			ocrProjects.GET("/review-queue", ocrProjectHandler.GetReviewQueue)
+			ocrProjects.GET("/:id", ocrProjectHandler.GetByID)
+			ocrProjects.PATCH("/:id", ocrProjectHandler.Patch)
+			ocrProjects.POST("/:id/start", ocrProjectHandler.StartProcessing)
+			ocrProjects.POST("/:id/results", ocrProjectHandler.SubmitResults)
+			ocrProjects.POST("/:id/assign", ocrProjectHandler.AssignReviewer)
+			ocrProjects.POST("/:id/approve", ocrProjectHandler.Approve)
+			ocrProjects.POST("/:id/reject", ocrProjectHandler.Reject)
+			ocrProjects.POST("/:id/reprocess", ocrRunHandler.Reprocess)
+			ocrProjects.GET("/:id/runs", ocrRunHandler.GetByOcrProjectID)
+		}
+
+		// OCR Runs
+		v1.GET("/ocr-engines", ocrRunHandler.GetEngines)
+		ocrRuns := v1.Group("/ocr-runs")
+		{
+			ocrRuns.POST("/bulk", ocrRunHandler.BulkReprocess)
+			ocrRuns.GET("/compare", ocrRunHandler.Compare)
+			ocrRuns.GET("/engine-comparison", ocrRunHandler.CompareEngines)
+			ocrRuns.GET("/batches/:batchId", ocrRunHandler.GetBatch)
+			ocrRuns.GET("/:id", ocrRunHandler.GetByID)
+		}
+
+		// Notifications
+		notifications := v1.Group("/notifications")
+		{
+			notifications.GET("", notificationHandler.GetAll)
+			notifications.POST("/:id/read", notificationHandler.MarkRead)
+		}
+
+		// Webhooks
+		webhooks := v1.Group("/webhooks")
+		{
+			webhooks.GET("", webhookHandler.GetAll)
+			webhooks.GET("/event-types", webhookHandler.GetEventTypes)
+			webhooks.GET("/:id", webhookHandler.GetByID)
+			webhooks.POST("", webhookHandler.Create)
+			webhooks.PUT("/:id", webhookHandler.Update)
+			webhooks.DELETE("/:id", webhookHandler.Delete)
+			webhooks.GET("/:id/deliveries", webhookHandler.GetDeliveries)
+			webhooks.POST("/:id/deliveries/:deliveryId/redeliver", webhookHandler.Redeliver)
 		}
 
 		// TODO: Add more routes
 		// - /auth (login, register)
 		// - /users
 		// - /roles
-		// - /ocr-projects
 		// - /tenants
 	}
 
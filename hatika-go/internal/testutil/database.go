@@ -0,0 +1,190 @@
+// Package testutil gives repository and service tests a database of their own and builders for the
+// entities they need.
+//
+// By default every test gets an in-memory SQLite database whose schema is created from the entities,
+// which is fast and needs nothing installed. With HATIKAGO_TEST_POSTGRES_DSN set, every test gets a
+// schema of its own in that Postgres database instead, migrated with the versioned migrations and
+// dropped when the test ends, so Postgres-only behavior such as full-text search can be tested too.
+package testutil
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"hatika-go/internal/infrastructure/migrations"
+	"hatika-go/internal/infrastructure/persistence"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// PostgresDSNEnv names the environment variable holding the DSN of the Postgres database tests create
+// their schemas in, e.g. "host=localhost port=5433 user=postgres password=postgres dbname=hatikago_test sslmode=disable"
+const PostgresDSNEnv = "HATIKAGO_TEST_POSTGRES_DSN"
+
+// sharedSearchConfiguration sets up what the first migration adds to the whole database rather than to
+// a schema. That migration creates the turkish_unaccent configuration only when the database has none,
+// in the first schema of the search path; creating it in public up front keeps it out of the test
+// schemas, which are dropped, and visible to all of them.
+const sharedSearchConfiguration = `
+CREATE EXTENSION IF NOT EXISTS unaccent;
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'turkish_unaccent') THEN
+        CREATE TEXT SEARCH CONFIGURATION public.turkish_unaccent (COPY = turkish);
+        ALTER TEXT SEARCH CONFIGURATION public.turkish_unaccent
+            ALTER MAPPING FOR hword, hword_part, word WITH unaccent, turkish_stem;
+    END IF;
+END
+$$;`
+
+// NewDatabase returns a database of the test's own: a disposable Postgres schema when
+// HATIKAGO_TEST_POSTGRES_DSN is set, an in-memory SQLite database otherwise
+func NewDatabase(t testing.TB) *gorm.DB {
+	t.Helper()
+	if os.Getenv(PostgresDSNEnv) != "" {
+		return NewPostgresDatabase(t)
+	}
+	return NewSQLiteDatabase(t)
+}
+
+// NewSQLiteDatabase returns an in-memory SQLite database with the schema created from the entities.
+// It has a single connection, so nested transactions work as savepoints but work running in another
+// goroutine waits for an open transaction to finish.
+func NewSQLiteDatabase(t testing.TB) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:?_foreign_keys=on"), gormConfig())
+	if err != nil {
+		t.Fatalf("failed to open SQLite database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get SQLite connection: %v", err)
+	}
+	// Every connection to :memory: is a database of its own
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if err := persistence.RegisterVersioning(db); err != nil {
+		t.Fatalf("failed to register optimistic concurrency: %v", err)
+	}
+	if err := persistence.AutoMigrate(db); err != nil {
+		t.Fatalf("failed to create SQLite schema: %v", err)
+	}
+	return db
+}
+
+// NewPostgresDatabase returns a connection to a new schema of the database named by
+// HATIKAGO_TEST_POSTGRES_DSN, with every migration applied. The schema is dropped when the test
+// ends. The test is skipped when the variable is not set.
+func NewPostgresDatabase(t testing.TB) *gorm.DB {
+	t.Helper()
+
+	db := NewEmptyPostgresDatabase(t)
+	migrator, err := migrations.New(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate test schema: %v", err)
+	}
+	return db
+}
+
+// NewEmptyPostgresDatabase is NewPostgresDatabase without the migrations, for tests that set up a
+// schema of their own
+func NewEmptyPostgresDatabase(t testing.TB) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv(PostgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", PostgresDSNEnv)
+	}
+
+	admin, err := gorm.Open(postgres.Open(dsn), gormConfig())
+	if err != nil {
+		t.Fatalf("failed to connect to Postgres: %v", err)
+	}
+	adminDB, err := admin.DB()
+	if err != nil {
+		t.Fatalf("failed to get Postgres connection: %v", err)
+	}
+	t.Cleanup(func() { adminDB.Close() })
+
+	// Packages run their tests in parallel processes; the lock keeps them from racing to set up
+	// the shared objects
+	if err := admin.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('hatikago:testutil'))").Error; err != nil {
+			return err
+		}
+		return tx.Exec(sharedSearchConfiguration).Error
+	}); err != nil {
+		t.Fatalf("failed to set up full-text search: %v", err)
+	}
+
+	schema := "test_" + randomHex(t, 8)
+	if err := admin.Exec(fmt.Sprintf(`CREATE SCHEMA "%s"`, schema)).Error; err != nil {
+		t.Fatalf("failed to create schema %s: %v", schema, err)
+	}
+	t.Cleanup(func() {
+		if err := admin.Exec(fmt.Sprintf(`DROP SCHEMA "%s" CASCADE`, schema)).Error; err != nil {
+			t.Logf("failed to drop schema %s: %v", schema, err)
+		}
+	})
+
+	db, err := gorm.Open(postgres.Open(withSearchPath(dsn, schema+",public")), gormConfig())
+	if err != nil {
+		t.Fatalf("failed to connect to schema %s: %v", schema, err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get Postgres connection: %v", err)
+	}
+	// Registered after the drop, so the connections are closed before the schema goes away
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if err := persistence.RegisterVersioning(db); err != nil {
+		t.Fatalf("failed to register optimistic concurrency: %v", err)
+	}
+	return db
+}
+
+// gormConfig matches the server's configuration without its query log
+func gormConfig() *gorm.Config {
+	return &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+		NowFunc: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+// withSearchPath adds the search_path run-time parameter to a keyword/value or URL DSN
+func withSearchPath(dsn, searchPath string) string {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		separator := "?"
+		if strings.Contains(dsn, "?") {
+			separator = "&"
+		}
+		return dsn + separator + "search_path=" + strings.ReplaceAll(searchPath, ",", "%2C")
+	}
+	return dsn + " search_path=" + searchPath
+}
+
+func randomHex(t testing.TB, n int) string {
+	t.Helper()
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatalf("failed to generate a random name: %v", err)
+	}
+	return hex.EncodeToString(b)
+}
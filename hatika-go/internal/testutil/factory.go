@@ -0,0 +1,459 @@
+package testutil
+
+import (
+	"fmt"
+	"testing"
+
+	"hatika-go/internal/domain/entities"
+	"hatika-go/internal/domain/valueobjects"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// DefaultPassword is the password of every user a factory creates unless one is given
+const DefaultPassword = "Passw0rd!"
+
+// Factory creates entities for a test. Every entity gets unique, readable defaults, so a test only
+// sets the fields it is about; a failing insert fails the test.
+type Factory struct {
+	t        testing.TB
+	db       *gorm.DB
+	sequence int
+}
+
+// NewFactory creates a factory writing to the database
+func NewFactory(t testing.TB, db *gorm.DB) *Factory {
+	return &Factory{t: t, db: db}
+}
+
+// DB returns the database the factory writes to
+func (f *Factory) DB() *gorm.DB {
+	return f.db
+}
+
+func (f *Factory) next() int {
+	f.sequence++
+	return f.sequence
+}
+
+func (f *Factory) create(value interface{}) {
+	f.t.Helper()
+	if err := f.db.Create(value).Error; err != nil {
+		f.t.Fatalf("failed to create %T: %v", value, err)
+	}
+}
+
+// TenantBuilder builds a tenant
+type TenantBuilder struct {
+	f      *Factory
+	tenant entities.Tenant
+}
+
+// Tenant starts a tenant named tenant-N
+func (f *Factory) Tenant() *TenantBuilder {
+	n := f.next()
+	return &TenantBuilder{f: f, tenant: entities.Tenant{
+		TenancyName: fmt.Sprintf("tenant-%d", n),
+		Name:        fmt.Sprintf("Tenant %d", n),
+		IsActive:    true,
+	}}
+}
+
+// Named sets the tenancy name
+func (b *TenantBuilder) Named(tenancyName string) *TenantBuilder {
+	b.tenant.TenancyName = tenancyName
+	return b
+}
+
+// Inactive marks the tenant as inactive
+func (b *TenantBuilder) Inactive() *TenantBuilder {
+	b.tenant.IsActive = false
+	return b
+}
+
+// Build returns the tenant without saving it
+func (b *TenantBuilder) Build() *entities.Tenant {
+	tenant := b.tenant
+	return &tenant
+}
+
+// Create saves the tenant
+func (b *TenantBuilder) Create() *entities.Tenant {
+	b.f.t.Helper()
+	tenant := b.Build()
+	b.f.create(tenant)
+	if !tenant.IsActive {
+		// IsActive defaults to true in the database, so false is only written by an update
+		if err := b.f.db.Model(tenant).Update("is_active", false).Error; err != nil {
+			b.f.t.Fatalf("failed to deactivate tenant: %v", err)
+		}
+	}
+	return tenant
+}
+
+// RoleBuilder builds a role
+type RoleBuilder struct {
+	f           *Factory
+	role        entities.Role
+	permissions []string
+}
+
+// Role starts a role named role-N
+func (f *Factory) Role() *RoleBuilder {
+	n := f.next()
+	return &RoleBuilder{f: f, role: entities.Role{
+		Name:        fmt.Sprintf("role-%d", n),
+		DisplayName: fmt.Sprintf("Role %d", n),
+	}}
+}
+
+// Named sets the role name, e.g. entities.AdminRoleName
+func (b *RoleBuilder) Named(name string) *RoleBuilder {
+	b.role.Name = name
+	b.role.DisplayName = name
+	return b
+}
+
+// ForTenant puts the role in a tenant
+func (b *RoleBuilder) ForTenant(tenantID int) *RoleBuilder {
+	b.role.TenantID = &tenantID
+	return b
+}
+
+// WithPermissions grants the named permissions, creating the ones that do not exist yet
+func (b *RoleBuilder) WithPermissions(names ...string) *RoleBuilder {
+	b.permissions = append(b.permissions, names...)
+	return b
+}
+
+// Build returns the role without saving it or its permissions
+func (b *RoleBuilder) Build() *entities.Role {
+	role := b.role
+	return &role
+}
+
+// Create saves the role with its permissions
+func (b *RoleBuilder) Create() *entities.Role {
+	b.f.t.Helper()
+	role := b.Build()
+	for _, name := range b.permissions {
+		permission := entities.Permission{Name: name, DisplayName: name}
+		if err := b.f.db.Where(entities.Permission{Name: name}).FirstOrCreate(&permission).Error; err != nil {
+			b.f.t.Fatalf("failed to create permission %s: %v", name, err)
+		}
+		role.Permissions = append(role.Permissions, permission)
+	}
+	b.f.create(role)
+	return role
+}
+
+// UserBuilder builds a user
+type UserBuilder struct {
+	f        *Factory
+	user     entities.User
+	password string
+}
+
+// User starts an active user named user-N with DefaultPassword
+func (f *Factory) User() *UserBuilder {
+	n := f.next()
+	return &UserBuilder{f: f, password: DefaultPassword, user: entities.User{
+		Username: fmt.Sprintf("user-%d", n),
+		Email:    fmt.Sprintf("user-%d@example.com", n),
+		Name:     "User",
+		Surname:  fmt.Sprint(n),
+		IsActive: true,
+	}}
+}
+
+// Named sets the user name; the e-mail address follows it
+func (b *UserBuilder) Named(username string) *UserBuilder {
+	b.user.Username = username
+	b.user.Email = username + "@example.com"
+	return b
+}
+
+// ForTenant puts the user in a tenant
+func (b *UserBuilder) ForTenant(tenantID int) *UserBuilder {
+	b.user.TenantID = &tenantID
+	return b
+}
+
+// WithPassword sets the password the hash is made of
+func (b *UserBuilder) WithPassword(password string) *UserBuilder {
+	b.password = password
+	return b
+}
+
+// WithRoles assigns saved roles to the user
+func (b *UserBuilder) WithRoles(roles ...*entities.Role) *UserBuilder {
+	for _, role := range roles {
+		b.user.Roles = append(b.user.Roles, *role)
+	}
+	return b
+}
+
+// Build returns the user without saving it
+func (b *UserBuilder) Build() *entities.User {
+	b.f.t.Helper()
+	// The lowest cost keeps hashing from dominating test time
+	hash, err := bcrypt.GenerateFromPassword([]byte(b.password), bcrypt.MinCost)
+	if err != nil {
+		b.f.t.Fatalf("failed to hash password: %v", err)
+	}
+	user := b.user
+	user.PasswordHash = string(hash)
+	return &user
+}
+
+// Create saves the user and its role assignments
+func (b *UserBuilder) Create() *entities.User {
+	b.f.t.Helper()
+	user := b.Build()
+	b.f.create(user)
+	return user
+}
+
+// ProjectGroupBuilder builds a project group
+type ProjectGroupBuilder struct {
+	f     *Factory
+	group entities.ProjectGroup
+}
+
+// ProjectGroup starts a top-level group named Group N
+func (f *Factory) ProjectGroup() *ProjectGroupBuilder {
+	return &ProjectGroupBuilder{f: f, group: entities.ProjectGroup{
+		Name: fmt.Sprintf("Group %d", f.next()),
+	}}
+}
+
+// ForTenant puts the group in a tenant
+func (b *ProjectGroupBuilder) ForTenant(tenantID int) *ProjectGroupBuilder {
+	b.group.TenantID = &tenantID
+	return b
+}
+
+// Under nests the group below a parent
+func (b *ProjectGroupBuilder) Under(parentID int) *ProjectGroupBuilder {
+	b.group.ParentID = &parentID
+	return b
+}
+
+// Create saves the group
+func (b *ProjectGroupBuilder) Create() *entities.ProjectGroup {
+	b.f.t.Helper()
+	group := b.group
+	b.f.create(&group)
+	return &group
+}
+
+// ProjectBuilder builds a project, optionally with OCR projects
+type ProjectBuilder struct {
+	f         *Factory
+	project   entities.Project
+	ocrTypes  []entities.OcrProjectType
+	deletedBy *int
+}
+
+// Project starts a project coded PRJ-N
+func (f *Factory) Project() *ProjectBuilder {
+	n := f.next()
+	return &ProjectBuilder{f: f, project: entities.Project{
+		ProjectName: fmt.Sprintf("Project %d", n),
+		ProjectCode: fmt.Sprintf("PRJ-%04d", n),
+	}}
+}
+
+// Named sets the project name
+func (b *ProjectBuilder) Named(name string) *ProjectBuilder {
+	b.project.ProjectName = name
+	return b
+}
+
+// WithCode sets the project code
+func (b *ProjectBuilder) WithCode(code string) *ProjectBuilder {
+	b.project.ProjectCode = code
+	return b
+}
+
+// WithMuellef sets the author of the project
+func (b *ProjectBuilder) WithMuellef(muellef string) *ProjectBuilder {
+	b.project.ProjectMuellef = muellef
+	return b
+}
+
+// WithBildirimNo sets the notification number
+func (b *ProjectBuilder) WithBildirimNo(bildirimNo string) *ProjectBuilder {
+	b.project.BildirimNo = bildirimNo
+	return b
+}
+
+// WithTalepGucu sets the requested power in kW
+func (b *ProjectBuilder) WithTalepGucu(kw int) *ProjectBuilder {
+	b.project.TalepGucu = b.power(kw)
+	return b
+}
+
+// WithKuruluGuc sets the installed power in kW
+func (b *ProjectBuilder) WithKuruluGuc(kw int) *ProjectBuilder {
+	b.project.KuruluGuc = b.power(kw)
+	return b
+}
+
+// WithPermitDate sets the building permit expiry from a yyyy-MM-dd or dd.MM.yyyy date
+func (b *ProjectBuilder) WithPermitDate(date string) *ProjectBuilder {
+	b.f.t.Helper()
+	permitDate, err := valueobjects.ParsePermitDate(date)
+	if err != nil {
+		b.f.t.Fatalf("invalid permit date: %v", err)
+	}
+	b.project.RuhsatGecerlilikDate = &permitDate
+	return b
+}
+
+// InGroup puts the project in a saved group
+func (b *ProjectBuilder) InGroup(groupID int) *ProjectBuilder {
+	b.project.GroupID = &groupID
+	return b
+}
+
+// ForTenant puts the project in a tenant
+func (b *ProjectBuilder) ForTenant(tenantID int) *ProjectBuilder {
+	b.project.TenantID = &tenantID
+	return b
+}
+
+// CreatedBy records the creator of the project
+func (b *ProjectBuilder) CreatedBy(userID int) *ProjectBuilder {
+	b.project.CreatorUserID = &userID
+	return b
+}
+
+// WithOcrProjects adds an OCR project of each type; without types it adds the default documents
+func (b *ProjectBuilder) WithOcrProjects(types ...entities.OcrProjectType) *ProjectBuilder {
+	if len(types) == 0 {
+		types = entities.DefaultOcrProjectTypes
+	}
+	b.ocrTypes = append(b.ocrTypes, types...)
+	return b
+}
+
+// Deleted soft-deletes the project, and its OCR projects with it, once it is created
+func (b *ProjectBuilder) Deleted(userID int) *ProjectBuilder {
+	b.deletedBy = &userID
+	return b
+}
+
+func (b *ProjectBuilder) power(kw int) *valueobjects.Power {
+	b.f.t.Helper()
+	power, err := valueobjects.NewPower(kw)
+	if err != nil {
+		b.f.t.Fatalf("invalid power: %v", err)
+	}
+	return &power
+}
+
+// Build returns the project without saving it or its OCR projects
+func (b *ProjectBuilder) Build() *entities.Project {
+	project := b.project
+	return &project
+}
+
+// Create saves the project with its OCR projects, loaded into OcrProjects
+func (b *ProjectBuilder) Create() *entities.Project {
+	b.f.t.Helper()
+	project := b.Build()
+	b.f.create(project)
+
+	for _, ocrType := range b.ocrTypes {
+		project.OcrProjects = append(project.OcrProjects, *b.f.OcrProject(project).OfType(ocrType).Create())
+	}
+
+	if b.deletedBy != nil {
+		project.SoftDelete(*b.deletedBy)
+		if err := b.f.db.Save(project).Error; err != nil {
+			b.f.t.Fatalf("failed to delete project: %v", err)
+		}
+		for i := range project.OcrProjects {
+			ocrProject := &project.OcrProjects[i]
+			ocrProject.IsDeleted = true
+			ocrProject.DeletionTime = project.DeletionTime
+			ocrProject.DeleterUserID = b.deletedBy
+			if err := b.f.db.Save(ocrProject).Error; err != nil {
+				b.f.t.Fatalf("failed to delete OCR project: %v", err)
+			}
+		}
+	}
+	return project
+}
+
+// OcrProjectBuilder builds an OCR project of a saved project
+type OcrProjectBuilder struct {
+	f          *Factory
+	ocrProject entities.OcrProject
+	deletedBy  *int
+}
+
+// OcrProject starts a pending ProjeAntenti document of the project, in the project's tenant
+func (f *Factory) OcrProject(project *entities.Project) *OcrProjectBuilder {
+	return &OcrProjectBuilder{f: f, ocrProject: entities.OcrProject{
+		MultiTenantEntity: entities.MultiTenantEntity{TenantID: project.TenantID},
+		ProjectID:         project.ID,
+		ProjectName:       project.ProjectName,
+		ProjectCode:       project.ProjectCode,
+		Type:              entities.ProjeAntenti,
+		Status:            entities.OcrProjectStatusPending,
+	}}
+}
+
+// OfType sets the document type
+func (b *OcrProjectBuilder) OfType(ocrType entities.OcrProjectType) *OcrProjectBuilder {
+	b.ocrProject.Type = ocrType
+	return b
+}
+
+// WithStatus sets the workflow status without going through the transitions
+func (b *OcrProjectBuilder) WithStatus(status entities.OcrProjectStatus) *OcrProjectBuilder {
+	b.ocrProject.Status = status
+	return b
+}
+
+// AssignedTo sets the reviewer
+func (b *OcrProjectBuilder) AssignedTo(userID int) *OcrProjectBuilder {
+	b.ocrProject.ReviewerUserID = &userID
+	return b
+}
+
+// Optional marks the document as optional
+func (b *OcrProjectBuilder) Optional() *OcrProjectBuilder {
+	b.ocrProject.IsOptional = true
+	return b
+}
+
+// Deleted soft-deletes the OCR project on its own once it is created
+func (b *OcrProjectBuilder) Deleted(userID int) *OcrProjectBuilder {
+	b.deletedBy = &userID
+	return b
+}
+
+// Build returns the OCR project without saving it
+func (b *OcrProjectBuilder) Build() *entities.OcrProject {
+	ocrProject := b.ocrProject
+	return &ocrProject
+}
+
+// Create saves the OCR project
+func (b *OcrProjectBuilder) Create() *entities.OcrProject {
+	b.f.t.Helper()
+	ocrProject := b.Build()
+	b.f.create(ocrProject)
+
+	if b.deletedBy != nil {
+		ocrProject.SoftDelete(*b.deletedBy)
+		if err := b.f.db.Save(ocrProject).Error; err != nil {
+			b.f.t.Fatalf("failed to delete OCR project: %v", err)
+		}
+	}
+	return ocrProject
+}
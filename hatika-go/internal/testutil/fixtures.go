@@ -0,0 +1,36 @@
+package testutil
+
+import "hatika-go/internal/domain/entities"
+
+// Fixtures is the minimal data set of a tenant in use: its static roles, an admin and a standard user
+type Fixtures struct {
+	Tenant    *entities.Tenant
+	AdminRole *entities.Role
+	UserRole  *entities.Role
+	Admin     *entities.User
+	User      *entities.User
+}
+
+// LoadFixtures creates a tenant with the static roles. The Admin role is granted every permission of
+// the application and the User role only the project pages, as a new installation would set them up.
+func LoadFixtures(f *Factory) *Fixtures {
+	f.t.Helper()
+
+	tenant := f.Tenant().Create()
+
+	permissions := make([]string, len(entities.PermissionDefinitions))
+	for i, definition := range entities.PermissionDefinitions {
+		permissions[i] = definition.Name
+	}
+	adminRole := f.Role().Named(entities.AdminRoleName).ForTenant(tenant.ID).WithPermissions(permissions...).Create()
+	userRole := f.Role().Named(entities.UserRoleName).ForTenant(tenant.ID).
+		WithPermissions(entities.PagesProjects, entities.PagesOcrProjects).Create()
+
+	return &Fixtures{
+		Tenant:    tenant,
+		AdminRole: adminRole,
+		UserRole:  userRole,
+		Admin:     f.User().ForTenant(tenant.ID).WithRoles(adminRole).Create(),
+		User:      f.User().ForTenant(tenant.ID).WithRoles(userRole).Create(),
+	}
+}
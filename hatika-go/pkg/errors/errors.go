@@ -0,0 +1,82 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+)
+
+// Kind classifies an application error so the HTTP layer can map it to a status code
+type Kind int
+
+const (
+	KindValidation Kind = iota + 1
+	KindNotFound
+	KindConflict
+	KindForbidden
+	KindConcurrencyConflict
+)
+
+// AppError is an error with a kind that survives wrapping with fmt.Errorf("%w")
+type AppError struct {
+	Kind    Kind
+	Message string
+	Details interface{}
+}
+
+func (e *AppError) Error() string {
+	return e.Message
+}
+
+func newError(kind Kind, format string, args ...interface{}) *AppError {
+	return &AppError{
+		Kind:    kind,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// Validation creates an error for invalid input
+func Validation(format string, args ...interface{}) *AppError {
+	return newError(KindValidation, format, args...)
+}
+
+// NotFound creates an error for a missing resource
+func NotFound(format string, args ...interface{}) *AppError {
+	return newError(KindNotFound, format, args...)
+}
+
+// Conflict creates an error for a request that conflicts with the current state of a resource
+func Conflict(format string, args ...interface{}) *AppError {
+	return newError(KindConflict, format, args...)
+}
+
+// ConcurrencyConflict creates an error for a change made to an outdated version of a resource, which
+// someone else changed in the meantime
+func ConcurrencyConflict(format string, args ...interface{}) *AppError {
+	return newError(KindConcurrencyConflict, format, args...)
+}
+
+// Forbidden creates an error for an operation the current user may not perform
+func Forbidden(format string, args ...interface{}) *AppError {
+	return newError(KindForbidden, format, args...)
+}
+
+// WithDetails attaches extra information that is returned to the client
+func (e *AppError) WithDetails(details interface{}) *AppError {
+	e.Details = details
+	return e
+}
+
+// As returns the first AppError in err's chain
+func As(err error) (*AppError, bool) {
+	var appErr *AppError
+	if stderrors.As(err, &appErr) {
+		return appErr, true
+	}
+	return nil, false
+}
+
+// IsKind reports whether err's chain contains an AppError of the given kind
+func IsKind(err error, kind Kind) bool {
+	appErr, ok := As(err)
+	return ok && appErr.Kind == kind
+}
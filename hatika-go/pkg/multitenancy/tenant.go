@@ -0,0 +1,19 @@
+package multitenancy
+
+import "context"
+
+// TenantIDHeader is the request header that selects the current tenant; host requests omit it
+const TenantIDHeader = "Abp.TenantId"
+
+type tenantIDKey struct{}
+
+// WithTenantID returns a context carrying the current tenant; nil means the host
+func WithTenantID(ctx context.Context, tenantID *int) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, tenantID)
+}
+
+// TenantIDFromContext returns the current tenant, or nil for the host
+func TenantIDFromContext(ctx context.Context) *int {
+	tenantID, _ := ctx.Value(tenantIDKey{}).(*int)
+	return tenantID
+}
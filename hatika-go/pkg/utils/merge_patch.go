@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MergePatchContentType is the media type of a JSON Merge Patch (RFC 7396)
+const MergePatchContentType = "application/merge-patch+json"
+
+// ApplyMergePatch applies a JSON Merge Patch (RFC 7396) to a JSON document: every member of the patch
+// replaces the member of the document with the same name, a null removes it, and nested objects are
+// merged the same way. A patch that is not an object replaces the whole document.
+func ApplyMergePatch(document, patch []byte) ([]byte, error) {
+	var target interface{}
+	if err := decodeJSON(document, &target); err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+	var changes interface{}
+	if err := decodeJSON(patch, &changes); err != nil {
+		return nil, fmt.Errorf("invalid merge patch: %w", err)
+	}
+
+	return json.Marshal(mergePatch(target, changes))
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{}, len(patchObject))
+	}
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+		targetObject[name] = mergePatch(targetObject[name], value)
+	}
+	return targetObject
+}
+
+// decodeJSON keeps numbers as written, so large integers survive the round trip
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
@@ -3,6 +3,8 @@ package utils
 import (
 	"net/http"
 
+	apperrors "hatika-go/pkg/errors"
+
 	"github.com/gin-gonic/gin"
 )
 
@@ -70,3 +72,25 @@ func RespondInternalError(c *gin.Context, message string) {
 	}
 	RespondWithError(c, http.StatusInternalServerError, message, nil)
 }
+
+// RespondWithAppError maps typed application errors to their HTTP status, falling back to 500
+func RespondWithAppError(c *gin.Context, err error) {
+	appErr, ok := apperrors.As(err)
+	if !ok {
+		RespondInternalError(c, err.Error())
+		return
+	}
+
+	switch appErr.Kind {
+	case apperrors.KindValidation:
+		RespondWithError(c, http.StatusBadRequest, appErr.Message, appErr.Details)
+	case apperrors.KindNotFound:
+		RespondWithError(c, http.StatusNotFound, appErr.Message, appErr.Details)
+	case apperrors.KindConflict, apperrors.KindConcurrencyConflict:
+		RespondWithError(c, http.StatusConflict, appErr.Message, appErr.Details)
+	case apperrors.KindForbidden:
+		RespondWithError(c, http.StatusForbidden, appErr.Message, appErr.Details)
+	default:
+		RespondInternalError(c, appErr.Message)
+	}
+}